@@ -1,6 +1,8 @@
 package main
 
 import (
+	"estudocoin/internal/audit"
+	"estudocoin/internal/bot"
 	"estudocoin/internal/commands"
 	"estudocoin/pkg/config"
 	"estudocoin/internal/database"
@@ -8,6 +10,8 @@ import (
 	"estudocoin/internal/api"
 	"estudocoin/internal/games"
 	"estudocoin/internal/stockmarket"
+	"estudocoin/internal/webhook"
+	"flag"
 	"log"
 	"os"
 	"os/signal"
@@ -18,6 +22,9 @@ import (
 )
 
 func main() {
+	unregister := flag.Bool("unregister", false, "remove every slash command (global and command_guild_ids) and exit")
+	flag.Parse()
+
 	_ = godotenv.Load() 
 
 	// Load Configuration
@@ -28,11 +35,19 @@ func main() {
 		log.Fatal("DISCORD_TOKEN not found in environment variables")
 	}
 
+	if *unregister {
+		unregisterCommands(token)
+		return
+	}
+
 	database.Initialize()
 	defer database.DB.Close()
 
+	// Start webhook delivery worker
+	webhook.Start()
+
 	// Start API Server
-	if config.Bot.EnableAPI {
+	if config.Bot().EnableAPI {
 		go api.Start()
 	} else {
 		log.Println("API is disabled in config.json")
@@ -49,6 +64,7 @@ func main() {
 	dg.AddHandler(commands.MessageCreate)
 	dg.AddHandler(commands.SlashHandler)
 	dg.AddHandler(commands.ComponentsHandler)
+	dg.AddHandler(commands.ModalHandler)
 	dg.AddHandler(events.VoiceStateUpdate)
 
 	// Identify Intent
@@ -64,6 +80,9 @@ func main() {
 	database.BotUserID = dg.State.User.ID
 	log.Printf("Bot User ID: %s", database.BotUserID)
 
+	// Audit channel (large transfers, jackpots, loans, shop, admin actions)
+	audit.Start(dg)
+
 	// Initialize voice sessions for users already in voice channels
 	events.InitializeVoiceSessions(dg)
 
@@ -73,6 +92,9 @@ func main() {
 	// Start Roulette
 	games.StartRoulette(dg)
 
+	// Start Lottery
+	games.StartLottery(dg)
+
 	// Start Event Betting
 	games.StartEventBetting(dg)
 
@@ -81,14 +103,7 @@ func main() {
 
 	// Register Slash Commands
 	log.Println("Registering slash commands...")
-	registeredCommands := make([]*discordgo.ApplicationCommand, len(commands.SlashCommands))
-	for i, v := range commands.SlashCommands {
-		cmd, err := dg.ApplicationCommandCreate(dg.State.User.ID, "", v)
-		if err != nil {
-			log.Panicf("Cannot create '%v' command: %v", v.Name, err)
-		}
-		registeredCommands[i] = cmd
-	}
+	registerCommands(dg)
 
 	log.Println("Bot is now running. Press CTRL-C to exit.")
 	
@@ -103,9 +118,54 @@ func main() {
 	events.CloseAllVoiceSessions()
 
 	// Cleanly close down the Discord session.
-	// Optionally remove commands on exit to avoid clutter if dev
-	// for _, v := range registeredCommands {
-	// 	dg.ApplicationCommandDelete(dg.State.User.ID, "", v.ID)
-	// }
 	dg.Close()
+}
+
+// registerCommands sincroniza os comandos de barra: nos servidores de
+// command_guild_ids, se configurados, ou globalmente
+func registerCommands(dg *discordgo.Session) {
+	appID := dg.State.User.ID
+
+	if len(config.Bot().CommandGuildIDs) == 0 {
+		if err := commands.Registry.Sync(dg, appID, ""); err != nil {
+			log.Printf("Cannot register slash commands: %v", err)
+		}
+		return
+	}
+
+	for _, guildID := range config.Bot().CommandGuildIDs {
+		if err := commands.Registry.Sync(dg, appID, guildID); err != nil {
+			log.Printf("Cannot register slash commands: %v", err)
+		}
+	}
+
+	// Comandos globais antigos apareceriam duplicados nesses servidores
+	if global, err := dg.ApplicationCommands(appID, ""); err == nil && len(global) > 0 {
+		log.Printf("Warning: %d global slash commands are still registered; run with --unregister to remove them", len(global))
+	}
+}
+
+// unregisterCommands remove os comandos globais e os dos servidores configurados
+func unregisterCommands(token string) {
+	dg, err := discordgo.New("Bot " + token)
+	if err != nil {
+		log.Fatal("Error creating Discord session: ", err)
+	}
+
+	// Só precisamos do ID da aplicação; não abrir o websocket
+	app, err := dg.User("@me")
+	if err != nil {
+		log.Fatal("Error fetching bot user: ", err)
+	}
+
+	failed := false
+	for _, guildID := range append([]string{""}, config.Bot().CommandGuildIDs...) {
+		if err := bot.Unregister(dg, app.ID, guildID); err != nil {
+			log.Println(err)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
 }
\ No newline at end of file
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"estudocoin/internal/service"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DailyResponse represents the result of a daily claim
+type DailyResponse struct {
+	Reward    int       `json:"reward"`
+	Streak    int       `json:"streak"`
+	MaxStreak int       `json:"max_streak"`
+	NextDaily time.Time `json:"next_daily"`
+	Balance   int       `json:"balance"`
+}
+
+// LeaderboardEntry represents a single user in the leaderboard
+type LeaderboardEntry struct {
+	Rank           int    `json:"rank"`
+	UserID         string `json:"user_id"`
+	Balance        int    `json:"balance"`
+	StockValue     int    `json:"stock_value"`
+	CryptoHoldings int    `json:"crypto_holdings"` // number of different coins held
+	TotalNetWorth  int    `json:"total_net_worth"`
+}
+
+// HandleDaily claims the daily reward for the authenticated user
+func HandleDaily(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := r.Header.Get("X-User-ID")
+
+	claim, err := service.Economy.ClaimDaily(r.Context(), userID)
+	if errors.Is(err, service.ErrDailyAlreadyClaimed) {
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"error":      "Daily reward already claimed",
+			"next_daily": claim.NextDaily,
+		})
+		return
+	}
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(DailyResponse{
+		Reward:    claim.Reward,
+		Streak:    claim.Streak,
+		MaxStreak: claim.MaxStreak,
+		NextDaily: claim.NextDaily,
+		Balance:   claim.Balance,
+	})
+}
+
+// HandleLeaderboard returns the richest users by net worth.
+// Accepts an optional ?limit= query parameter (default 10, max 100).
+func HandleLeaderboard(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	limit := 10
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n <= 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(ErrorResponse{Error: "limit must be a positive number"})
+			return
+		}
+		if n > 100 {
+			n = 100
+		}
+		limit = n
+	}
+
+	users, err := service.Economy.Leaderboard(r.Context(), limit)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	entries := make([]LeaderboardEntry, 0, len(users))
+	for i, u := range users {
+		entries = append(entries, LeaderboardEntry{
+			Rank:           i + 1,
+			UserID:         u.ID,
+			Balance:        u.Balance,
+			StockValue:     u.StockValue,
+			CryptoHoldings: u.CryptoValue,
+			TotalNetWorth:  u.TotalNetWorth,
+		})
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(entries)
+}
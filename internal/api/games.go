@@ -0,0 +1,118 @@
+package api
+
+import (
+	"encoding/json"
+	"estudocoin/internal/database"
+	"estudocoin/internal/games"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GameHistoryEntry is one finished game of the user
+type GameHistoryEntry struct {
+	Game     string    `json:"game"`
+	Stake    int       `json:"stake"`
+	Payout   int       `json:"payout"`
+	Profit   int       `json:"profit"`
+	Detail   string    `json:"detail"`
+	PlayedAt time.Time `json:"played_at"`
+}
+
+// HandleRouletteCurrent returns the state of the current wheel round
+func HandleRouletteCurrent(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	round, ok := games.GetRoundSnapshot()
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(ErrorResponse{Error: "No roulette round in progress"})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(round)
+}
+
+// HandleEvents returns every active betting event with its current odds
+func HandleEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(games.ListEvents())
+}
+
+// HandleGamesHistory returns the authenticated user's finished games, newest
+// first. Accepts optional ?game=, ?limit= (default 20, max 100) and ?offset=.
+func HandleGamesHistory(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID := r.Header.Get("X-User-ID")
+	query := r.URL.Query()
+
+	game := query.Get("game")
+	if game != "" && !games.IsStatsGame(game) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{Error: "game must be one of: " + strings.Join(games.StatsGames, ", ")})
+		return
+	}
+
+	limit := 20
+	if raw := query.Get("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n <= 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(ErrorResponse{Error: "limit must be a positive number"})
+			return
+		}
+		if n > 100 {
+			n = 100
+		}
+		limit = n
+	}
+
+	offset := 0
+	if raw := query.Get("offset"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(ErrorResponse{Error: "offset must be zero or a positive number"})
+			return
+		}
+		offset = n
+	}
+
+	results, err := database.GetGameHistory(userID, game, limit, offset)
+	if err != nil {
+		log.Printf("[API] Error loading game history of %s: %v", userID, err)
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(ErrorResponse{Error: "Internal error"})
+		return
+	}
+
+	entries := make([]GameHistoryEntry, 0, len(results))
+	for _, res := range results {
+		entries = append(entries, GameHistoryEntry{
+			Game:     res.Game,
+			Stake:    res.Stake,
+			Payout:   res.Payout,
+			Profit:   res.Payout - res.Stake,
+			Detail:   res.Detail,
+			PlayedAt: res.PlayedAt,
+		})
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(entries)
+}
@@ -0,0 +1,139 @@
+package api
+
+import (
+	"encoding/json"
+	"estudocoin/internal/database"
+	"estudocoin/internal/service"
+	"net/http"
+	"time"
+)
+
+// LoanInfo represents a loan or pending loan offer
+type LoanInfo struct {
+	ID           string    `json:"id"`
+	LenderID     string    `json:"lender_id"`
+	BorrowerID   string    `json:"borrower_id"`
+	Amount       int       `json:"amount"`
+	InterestRate float64   `json:"interest_rate"`
+	TotalOwed    int       `json:"total_owed"`
+	DueDate      time.Time `json:"due_date"`
+	CreatedAt    time.Time `json:"created_at"`
+	Role         string    `json:"role"` // "lender" or "borrower"
+}
+
+// LoansResponse lists the user's active loans and pending offers
+type LoansResponse struct {
+	Active  []LoanInfo `json:"active"`
+	Pending []LoanInfo `json:"pending"`
+}
+
+// LoanActionRequest represents a loan action.
+// Action is one of "offer", "accept", "decline" or "pay".
+type LoanActionRequest struct {
+	Action       string  `json:"action"`
+	LoanID       string  `json:"loan_id,omitempty"`
+	BorrowerID   string  `json:"borrower_id,omitempty"`
+	Amount       int     `json:"amount,omitempty"`
+	InterestRate float64 `json:"interest_rate,omitempty"`
+	Days         int     `json:"days,omitempty"`
+}
+
+func toLoanInfo(loan *database.Loan, userID string) LoanInfo {
+	role := "borrower"
+	if loan.LenderID == userID {
+		role = "lender"
+	}
+	return LoanInfo{
+		ID:           loan.ID,
+		LenderID:     loan.LenderID,
+		BorrowerID:   loan.BorrowerID,
+		Amount:       loan.Amount,
+		InterestRate: loan.InterestRate,
+		TotalOwed:    loan.TotalOwed,
+		DueDate:      loan.DueDate,
+		CreatedAt:    loan.CreatedAt,
+		Role:         role,
+	}
+}
+
+// HandleLoans lists the user's loans (GET) or performs a loan action (POST)
+func HandleLoans(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		handleListLoans(w, r)
+	case http.MethodPost:
+		handleLoanAction(w, r)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func handleListLoans(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-User-ID")
+
+	resp := LoansResponse{
+		Active:  []LoanInfo{},
+		Pending: []LoanInfo{},
+	}
+	for _, loan := range service.Loans.Active(userID) {
+		resp.Active = append(resp.Active, toLoanInfo(loan, userID))
+	}
+	for _, loan := range service.Loans.PendingOffers(userID) {
+		resp.Pending = append(resp.Pending, toLoanInfo(loan, userID))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resp)
+}
+
+func handleLoanAction(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get("X-User-ID")
+
+	var req LoanActionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{Error: "Invalid Request Body"})
+		return
+	}
+
+	var loan *database.Loan
+	var err error
+
+	switch req.Action {
+	case "offer":
+		if req.BorrowerID == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(ErrorResponse{Error: "borrower_id is required"})
+			return
+		}
+		loan, err = service.Loans.Offer(r.Context(), userID, req.BorrowerID, req.Amount, req.InterestRate, req.Days, "", "")
+	case "accept":
+		loan, err = service.Loans.Accept(r.Context(), userID, req.LoanID)
+	case "decline":
+		_, err = service.Loans.Decline(r.Context(), userID, req.LoanID)
+	case "pay":
+		loan, err = service.Loans.Pay(r.Context(), userID, req.LoanID)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ErrorResponse{Error: "action must be one of offer, accept, decline, pay"})
+		return
+	}
+
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	if loan == nil {
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]string{"status": "success"})
+		return
+	}
+
+	status := http.StatusOK
+	if req.Action == "offer" {
+		status = http.StatusCreated
+	}
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(toLoanInfo(loan, userID))
+}
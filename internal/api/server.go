@@ -2,8 +2,9 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"estudocoin/internal/database"
-	"estudocoin/internal/webhook"
+	"estudocoin/internal/service"
 	"estudocoin/pkg/config"
 	"log"
 	"net/http"
@@ -23,6 +24,31 @@ type TransferRequest struct {
 	Amount   int    `json:"amount"`
 }
 
+// writeServiceError maps a service error kind to the matching HTTP status
+func writeServiceError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, service.ErrInvalidInput), errors.Is(err, service.ErrInsufficientFunds):
+		status = http.StatusBadRequest
+	case errors.Is(err, service.ErrForbidden):
+		status = http.StatusForbidden
+	case errors.Is(err, service.ErrNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, service.ErrConflict):
+		status = http.StatusConflict
+	case errors.Is(err, service.ErrUnavailable):
+		status = http.StatusServiceUnavailable
+	}
+
+	message := err.Error()
+	if status == http.StatusInternalServerError && !errors.As(err, new(*service.Error)) {
+		message = "Internal error"
+	}
+
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(ErrorResponse{Error: message})
+}
+
 func AuthMiddleware(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		key := r.Header.Get("X-API-Key")
@@ -39,6 +65,13 @@ func AuthMiddleware(next http.HandlerFunc) http.HandlerFunc {
 			return
 		}
 
+		// Frozen accounts can't use the API at all
+		if database.IsFrozen(userID) {
+			w.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(w).Encode(ErrorResponse{Error: service.ErrAccountFrozen.Message})
+			return
+		}
+
 		// Add UserID to header for next handler (simple context passing)
 		r.Header.Set("X-User-ID", userID)
 		next(w, r)
@@ -75,27 +108,11 @@ func HandleTransfer(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if req.Amount <= 0 {
-		w.WriteHeader(http.StatusBadRequest)
-		json.NewEncoder(w).Encode(ErrorResponse{Error: "Amount must be positive"})
-		return
-	}
-
-	if req.ToUserID == userID {
-		w.WriteHeader(http.StatusBadRequest)
-		json.NewEncoder(w).Encode(ErrorResponse{Error: "Cannot transfer to yourself"})
-		return
-	}
-
-	err := database.TransferCoins(userID, req.ToUserID, req.Amount)
-	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		json.NewEncoder(w).Encode(ErrorResponse{Error: "Insufficient funds or transaction failed"})
+	if err := service.Economy.Transfer(r.Context(), userID, req.ToUserID, req.Amount); err != nil {
+		writeServiceError(w, err)
 		return
 	}
 
-	webhook.SendTransferNotification(userID, req.ToUserID, req.Amount)
-
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]string{"status": "success"})
 }
@@ -106,6 +123,9 @@ func Start() {
 	// User endpoints
 	mux.HandleFunc("/api/v1/me", AuthMiddleware(HandleMe))
 	mux.HandleFunc("/api/v1/transfer", AuthMiddleware(HandleTransfer))
+	mux.HandleFunc("/api/v1/daily", AuthMiddleware(HandleDaily))
+	mux.HandleFunc("/api/v1/leaderboard", HandleLeaderboard)
+	mux.HandleFunc("/api/v1/loans", AuthMiddleware(HandleLoans))
 	
 	// Stock market endpoints
 	mux.HandleFunc("/api/v1/stocks", HandleStocksList)
@@ -119,7 +139,12 @@ func Start() {
 	mux.HandleFunc("/api/v1/crypto/buy", AuthMiddleware(HandleBuyCrypto))
 	mux.HandleFunc("/api/v1/crypto/sell", AuthMiddleware(HandleSellCrypto))
 
-	port := config.Bot.ApiPort
+	// Game endpoints (read-only)
+	mux.HandleFunc("/api/v1/roulette/current", HandleRouletteCurrent)
+	mux.HandleFunc("/api/v1/events", HandleEvents)
+	mux.HandleFunc("/api/v1/games/history", AuthMiddleware(HandleGamesHistory))
+
+	port := config.Bot().ApiPort
 	if port == "" {
 		port = ":8080"
 	}
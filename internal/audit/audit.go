@@ -0,0 +1,200 @@
+// Package audit posts notable economy events (large transfers, jackpots, loan
+// collections, shop punishments and admin actions) to the audit channel set
+// in config.json. Every category can be toggled on its own.
+package audit
+
+import (
+	"estudocoin/internal/database"
+	"estudocoin/pkg/config"
+	"estudocoin/pkg/utils"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Embed colors per category
+const (
+	colorTransfer = 0x3498DB
+	colorJackpot  = utils.ColorGold
+	colorLoan     = 0xE67E22
+	colorShop     = 0x9B59B6
+	colorAdmin    = 0xE74C3C
+)
+
+var session *discordgo.Session
+
+// Start sets the session used to post. Events before Start are dropped.
+func Start(s *discordgo.Session) {
+	session = s
+}
+
+// Transfer logs a user-to-user transfer at or above the configured threshold
+func Transfer(fromID, toID string, amount int) {
+	cfg := config.Bot().Audit
+	if !cfg.Transfers || amount < cfg.TransferThreshold {
+		return
+	}
+
+	embed := newEmbed("💸 Large Transfer", colorTransfer)
+	embed.Fields = []*discordgo.MessageEmbedField{
+		{Name: "From", Value: mention(fromID), Inline: true},
+		{Name: "To", Value: mention(toID), Inline: true},
+		{Name: "Amount", Value: coins(amount), Inline: true},
+	}
+	post(cfg.ChannelID, embed)
+}
+
+// GameWin logs a game whose profit reaches the jackpot threshold
+func GameWin(userID, game string, stake, payout int) {
+	cfg := config.Bot().Audit
+	profit := payout - stake
+	if !cfg.Jackpots || profit <= 0 || profit < cfg.JackpotThreshold {
+		return
+	}
+
+	embed := newEmbed("🎰 Jackpot", colorJackpot)
+	embed.Fields = []*discordgo.MessageEmbedField{
+		{Name: "Player", Value: mention(userID), Inline: true},
+		{Name: "Game", Value: game, Inline: true},
+		{Name: "Bet", Value: coins(stake), Inline: true},
+		{Name: "Payout", Value: coins(payout), Inline: true},
+		{Name: "Profit", Value: coins(profit), Inline: true},
+	}
+	post(cfg.ChannelID, embed)
+}
+
+// LoanCollected logs an automatic collection at the due date.
+// defaulted means the borrower could not pay and went negative.
+func LoanCollected(loan *database.Loan, collected int, defaulted bool) {
+	cfg := config.Bot().Audit
+	if !cfg.Loans {
+		return
+	}
+
+	title := "🏦 Loan Auto-Collected"
+	if defaulted {
+		title = "🚨 Loan Default"
+	}
+	embed := newEmbed(title, colorLoan)
+	embed.Fields = []*discordgo.MessageEmbedField{
+		{Name: "Borrower", Value: mention(loan.BorrowerID), Inline: true},
+		{Name: "Lender", Value: mention(loan.LenderID), Inline: true},
+		{Name: "Owed", Value: coins(loan.TotalOwed), Inline: true},
+		{Name: "Collected", Value: coins(collected), Inline: true},
+	}
+	if defaulted {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name: "Debt", Value: coins(loan.TotalOwed - collected), Inline: true,
+		})
+	}
+	embed.Footer = &discordgo.MessageEmbedFooter{Text: "Loan ID: " + loan.ID}
+	post(cfg.ChannelID, embed)
+}
+
+// ShopPurchase logs a shop item used on a member (timeout, mute, rename).
+// detail describes the effect, e.g. "10 minutes" or the new nickname.
+func ShopPurchase(buyerID, targetID, item, detail string, cost int) {
+	cfg := config.Bot().Audit
+	if !cfg.Shop {
+		return
+	}
+
+	embed := newEmbed("🛒 Shop: "+item, colorShop)
+	embed.Fields = []*discordgo.MessageEmbedField{
+		{Name: "Buyer", Value: mention(buyerID), Inline: true},
+		{Name: "Target", Value: mention(targetID), Inline: true},
+		{Name: "Cost", Value: coins(cost), Inline: true},
+	}
+	if detail != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "Details", Value: detail})
+	}
+	post(cfg.ChannelID, embed)
+}
+
+// AdminAction posts an /admin action to the mod-log channel and, when the
+// admin category is on, to the audit channel (once if both are the same)
+func AdminAction(entry *database.AdminAction) {
+	embed := AdminActionEmbed(entry)
+	modLog := config.Bot().ModLogChannelID
+	post(modLog, embed)
+
+	cfg := config.Bot().Audit
+	if cfg.Admin && cfg.ChannelID != modLog {
+		post(cfg.ChannelID, embed)
+	}
+}
+
+// AdminActionEmbed describes an audit log entry
+func AdminActionEmbed(entry *database.AdminAction) *discordgo.MessageEmbed {
+	embed := newEmbed("🛡️ Admin: "+AdminActionLabel(entry.Action), colorAdmin)
+	embed.Fields = []*discordgo.MessageEmbedField{
+		{Name: "Admin", Value: mention(entry.AdminID), Inline: true},
+		{Name: "User", Value: mention(entry.TargetID), Inline: true},
+	}
+	if entry.Amount != 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name: "Amount", Value: coins(entry.Amount), Inline: true,
+		})
+	}
+	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+		Name:  "Balance",
+		Value: fmt.Sprintf("%d → %d %s", entry.BalanceBefore, entry.BalanceAfter, config.Bot().CurrencySymbol),
+	})
+
+	reason := entry.Reason
+	if reason == "" {
+		reason = "*No reason given*"
+	}
+	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: "Reason", Value: reason})
+	embed.Timestamp = entry.CreatedAt.Format(time.RFC3339)
+	return embed
+}
+
+// AdminActionLabel is the human name of an audit action
+func AdminActionLabel(action string) string {
+	switch action {
+	case database.AdminActionGive:
+		return "Coins Given"
+	case database.AdminActionTake:
+		return "Coins Taken"
+	case database.AdminActionSet:
+		return "Balance Set"
+	case database.AdminActionFreeze:
+		return "Account Frozen"
+	case database.AdminActionUnfreeze:
+		return "Account Unfrozen"
+	case database.AdminActionReset:
+		return "Account Reset"
+	}
+	return action
+}
+
+// post sends the embed without blocking the caller
+func post(channelID string, embed *discordgo.MessageEmbed) {
+	if channelID == "" || session == nil {
+		return
+	}
+	go func() {
+		if _, err := session.ChannelMessageSendEmbed(channelID, embed); err != nil {
+			log.Printf("Error posting audit event to channel %s: %v", channelID, err)
+		}
+	}()
+}
+
+func newEmbed(title string, color int) *discordgo.MessageEmbed {
+	return &discordgo.MessageEmbed{
+		Title:     title,
+		Color:     color,
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+}
+
+func mention(userID string) string {
+	return fmt.Sprintf("<@%s>", userID)
+}
+
+func coins(amount int) string {
+	return fmt.Sprintf("%d %s", amount, config.Bot().CurrencySymbol)
+}
@@ -0,0 +1,220 @@
+// Package bot holds the command registry shared by prefix (!) and slash commands.
+//
+// A command is declared once, with its options, aliases and permissions, and
+// the registry derives from it both the prefix parser and the Discord
+// application command definition. Handlers receive a *Context that hides
+// whether the invocation came from a message or an interaction.
+package bot
+
+import (
+	"estudocoin/internal/permissions"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Handler runs a command
+type Handler func(ctx *Context)
+
+// AutocompleteHandler suggests values for an option while the user types.
+// value is the partial input; at most 25 choices are sent to Discord.
+type AutocompleteHandler func(ctx *Context, value string) []*discordgo.ApplicationCommandOptionChoice
+
+// Command describes a top-level command or a subcommand
+type Command struct {
+	Name        string
+	Description string
+
+	// Aliases are extra names accepted by the prefix parser (e.g. "saldo" for "balance")
+	Aliases []string
+	// Shortcuts are top-level prefix names that run a subcommand directly,
+	// kept for legacy commands (e.g. "createevent" for "event create")
+	Shortcuts []string
+
+	// Options are parsed positionally in prefix mode, in declaration order.
+	// On a group they are prefix-only and feed Handler when no subcommand matches.
+	Options []*Option
+
+	// Subcommands turn the command into a group; Handler is then only used
+	// in prefix mode when no subcommand matches
+	Subcommands []*Command
+
+	// Permissions are the Discord permission bits the member must have (0 = everyone)
+	Permissions int64
+	// Level is the bot access level required (see the permissions package).
+	// It applies to every subcommand; the highest level along the path wins.
+	Level permissions.Level
+	// Economic commands move coins (bets, transfers) and are refused for
+	// frozen accounts (see Registry.Frozen). Applies to every subcommand.
+	Economic bool
+
+	// Private commands only answer the invoking user: slash replies are
+	// ephemeral and prefix replies are sent by DM. Applies to every subcommand.
+	Private bool
+
+	// PrefixOnly commands are not registered as slash commands
+	PrefixOnly bool
+	// SlashOnly commands are not reachable through the prefix
+	SlashOnly bool
+
+	Handler Handler
+
+	parent *Command
+}
+
+// Option is a typed argument of a command
+type Option struct {
+	Name        string
+	Description string
+	Type        discordgo.ApplicationCommandOptionType
+	Required    bool
+	MinValue    *float64
+	MaxValue    float64
+	Choices     []*discordgo.ApplicationCommandOptionChoice
+
+	// Rest makes a string option consume every remaining word in prefix mode
+	Rest bool
+
+	// Autocomplete enables slash suggestions for this option (ignored in prefix mode)
+	Autocomplete AutocompleteHandler
+}
+
+// Min is a helper for Option.MinValue
+func Min(v float64) *float64 {
+	return &v
+}
+
+// option finds an option by name
+func (c *Command) option(name string) *Option {
+	for _, opt := range c.Options {
+		if opt.Name == name {
+			return opt
+		}
+	}
+	return nil
+}
+
+// Path returns the full command path, e.g. "loan pay"
+func (c *Command) Path() string {
+	if c.parent == nil {
+		return c.Name
+	}
+	return c.parent.Path() + " " + c.Name
+}
+
+// Usage returns the prefix usage line, e.g. "!pay <user> <amount>"
+func (c *Command) Usage(prefix string) string {
+	var sb strings.Builder
+	sb.WriteString(prefix + c.Path())
+
+	if subs := c.prefixSubcommands(); len(subs) > 0 {
+		names := make([]string, 0, len(subs))
+		for _, sub := range subs {
+			names = append(names, sub.Name)
+		}
+		sb.WriteString(" <" + strings.Join(names, "|") + ">")
+		return sb.String()
+	}
+
+	for _, opt := range c.Options {
+		if opt.Required {
+			sb.WriteString(fmt.Sprintf(" <%s>", opt.Name))
+		} else {
+			sb.WriteString(fmt.Sprintf(" [%s]", opt.Name))
+		}
+	}
+	return sb.String()
+}
+
+// subcommand finds a subcommand by name or alias
+func (c *Command) subcommand(name string) *Command {
+	name = strings.ToLower(name)
+	for _, sub := range c.Subcommands {
+		if sub.SlashOnly {
+			continue
+		}
+		if sub.Name == name {
+			return sub
+		}
+		for _, alias := range sub.Aliases {
+			if alias == name {
+				return sub
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Command) prefixSubcommands() []*Command {
+	var subs []*Command
+	for _, sub := range c.Subcommands {
+		if !sub.SlashOnly {
+			subs = append(subs, sub)
+		}
+	}
+	return subs
+}
+
+// link sets the parent pointers of the subcommand tree
+func (c *Command) link() {
+	for _, sub := range c.Subcommands {
+		sub.parent = c
+		sub.link()
+	}
+}
+
+// ApplicationCommand builds the slash command definition
+func (c *Command) ApplicationCommand() *discordgo.ApplicationCommand {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        c.Name,
+		Description: c.Description,
+		Options:     c.applicationOptions(),
+	}
+	if c.Permissions != 0 {
+		perms := c.Permissions
+		cmd.DefaultMemberPermissions = &perms
+	}
+	return cmd
+}
+
+func (c *Command) applicationOptions() []*discordgo.ApplicationCommandOption {
+	var options []*discordgo.ApplicationCommandOption
+
+	for _, sub := range c.Subcommands {
+		if sub.PrefixOnly {
+			continue
+		}
+		optType := discordgo.ApplicationCommandOptionSubCommand
+		if len(sub.Subcommands) > 0 {
+			optType = discordgo.ApplicationCommandOptionSubCommandGroup
+		}
+		options = append(options, &discordgo.ApplicationCommandOption{
+			Type:        optType,
+			Name:        sub.Name,
+			Description: sub.Description,
+			Options:     sub.applicationOptions(),
+		})
+	}
+
+	// Discord does not mix subcommands and options; a group's options only
+	// exist in prefix mode
+	if len(c.Subcommands) > 0 {
+		return options
+	}
+
+	for _, opt := range c.Options {
+		options = append(options, &discordgo.ApplicationCommandOption{
+			Type:         opt.Type,
+			Name:         opt.Name,
+			Description:  opt.Description,
+			Required:     opt.Required,
+			MinValue:     opt.MinValue,
+			MaxValue:     opt.MaxValue,
+			Choices:      opt.Choices,
+			Autocomplete: opt.Autocomplete != nil,
+		})
+	}
+
+	return options
+}
@@ -0,0 +1,310 @@
+package bot
+
+import (
+	"errors"
+	"estudocoin/internal/i18n"
+	"estudocoin/internal/permissions"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Response is a reply that works for both messages and interactions
+type Response struct {
+	Content    string
+	Embeds     []*discordgo.MessageEmbed
+	Components []discordgo.MessageComponent
+	// Ephemeral only applies to slash commands; prefix replies are public
+	// unless the command is private (then they go by DM)
+	Ephemeral bool
+}
+
+type replyState int
+
+const (
+	notReplied replyState = iota
+	deferred
+	replied
+)
+
+// Context is the invocation of a command, from a message or from an interaction
+type Context struct {
+	Session *discordgo.Session
+
+	// Exactly one of Message and Interaction is set
+	Message     *discordgo.MessageCreate
+	Interaction *discordgo.InteractionCreate
+
+	Command   *Command
+	Author    *discordgo.User
+	ChannelID string
+	GuildID   string
+
+	// Args are the raw words after the command path (prefix mode only)
+	Args []string
+
+	prefix  string
+	options map[string]interface{}
+	locale  i18n.Locale
+
+	// private replies only reach the author (see Command.Private)
+	private bool
+
+	state       replyState
+	lastMessage *discordgo.Message
+	dmNotified  bool
+}
+
+// IsSlash reports whether the command came from an interaction
+func (c *Context) IsSlash() bool {
+	return c.Interaction != nil
+}
+
+// Level returns the bot access level of the author
+func (c *Context) Level() permissions.Level {
+	if c.IsSlash() {
+		return permissions.ForInteraction(c.Interaction)
+	}
+	return permissions.ForMessage(c.Session, c.Message)
+}
+
+// Locale returns the language of the author (see i18n.ForMessage / ForInteraction)
+func (c *Context) Locale() i18n.Locale {
+	if c.locale == "" {
+		if c.IsSlash() {
+			c.locale = i18n.ForInteraction(c.Interaction)
+		} else {
+			c.locale = i18n.ForMessage(c.Message)
+		}
+	}
+	return c.locale
+}
+
+// T translates a message to the author's language
+func (c *Context) T(msg string, args ...interface{}) string {
+	return c.Locale().T(msg, args...)
+}
+
+// ErrorEmbed builds a translated error embed
+func (c *Context) ErrorEmbed(msg string, args ...interface{}) *discordgo.MessageEmbed {
+	return c.Locale().ErrorEmbed(msg, args...)
+}
+
+// Err translates the user message of an error (see i18n.Locale.Err)
+func (c *Context) Err(err error) string {
+	return c.Locale().Err(err)
+}
+
+// Usage returns the prefix usage of the running command
+func (c *Context) Usage() string {
+	return c.Command.Usage(c.prefix)
+}
+
+// Has reports whether the option was given
+func (c *Context) Has(name string) bool {
+	_, ok := c.options[name]
+	return ok
+}
+
+// Int returns an integer option, or 0 when missing
+func (c *Context) Int(name string) int {
+	v, _ := c.options[name].(int64)
+	return int(v)
+}
+
+// Float returns a number option, or 0 when missing
+func (c *Context) Float(name string) float64 {
+	v, _ := c.options[name].(float64)
+	return v
+}
+
+// String returns a string option, or "" when missing
+func (c *Context) String(name string) string {
+	v, _ := c.options[name].(string)
+	return v
+}
+
+// Bool returns a boolean option, or false when missing
+func (c *Context) Bool(name string) bool {
+	v, _ := c.options[name].(bool)
+	return v
+}
+
+// User returns a user option, or nil when missing
+func (c *Context) User(name string) *discordgo.User {
+	v, _ := c.options[name].(*discordgo.User)
+	return v
+}
+
+// Reply sends a public embed
+func (c *Context) Reply(embed *discordgo.MessageEmbed) error {
+	return c.Respond(&Response{Embeds: []*discordgo.MessageEmbed{embed}})
+}
+
+// ReplyEphemeral sends an embed only the invoking user sees (slash only,
+// or by DM for private prefix commands)
+func (c *Context) ReplyEphemeral(embed *discordgo.MessageEmbed) error {
+	return c.Respond(&Response{Embeds: []*discordgo.MessageEmbed{embed}, Ephemeral: true})
+}
+
+// Defer acknowledges a slow command. Slash commands show "thinking...";
+// prefix commands show the typing indicator.
+func (c *Context) Defer(ephemeral bool) error {
+	if !c.IsSlash() {
+		return c.Session.ChannelTyping(c.ChannelID)
+	}
+	if c.state != notReplied {
+		return nil
+	}
+
+	var flags discordgo.MessageFlags
+	if ephemeral || c.private {
+		flags = discordgo.MessageFlagsEphemeral
+	}
+	err := c.Session.InteractionRespond(c.Interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Flags: flags},
+	})
+	if err == nil {
+		c.state = deferred
+	}
+	return err
+}
+
+// ShowModal answers a slash command with a modal form. The modal must be the
+// first reply, and prefix commands cannot open one.
+func (c *Context) ShowModal(data *discordgo.InteractionResponseData) error {
+	if !c.IsSlash() {
+		return errors.New("modals are only available to slash commands")
+	}
+	if c.state != notReplied {
+		return errors.New("interaction already acknowledged")
+	}
+
+	err := c.Session.InteractionRespond(c.Interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: data,
+	})
+	if err == nil {
+		c.state = replied
+	}
+	return err
+}
+
+// Respond sends a reply. The first slash reply answers the interaction (or
+// fills a deferred answer); later ones are sent as follow-ups.
+func (c *Context) Respond(r *Response) error {
+	if !c.IsSlash() {
+		if c.private {
+			return c.sendDM(r)
+		}
+		return c.send(c.ChannelID, r)
+	}
+
+	var flags discordgo.MessageFlags
+	if r.Ephemeral || c.private {
+		flags = discordgo.MessageFlagsEphemeral
+	}
+
+	switch c.state {
+	case notReplied:
+		err := c.Session.InteractionRespond(c.Interaction.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Content:    r.Content,
+				Embeds:     r.Embeds,
+				Components: r.Components,
+				Flags:      flags,
+			},
+		})
+		if err == nil {
+			c.state = replied
+		}
+		return err
+	case deferred:
+		// The visibility was fixed when deferring
+		c.state = replied
+		return c.Edit(r)
+	default:
+		_, err := c.Session.FollowupMessageCreate(c.Interaction.Interaction, true, &discordgo.WebhookParams{
+			Content:    r.Content,
+			Embeds:     r.Embeds,
+			Components: r.Components,
+			Flags:      flags,
+		})
+		return err
+	}
+}
+
+// send posts a prefix reply in a channel
+func (c *Context) send(channelID string, r *Response) error {
+	msg, err := c.Session.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Content:    r.Content,
+		Embeds:     r.Embeds,
+		Components: r.Components,
+	})
+	if err == nil {
+		c.lastMessage = msg
+	}
+	return err
+}
+
+// sendDM delivers a reply of a private prefix command by DM and reacts to the
+// command message so the user knows where to look. When the DMs are closed
+// only a notice is posted: the reply itself never goes to the channel.
+func (c *Context) sendDM(r *Response) error {
+	channel, err := c.Session.UserChannelCreate(c.Author.ID)
+	if err == nil {
+		err = c.send(channel.ID, r)
+	}
+	if err != nil {
+		if c.dmNotified {
+			return err
+		}
+		c.dmNotified = true
+		return c.send(c.ChannelID, &Response{Embeds: []*discordgo.MessageEmbed{
+			c.ErrorEmbed("I couldn't send you a DM. Open your DMs or use `/%s` instead.", c.Command.Path()),
+		}})
+	}
+
+	if !c.dmNotified {
+		c.dmNotified = true
+		c.Session.MessageReactionAdd(c.ChannelID, c.Message.ID, "📬")
+	}
+	return nil
+}
+
+// Edit replaces the first reply (slash) or the last message sent (prefix)
+func (c *Context) Edit(r *Response) error {
+	embeds := r.Embeds
+	if embeds == nil {
+		embeds = []*discordgo.MessageEmbed{}
+	}
+	components := r.Components
+	if components == nil {
+		components = []discordgo.MessageComponent{}
+	}
+
+	if !c.IsSlash() {
+		if c.lastMessage == nil {
+			return c.Respond(r)
+		}
+		_, err := c.Session.ChannelMessageEditComplex(&discordgo.MessageEdit{
+			Channel:    c.lastMessage.ChannelID,
+			ID:         c.lastMessage.ID,
+			Content:    &r.Content,
+			Embeds:     &embeds,
+			Components: &components,
+		})
+		return err
+	}
+
+	if c.state == notReplied {
+		return c.Respond(r)
+	}
+	_, err := c.Session.InteractionResponseEdit(c.Interaction.Interaction, &discordgo.WebhookEdit{
+		Content:    &r.Content,
+		Embeds:     &embeds,
+		Components: &components,
+	})
+	return err
+}
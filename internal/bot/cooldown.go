@@ -0,0 +1,125 @@
+package bot
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Limits configures the anti-spam checks run before every command
+type Limits struct {
+	// Commands maps a command path ("balance", "bet slots", "stock buy") to
+	// the time a user must wait between two uses. A group ("bet") covers
+	// every subcommand that has no entry of its own.
+	Commands map[string]time.Duration
+	// Rate is the maximum number of commands a user can run within Window
+	// (0 disables the global limit)
+	Rate   int
+	Window time.Duration
+}
+
+// cooldownFor returns the cooldown of cmd and the path it was configured under
+func (l Limits) cooldownFor(cmd *Command) (time.Duration, string) {
+	for c := cmd; c != nil; c = c.parent {
+		if d, ok := l.Commands[c.Path()]; ok {
+			return d, c.Path()
+		}
+	}
+	return 0, ""
+}
+
+// sweepInterval is how often expired entries are dropped from the tracker
+const sweepInterval = 5 * time.Minute
+
+// cooldowns remembers the recent commands of each user
+type cooldowns struct {
+	mu        sync.Mutex
+	lastUse   map[string]time.Time   // userID + " " + path -> end of the cooldown
+	recent    map[string][]time.Time // userID -> commands within the rate window
+	warned    map[string]time.Time   // userID -> rate limit already reported until
+	lastSweep time.Time
+}
+
+func newCooldowns() *cooldowns {
+	return &cooldowns{
+		lastUse: make(map[string]time.Time),
+		recent:  make(map[string][]time.Time),
+		warned:  make(map[string]time.Time),
+	}
+}
+
+// take records a use of cmd by userID. When the user must wait it returns
+// the remaining time, whether the global rate limit was hit and whether the
+// user was already told about it (so spam is not answered every time).
+func (c *cooldowns) take(limits Limits, userID string, cmd *Command, now time.Time) (wait time.Duration, global, silent bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if now.Sub(c.lastSweep) > sweepInterval {
+		c.sweep(limits, now)
+	}
+
+	if limits.Rate > 0 && limits.Window > 0 {
+		recent := c.recent[userID][:0]
+		for _, t := range c.recent[userID] {
+			if now.Sub(t) < limits.Window {
+				recent = append(recent, t)
+			}
+		}
+		c.recent[userID] = recent
+
+		if len(recent) >= limits.Rate {
+			wait = recent[0].Add(limits.Window).Sub(now)
+			silent = now.Before(c.warned[userID])
+			c.warned[userID] = now.Add(wait)
+			return wait, true, silent
+		}
+		// Attempts blocked by a cooldown count too, so spamming a command
+		// on cooldown ends up silenced by the global limit
+		c.recent[userID] = append(recent, now)
+	}
+
+	if d, path := limits.cooldownFor(cmd); d > 0 {
+		key := userID + " " + path
+		if until := c.lastUse[key]; now.Before(until) {
+			return until.Sub(now), false, false
+		}
+		c.lastUse[key] = now.Add(d)
+	}
+	return 0, false, false
+}
+
+// sweep drops the entries that no longer block anyone
+func (c *cooldowns) sweep(limits Limits, now time.Time) {
+	for key, until := range c.lastUse {
+		if !now.Before(until) {
+			delete(c.lastUse, key)
+		}
+	}
+	for userID, times := range c.recent {
+		if len(times) == 0 || now.Sub(times[len(times)-1]) >= limits.Window {
+			delete(c.recent, userID)
+		}
+	}
+	for userID, until := range c.warned {
+		if !now.Before(until) {
+			delete(c.warned, userID)
+		}
+	}
+	c.lastSweep = now
+}
+
+// formatWait renders a wait time as "42s" or "3m 5s", rounding up
+func formatWait(d time.Duration) string {
+	secs := int((d + time.Second - 1) / time.Second)
+	if secs < 1 {
+		secs = 1
+	}
+	if secs < 60 {
+		return fmt.Sprintf("%ds", secs)
+	}
+	if secs%60 == 0 {
+		return fmt.Sprintf("%dm", secs/60)
+	}
+	return fmt.Sprintf("%dm %ds", secs/60, secs%60)
+}
@@ -0,0 +1,137 @@
+package bot
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCooldownTake(t *testing.T) {
+	slots := &Command{Name: "slots"}
+	crash := &Command{Name: "crash"}
+	bet := &Command{Name: "bet", Subcommands: []*Command{slots, crash}}
+	bet.link()
+	balance := &Command{Name: "balance"}
+	commands := map[string]*Command{"bet slots": slots, "bet crash": crash, "balance": balance}
+
+	type use struct {
+		user    string
+		command string
+		at      time.Duration // since the start of the test
+		wait    time.Duration
+		global  bool
+		silent  bool
+	}
+	tests := []struct {
+		name   string
+		limits Limits
+		uses   []use
+	}{
+		{
+			name:   "no limits",
+			limits: Limits{},
+			uses: []use{
+				{user: "a", command: "balance"},
+				{user: "a", command: "balance"},
+			},
+		},
+		{
+			name:   "command cooldown",
+			limits: Limits{Commands: map[string]time.Duration{"balance": 10 * time.Second}},
+			uses: []use{
+				{user: "a", command: "balance"},
+				{user: "a", command: "balance", at: 4 * time.Second, wait: 6 * time.Second},
+				{user: "b", command: "balance", at: 4 * time.Second},
+				{user: "a", command: "bet slots", at: 5 * time.Second},
+				{user: "a", command: "balance", at: 10 * time.Second},
+			},
+		},
+		{
+			name:   "blocked attempts do not extend the cooldown",
+			limits: Limits{Commands: map[string]time.Duration{"balance": 10 * time.Second}},
+			uses: []use{
+				{user: "a", command: "balance"},
+				{user: "a", command: "balance", at: 9 * time.Second, wait: time.Second},
+				{user: "a", command: "balance", at: 10 * time.Second},
+			},
+		},
+		{
+			name:   "group cooldown is shared by its subcommands",
+			limits: Limits{Commands: map[string]time.Duration{"bet": 5 * time.Second}},
+			uses: []use{
+				{user: "a", command: "bet slots"},
+				{user: "a", command: "bet crash", at: 2 * time.Second, wait: 3 * time.Second},
+			},
+		},
+		{
+			name: "subcommand entry overrides the group",
+			limits: Limits{Commands: map[string]time.Duration{
+				"bet":       5 * time.Second,
+				"bet crash": 30 * time.Second,
+			}},
+			uses: []use{
+				{user: "a", command: "bet slots"},
+				{user: "a", command: "bet crash", at: time.Second},
+				{user: "a", command: "bet slots", at: 5 * time.Second},
+				{user: "a", command: "bet crash", at: 6 * time.Second, wait: 25 * time.Second},
+			},
+		},
+		{
+			name:   "global rate limit warns once",
+			limits: Limits{Rate: 2, Window: 10 * time.Second},
+			uses: []use{
+				{user: "a", command: "balance"},
+				{user: "a", command: "bet slots", at: time.Second},
+				{user: "a", command: "balance", at: 2 * time.Second, wait: 8 * time.Second, global: true},
+				{user: "a", command: "balance", at: 3 * time.Second, wait: 7 * time.Second, global: true, silent: true},
+				{user: "b", command: "balance", at: 3 * time.Second},
+				{user: "a", command: "balance", at: 10 * time.Second},
+			},
+		},
+		{
+			name: "cooldown attempts count toward the rate limit",
+			limits: Limits{
+				Commands: map[string]time.Duration{"balance": time.Minute},
+				Rate:     3,
+				Window:   10 * time.Second,
+			},
+			uses: []use{
+				{user: "a", command: "balance"},
+				{user: "a", command: "balance", at: time.Second, wait: 59 * time.Second},
+				{user: "a", command: "balance", at: 2 * time.Second, wait: 58 * time.Second},
+				{user: "a", command: "bet slots", at: 3 * time.Second, wait: 7 * time.Second, global: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newCooldowns()
+			start := time.Now()
+			for n, u := range tt.uses {
+				wait, global, silent := c.take(tt.limits, u.user, commands[u.command], start.Add(u.at))
+				if wait != u.wait || global != u.global || silent != u.silent {
+					t.Errorf("use %d (%s %s at %v) = %v, %v, %v; want %v, %v, %v",
+						n, u.user, u.command, u.at, wait, global, silent, u.wait, u.global, u.silent)
+				}
+			}
+		})
+	}
+}
+
+func TestFormatWait(t *testing.T) {
+	tests := []struct {
+		wait time.Duration
+		want string
+	}{
+		{0, "1s"},
+		{300 * time.Millisecond, "1s"},
+		{42 * time.Second, "42s"},
+		{59*time.Second + time.Millisecond, "1m"},
+		{2 * time.Minute, "2m"},
+		{3*time.Minute + 5*time.Second, "3m 5s"},
+	}
+	for _, tt := range tests {
+		if got := formatWait(tt.wait); got != tt.want {
+			t.Errorf("formatWait(%v) = %q, want %q", tt.wait, got, tt.want)
+		}
+	}
+}
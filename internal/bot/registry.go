@@ -0,0 +1,503 @@
+package bot
+
+import (
+	"estudocoin/internal/i18n"
+	"estudocoin/internal/permissions"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Registry keeps every command and dispatches messages and interactions
+type Registry struct {
+	Prefix string
+
+	// Frozen reports whether a user's account is frozen; Economic commands
+	// are refused for frozen users. Nil disables the check.
+	Frozen func(userID string) bool
+
+	// Private reports whether a command path was made private by configuration,
+	// on top of Command.Private. Nil means only Command.Private counts.
+	Private func(path string) bool
+
+	// Limits returns the cooldown settings. It is called for every command,
+	// so a config reload applies right away. Nil disables cooldowns.
+	Limits func() Limits
+
+	commands  []*Command
+	byName    map[string]*Command // prefix names and aliases
+	cooldowns *cooldowns
+}
+
+// NewRegistry creates an empty registry for the given prefix
+func NewRegistry(prefix string) *Registry {
+	return &Registry{
+		Prefix:    prefix,
+		byName:    make(map[string]*Command),
+		cooldowns: newCooldowns(),
+	}
+}
+
+// Register adds commands. Names and aliases must be unique.
+func (r *Registry) Register(cmds ...*Command) {
+	for _, cmd := range cmds {
+		cmd.link()
+		r.commands = append(r.commands, cmd)
+		if cmd.SlashOnly {
+			continue
+		}
+		for _, name := range append([]string{cmd.Name}, cmd.Aliases...) {
+			r.addName(name, cmd)
+		}
+		r.addShortcuts(cmd)
+	}
+}
+
+func (r *Registry) addName(name string, cmd *Command) {
+	if other, ok := r.byName[name]; ok {
+		log.Panicf("command name %q used by both %q and %q", name, other.Path(), cmd.Path())
+	}
+	r.byName[name] = cmd
+}
+
+// addShortcuts registers the Shortcuts of the prefix subcommands of cmd
+func (r *Registry) addShortcuts(cmd *Command) {
+	for _, sub := range cmd.prefixSubcommands() {
+		for _, name := range sub.Shortcuts {
+			r.addName(name, sub)
+		}
+		r.addShortcuts(sub)
+	}
+}
+
+// Commands returns the registered commands in registration order
+func (r *Registry) Commands() []*Command {
+	return r.commands
+}
+
+// ApplicationCommands returns the slash definitions of every non prefix-only command
+func (r *Registry) ApplicationCommands() []*discordgo.ApplicationCommand {
+	var defs []*discordgo.ApplicationCommand
+	for _, cmd := range r.commands {
+		if !cmd.PrefixOnly {
+			defs = append(defs, cmd.ApplicationCommand())
+		}
+	}
+	return defs
+}
+
+// HandleMessage parses a prefixed message and runs the matching command.
+// Returns false when the message is not a known command.
+func (r *Registry) HandleMessage(s *discordgo.Session, m *discordgo.MessageCreate) bool {
+	if !strings.HasPrefix(m.Content, r.Prefix) {
+		return false
+	}
+
+	words := strings.Fields(strings.TrimPrefix(m.Content, r.Prefix))
+	if len(words) == 0 {
+		return false
+	}
+
+	cmd, ok := r.byName[strings.ToLower(words[0])]
+	if !ok {
+		return false
+	}
+	words = words[1:]
+
+	ctx := &Context{
+		Session:   s,
+		Message:   m,
+		Author:    m.Author,
+		ChannelID: m.ChannelID,
+		GuildID:   m.GuildID,
+		prefix:    r.Prefix,
+		options:   make(map[string]interface{}),
+	}
+
+	// Descend into subcommands
+	for len(cmd.Subcommands) > 0 {
+		var sub *Command
+		if len(words) > 0 {
+			sub = cmd.subcommand(words[0])
+		}
+		if sub == nil {
+			break
+		}
+		cmd = sub
+		words = words[1:]
+	}
+	ctx.Command = cmd
+	ctx.Args = words
+	ctx.private = r.isPrivate(cmd)
+
+	if !r.allowed(ctx) {
+		return true
+	}
+
+	if len(cmd.Subcommands) > 0 && cmd.Handler == nil {
+		// Group invoked without a valid subcommand
+		s.ChannelMessageSendEmbed(m.ChannelID, ctx.ErrorEmbed("Usage: `%s`", cmd.Usage(r.Prefix)))
+		return true
+	}
+
+	if err := r.parseArgs(ctx, words); err != nil {
+		s.ChannelMessageSendEmbed(m.ChannelID, ctx.ErrorEmbed("%s\nUsage: `%s`", ctx.Err(err), cmd.Usage(r.Prefix)))
+		return true
+	}
+
+	if cmd.Handler != nil && !r.throttled(ctx) {
+		cmd.Handler(ctx)
+	}
+	return true
+}
+
+// parseArgs fills ctx.options from the prefix words.
+// User options take mentions wherever they appear; the other options are positional.
+func (r *Registry) parseArgs(ctx *Context, words []string) error {
+	var positional []string
+	var mentions []string
+	for _, w := range words {
+		if _, ok := mentionID(w); ok {
+			mentions = append(mentions, w)
+		} else {
+			positional = append(positional, w)
+		}
+	}
+
+	for _, opt := range ctx.Command.Options {
+		if opt.Type == discordgo.ApplicationCommandOptionUser {
+			if len(mentions) == 0 {
+				if opt.Required {
+					return i18n.Errorf("Missing `%s`.", opt.Name)
+				}
+				continue
+			}
+			user, err := r.resolveUser(ctx, mentions[0])
+			if err != nil {
+				return i18n.Errorf("Invalid `%s`.", opt.Name)
+			}
+			ctx.options[opt.Name] = user
+			mentions = mentions[1:]
+			continue
+		}
+
+		if len(positional) == 0 {
+			if opt.Required {
+				return i18n.Errorf("Missing `%s`.", opt.Name)
+			}
+			continue
+		}
+
+		word := positional[0]
+		positional = positional[1:]
+		if opt.Rest {
+			word = strings.Join(append([]string{word}, positional...), " ")
+			positional = nil
+		}
+
+		value, err := parseValue(opt, word)
+		if err != nil {
+			return err
+		}
+		ctx.options[opt.Name] = value
+	}
+	return nil
+}
+
+func (r *Registry) resolveUser(ctx *Context, word string) (*discordgo.User, error) {
+	id, _ := mentionID(word)
+	for _, u := range ctx.Message.Mentions {
+		if u.ID == id {
+			return u, nil
+		}
+	}
+	return ctx.Session.User(id)
+}
+
+// mentionID extracts the user ID of a <@id> or <@!id> mention
+func mentionID(word string) (string, bool) {
+	if !strings.HasPrefix(word, "<@") || !strings.HasSuffix(word, ">") {
+		return "", false
+	}
+	id := strings.TrimPrefix(strings.TrimSuffix(word[2:], ">"), "!")
+	if id == "" || strings.HasPrefix(id, "&") {
+		return "", false
+	}
+	return id, true
+}
+
+func parseValue(opt *Option, word string) (interface{}, error) {
+	var value interface{}
+
+	switch opt.Type {
+	case discordgo.ApplicationCommandOptionInteger:
+		n, err := strconv.ParseInt(word, 10, 64)
+		if err != nil {
+			return nil, i18n.Errorf("`%s` must be a whole number.", opt.Name)
+		}
+		if err := checkRange(opt, float64(n)); err != nil {
+			return nil, err
+		}
+		value = n
+	case discordgo.ApplicationCommandOptionNumber:
+		f, err := strconv.ParseFloat(word, 64)
+		if err != nil {
+			return nil, i18n.Errorf("`%s` must be a number.", opt.Name)
+		}
+		if err := checkRange(opt, f); err != nil {
+			return nil, err
+		}
+		value = f
+	case discordgo.ApplicationCommandOptionBoolean:
+		b, err := strconv.ParseBool(word)
+		if err != nil {
+			return nil, i18n.Errorf("`%s` must be true or false.", opt.Name)
+		}
+		value = b
+	default:
+		value = word
+	}
+
+	if len(opt.Choices) == 0 {
+		return value, nil
+	}
+	for _, choice := range opt.Choices {
+		if strings.EqualFold(choice.Name, word) || strings.EqualFold(fmt.Sprint(choice.Value), word) {
+			// Parse the canonical value so the type matches the slash path
+			return parseValue(&Option{Name: opt.Name, Type: opt.Type}, fmt.Sprint(choice.Value))
+		}
+	}
+	names := make([]string, 0, len(opt.Choices))
+	for _, choice := range opt.Choices {
+		names = append(names, choice.Name)
+	}
+	return nil, i18n.Errorf("`%s` must be one of: %s.", opt.Name, strings.Join(names, ", "))
+}
+
+func checkRange(opt *Option, v float64) error {
+	if opt.MinValue != nil && v < *opt.MinValue {
+		return i18n.Errorf("`%s` must be at least %v.", opt.Name, *opt.MinValue)
+	}
+	if opt.MaxValue != 0 && v > opt.MaxValue {
+		return i18n.Errorf("`%s` must be at most %v.", opt.Name, opt.MaxValue)
+	}
+	return nil
+}
+
+// HandleInteraction runs the slash command of an application command interaction.
+// Returns false when the command is unknown.
+func (r *Registry) HandleInteraction(s *discordgo.Session, i *discordgo.InteractionCreate) bool {
+	if i.Type != discordgo.InteractionApplicationCommand {
+		return false
+	}
+
+	ctx, options := r.interactionContext(s, i)
+	if ctx == nil {
+		return false
+	}
+
+	for _, opt := range options {
+		switch opt.Type {
+		case discordgo.ApplicationCommandOptionInteger:
+			ctx.options[opt.Name] = opt.IntValue()
+		case discordgo.ApplicationCommandOptionNumber:
+			ctx.options[opt.Name] = opt.FloatValue()
+		case discordgo.ApplicationCommandOptionBoolean:
+			ctx.options[opt.Name] = opt.BoolValue()
+		case discordgo.ApplicationCommandOptionUser:
+			ctx.options[opt.Name] = opt.UserValue(s)
+		default:
+			ctx.options[opt.Name] = opt.StringValue()
+		}
+	}
+
+	if !r.allowed(ctx) {
+		return true
+	}
+
+	if ctx.Command.Handler != nil && !r.throttled(ctx) {
+		ctx.Command.Handler(ctx)
+	}
+	return true
+}
+
+// HandleAutocomplete answers an autocomplete interaction with the suggestions
+// of the focused option. Returns false when the command is unknown.
+func (r *Registry) HandleAutocomplete(s *discordgo.Session, i *discordgo.InteractionCreate) bool {
+	if i.Type != discordgo.InteractionApplicationCommandAutocomplete {
+		return false
+	}
+
+	ctx, options := r.interactionContext(s, i)
+	if ctx == nil {
+		return false
+	}
+
+	// Values of partially typed options may not match their declared type yet,
+	// so only string options are exposed to the handler
+	var focused *discordgo.ApplicationCommandInteractionDataOption
+	for _, opt := range options {
+		if opt.Focused {
+			focused = opt
+		} else if opt.Type == discordgo.ApplicationCommandOptionString {
+			ctx.options[opt.Name] = opt.StringValue()
+		}
+	}
+
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	if focused != nil {
+		if opt := ctx.Command.option(focused.Name); opt != nil && opt.Autocomplete != nil {
+			choices = opt.Autocomplete(ctx, fmt.Sprint(focused.Value))
+		}
+	}
+	if len(choices) > 25 {
+		choices = choices[:25]
+	}
+
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+		Data: &discordgo.InteractionResponseData{Choices: choices},
+	})
+	return true
+}
+
+// interactionContext finds the (sub)command of an interaction and returns its
+// context along with the options addressed to it
+func (r *Registry) interactionContext(s *discordgo.Session, i *discordgo.InteractionCreate) (*Context, []*discordgo.ApplicationCommandInteractionDataOption) {
+	data := i.ApplicationCommandData()
+	var cmd *Command
+	for _, c := range r.commands {
+		if c.Name == data.Name && !c.PrefixOnly {
+			cmd = c
+			break
+		}
+	}
+	if cmd == nil {
+		return nil, nil
+	}
+
+	// Descend into subcommands
+	options := data.Options
+	for len(options) > 0 && (options[0].Type == discordgo.ApplicationCommandOptionSubCommand ||
+		options[0].Type == discordgo.ApplicationCommandOptionSubCommandGroup) {
+		var sub *Command
+		for _, c := range cmd.Subcommands {
+			if c.Name == options[0].Name {
+				sub = c
+				break
+			}
+		}
+		if sub == nil {
+			return nil, nil
+		}
+		cmd = sub
+		options = options[0].Options
+	}
+
+	user := i.User
+	if i.Member != nil {
+		user = i.Member.User
+	}
+
+	return &Context{
+		Session:     s,
+		Interaction: i,
+		Command:     cmd,
+		Author:      user,
+		ChannelID:   i.ChannelID,
+		GuildID:     i.GuildID,
+		prefix:      r.Prefix,
+		options:     make(map[string]interface{}),
+		private:     r.isPrivate(cmd),
+	}, options
+}
+
+// isPrivate reports whether the command or one of its parents is private
+func (r *Registry) isPrivate(cmd *Command) bool {
+	for c := cmd; c != nil; c = c.parent {
+		if c.Private || (r.Private != nil && r.Private(c.Path())) {
+			return true
+		}
+	}
+	return false
+}
+
+// throttled applies the cooldowns and tells the user how long to wait.
+// Admins are never throttled.
+func (r *Registry) throttled(ctx *Context) bool {
+	if r.Limits == nil || ctx.Level() == permissions.Admin {
+		return false
+	}
+
+	wait, global, silent := r.cooldowns.take(r.Limits(), ctx.Author.ID, ctx.Command, time.Now())
+	if wait <= 0 {
+		return false
+	}
+
+	// Repeated spam past the global limit is ignored in prefix mode;
+	// interactions always need an answer
+	if silent && !ctx.IsSlash() {
+		return true
+	}
+	if global {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("You're sending commands too fast. Try again in %s.", formatWait(wait)))
+	} else {
+		name := r.Prefix + ctx.Command.Path()
+		if ctx.IsSlash() {
+			name = "/" + ctx.Command.Path()
+		}
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Slow down! You can use `%s` again in %s.", name, formatWait(wait)))
+	}
+	return true
+}
+
+// allowed checks the access level and permissions declared on the command and its parents
+func (r *Registry) allowed(ctx *Context) bool {
+	var required int64
+	level := permissions.Everyone
+	economic := false
+	for c := ctx.Command; c != nil; c = c.parent {
+		required |= c.Permissions
+		if c.Level > level {
+			level = c.Level
+		}
+		economic = economic || c.Economic
+	}
+
+	if economic && r.Frozen != nil && r.Frozen(ctx.Author.ID) {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Your account is frozen. Contact a moderator."))
+		return false
+	}
+	if required == 0 && level == permissions.Everyone {
+		return true
+	}
+
+	if ctx.Level() < level {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("This command requires the **%s** level.", ctx.T(level.String())))
+		return false
+	}
+	if required == 0 {
+		return true
+	}
+
+	var perms int64
+	if ctx.IsSlash() {
+		if ctx.Interaction.Member != nil {
+			perms = ctx.Interaction.Member.Permissions
+		}
+	} else {
+		perms, _ = ctx.Session.UserChannelPermissions(ctx.Author.ID, ctx.ChannelID)
+	}
+
+	// Bot admins count as having every Discord permission the command asks for
+	if perms&discordgo.PermissionAdministrator != 0 || perms&required == required || ctx.Level() == permissions.Admin {
+		return true
+	}
+
+	ctx.ReplyEphemeral(ctx.ErrorEmbed("You don't have permission to use this command."))
+	return false
+}
@@ -0,0 +1,130 @@
+package bot
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Sync makes the slash commands of a scope (guildID "" = global) match the
+// registry. Unchanged scopes are left alone; otherwise every command is sent
+// in a single bulk overwrite, which also deletes commands that no longer exist.
+func (r *Registry) Sync(s *discordgo.Session, appID, guildID string) error {
+	desired := r.ApplicationCommands()
+
+	existing, err := s.ApplicationCommands(appID, guildID)
+	if err != nil {
+		return fmt.Errorf("listing %s commands: %w", scopeName(guildID), err)
+	}
+
+	added, changed, removed := diffCommands(desired, existing)
+	if len(added)+len(changed)+len(removed) == 0 {
+		log.Printf("Slash commands (%s) are up to date (%d commands)", scopeName(guildID), len(desired))
+		return nil
+	}
+
+	if _, err := s.ApplicationCommandBulkOverwrite(appID, guildID, desired); err != nil {
+		return fmt.Errorf("overwriting %s commands: %w", scopeName(guildID), err)
+	}
+
+	log.Printf("Slash commands (%s) updated: added [%s], changed [%s], removed [%s]", scopeName(guildID),
+		strings.Join(added, ", "), strings.Join(changed, ", "), strings.Join(removed, ", "))
+	return nil
+}
+
+// Unregister deletes every slash command of a scope (guildID "" = global)
+func Unregister(s *discordgo.Session, appID, guildID string) error {
+	if _, err := s.ApplicationCommandBulkOverwrite(appID, guildID, []*discordgo.ApplicationCommand{}); err != nil {
+		return fmt.Errorf("removing %s commands: %w", scopeName(guildID), err)
+	}
+	log.Printf("Slash commands (%s) removed", scopeName(guildID))
+	return nil
+}
+
+func scopeName(guildID string) string {
+	if guildID == "" {
+		return "global"
+	}
+	return "guild " + guildID
+}
+
+// diffCommands compares the wanted definitions with the ones Discord has,
+// returning the command names on each side of the change
+func diffCommands(desired, existing []*discordgo.ApplicationCommand) (added, changed, removed []string) {
+	current := make(map[string]*discordgo.ApplicationCommand, len(existing))
+	for _, cmd := range existing {
+		current[cmd.Name] = cmd
+	}
+
+	for _, cmd := range desired {
+		old, ok := current[cmd.Name]
+		switch {
+		case !ok:
+			added = append(added, cmd.Name)
+		case !sameCommand(cmd, old):
+			changed = append(changed, cmd.Name)
+		}
+		delete(current, cmd.Name)
+	}
+
+	for name := range current {
+		removed = append(removed, name)
+	}
+	sort.Strings(removed)
+	return added, changed, removed
+}
+
+func sameCommand(a, b *discordgo.ApplicationCommand) bool {
+	if a.Name != b.Name || a.Description != b.Description {
+		return false
+	}
+	if permissionBits(a.DefaultMemberPermissions) != permissionBits(b.DefaultMemberPermissions) {
+		return false
+	}
+	return sameOptions(a.Options, b.Options)
+}
+
+// permissionBits treats a missing value as "everyone"
+func permissionBits(p *int64) int64 {
+	if p == nil {
+		return -1
+	}
+	return *p
+}
+
+func sameOptions(a, b []*discordgo.ApplicationCommandOption) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		x, y := a[i], b[i]
+		if x.Type != y.Type || x.Name != y.Name || x.Description != y.Description ||
+			x.Required != y.Required || x.Autocomplete != y.Autocomplete ||
+			x.MaxValue != y.MaxValue || x.MaxLength != y.MaxLength {
+			return false
+		}
+		if (x.MinValue == nil) != (y.MinValue == nil) || (x.MinValue != nil && *x.MinValue != *y.MinValue) {
+			return false
+		}
+		if !sameChoices(x.Choices, y.Choices) || !sameOptions(x.Options, y.Options) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameChoices(a, b []*discordgo.ApplicationCommandOptionChoice) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		// Discord returns numeric values as float64; compare their text form
+		if a[i].Name != b[i].Name || fmt.Sprint(a[i].Value) != fmt.Sprint(b[i].Value) {
+			return false
+		}
+	}
+	return true
+}
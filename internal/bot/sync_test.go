@@ -0,0 +1,141 @@
+package bot
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestDiffCommands(t *testing.T) {
+	admin := int64(discordgo.PermissionManageGuild)
+	one, two := 1.0, 2.0
+	amount := func(min *float64) *discordgo.ApplicationCommandOption {
+		return &discordgo.ApplicationCommandOption{
+			Type: discordgo.ApplicationCommandOptionInteger, Name: "amount", Description: "Amount", Required: true, MinValue: min,
+		}
+	}
+	side := func(values ...interface{}) *discordgo.ApplicationCommandOption {
+		opt := &discordgo.ApplicationCommandOption{Type: discordgo.ApplicationCommandOptionString, Name: "side", Description: "Side"}
+		for _, v := range values {
+			opt.Choices = append(opt.Choices, &discordgo.ApplicationCommandOptionChoice{Name: fmt.Sprint(v), Value: v})
+		}
+		return opt
+	}
+	cmd := func(name, description string, options ...*discordgo.ApplicationCommandOption) *discordgo.ApplicationCommand {
+		return &discordgo.ApplicationCommand{Name: name, Description: description, Options: options}
+	}
+	withPermissions := func(c *discordgo.ApplicationCommand, p *int64) *discordgo.ApplicationCommand {
+		c.DefaultMemberPermissions = p
+		return c
+	}
+	group := func(name string, subs ...*discordgo.ApplicationCommandOption) *discordgo.ApplicationCommand {
+		for _, s := range subs {
+			s.Type = discordgo.ApplicationCommandOptionSubCommand
+		}
+		return cmd(name, name, subs...)
+	}
+	sub := func(name string, options ...*discordgo.ApplicationCommandOption) *discordgo.ApplicationCommandOption {
+		return &discordgo.ApplicationCommandOption{Name: name, Description: name, Options: options}
+	}
+
+	tests := []struct {
+		name     string
+		desired  []*discordgo.ApplicationCommand
+		existing []*discordgo.ApplicationCommand
+		added    []string
+		changed  []string
+		removed  []string
+	}{
+		{
+			name:    "first sync adds everything",
+			desired: []*discordgo.ApplicationCommand{cmd("balance", "Balance"), cmd("pay", "Pay")},
+			added:   []string{"balance", "pay"},
+		},
+		{
+			name:     "identical commands",
+			desired:  []*discordgo.ApplicationCommand{cmd("pay", "Pay", amount(&one))},
+			existing: []*discordgo.ApplicationCommand{cmd("pay", "Pay", amount(&one))},
+		},
+		{
+			name:     "stale commands are removed in order",
+			desired:  []*discordgo.ApplicationCommand{cmd("pay", "Pay")},
+			existing: []*discordgo.ApplicationCommand{cmd("zeta", "Z"), cmd("pay", "Pay"), cmd("alpha", "A")},
+			removed:  []string{"alpha", "zeta"},
+		},
+		{
+			name:     "description change",
+			desired:  []*discordgo.ApplicationCommand{cmd("pay", "Send coins")},
+			existing: []*discordgo.ApplicationCommand{cmd("pay", "Pay")},
+			changed:  []string{"pay"},
+		},
+		{
+			name:     "min value change",
+			desired:  []*discordgo.ApplicationCommand{cmd("pay", "Pay", amount(&two))},
+			existing: []*discordgo.ApplicationCommand{cmd("pay", "Pay", amount(&one))},
+			changed:  []string{"pay"},
+		},
+		{
+			name:     "min value removed",
+			desired:  []*discordgo.ApplicationCommand{cmd("pay", "Pay", amount(nil))},
+			existing: []*discordgo.ApplicationCommand{cmd("pay", "Pay", amount(&one))},
+			changed:  []string{"pay"},
+		},
+		{
+			name:     "option order matters",
+			desired:  []*discordgo.ApplicationCommand{cmd("flip", "Flip", side("heads"), amount(nil))},
+			existing: []*discordgo.ApplicationCommand{cmd("flip", "Flip", amount(nil), side("heads"))},
+			changed:  []string{"flip"},
+		},
+		{
+			name:     "numeric choices come back as float64",
+			desired:  []*discordgo.ApplicationCommand{cmd("dice", "Dice", side(6, 20))},
+			existing: []*discordgo.ApplicationCommand{cmd("dice", "Dice", side(6.0, 20.0))},
+		},
+		{
+			name:     "choice change",
+			desired:  []*discordgo.ApplicationCommand{cmd("flip", "Flip", side("heads", "tails"))},
+			existing: []*discordgo.ApplicationCommand{cmd("flip", "Flip", side("heads"))},
+			changed:  []string{"flip"},
+		},
+		{
+			name:     "subcommand option change",
+			desired:  []*discordgo.ApplicationCommand{group("bet", sub("slots", amount(&two)), sub("crash"))},
+			existing: []*discordgo.ApplicationCommand{group("bet", sub("slots", amount(&one)), sub("crash"))},
+			changed:  []string{"bet"},
+		},
+		{
+			name:     "permissions added",
+			desired:  []*discordgo.ApplicationCommand{withPermissions(cmd("admin", "Admin"), &admin)},
+			existing: []*discordgo.ApplicationCommand{cmd("admin", "Admin")},
+			changed:  []string{"admin"},
+		},
+		{
+			name:     "same permissions",
+			desired:  []*discordgo.ApplicationCommand{withPermissions(cmd("admin", "Admin"), &admin)},
+			existing: []*discordgo.ApplicationCommand{withPermissions(cmd("admin", "Admin"), &admin)},
+		},
+		{
+			name:     "mixed",
+			desired:  []*discordgo.ApplicationCommand{cmd("balance", "Balance"), cmd("pay", "Send coins"), cmd("loan", "Loan")},
+			existing: []*discordgo.ApplicationCommand{cmd("pay", "Pay"), cmd("balance", "Balance"), cmd("rob", "Rob")},
+			added:    []string{"loan"},
+			changed:  []string{"pay"},
+			removed:  []string{"rob"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, changed, removed := diffCommands(tt.desired, tt.existing)
+			if fmt.Sprint(added) != fmt.Sprint(tt.added) {
+				t.Errorf("added = %v, want %v", added, tt.added)
+			}
+			if fmt.Sprint(changed) != fmt.Sprint(tt.changed) {
+				t.Errorf("changed = %v, want %v", changed, tt.changed)
+			}
+			if fmt.Sprint(removed) != fmt.Sprint(tt.removed) {
+				t.Errorf("removed = %v, want %v", removed, tt.removed)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package commands
+
+import (
+	"context"
+	"estudocoin/internal/audit"
+	"estudocoin/internal/bot"
+	"estudocoin/internal/database"
+	"estudocoin/internal/games"
+	"estudocoin/internal/permissions"
+	"estudocoin/internal/service"
+	"estudocoin/pkg/config"
+	"estudocoin/pkg/utils"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Comandos administrativos: exigem o nível Admin (ver internal/permissions)
+var adminCommands = []*bot.Command{
+	{
+		Name:        "admin",
+		Description: "Bot administration",
+		Level:       permissions.Admin,
+		Subcommands: []*bot.Command{
+			{
+				Name:        "reload",
+				Description: "Reload config.json and economy.json",
+				Handler:     cmdAdminReload,
+			},
+			{
+				Name:        "coins",
+				Description: "Fix a user's balance",
+				Subcommands: []*bot.Command{
+					adminCoinsCommand("give", "Add coins to a user", service.Admin.GiveCoins),
+					adminCoinsCommand("take", "Remove coins from a user", service.Admin.TakeCoins),
+					adminCoinsCommand("set", "Set a user's balance", service.Admin.SetCoins),
+				},
+			},
+			adminUserCommand("freeze", "Block transfers, games, trading and API use", service.Admin.Freeze),
+			adminUserCommand("unfreeze", "Lift a freeze", service.Admin.Unfreeze),
+			adminUserCommand("reset", "Wipe balance, holdings and loans", service.Admin.Reset),
+			{
+				Name:        "audit",
+				Description: "Recent admin actions on a user",
+				Options: []*bot.Option{
+					{Name: "user", Description: "The user", Type: discordgo.ApplicationCommandOptionUser, Required: true},
+				},
+				Handler: cmdAdminAudit,
+			},
+			{
+				Name:        "limits",
+				Description: "A user's gambling limits (read-only)",
+				Options: []*bot.Option{
+					{Name: "user", Description: "The user", Type: discordgo.ApplicationCommandOptionUser, Required: true},
+				},
+				Handler: cmdAdminLimits,
+			},
+		},
+	},
+}
+
+type adminCoinsAction func(ctx context.Context, adminID, targetID string, amount int, reason string) (*database.AdminAction, error)
+
+type adminUserAction func(ctx context.Context, adminID, targetID, reason string) (*database.AdminAction, error)
+
+// adminCoinsCommand monta give/take/set, que recebem usuário, valor e motivo
+func adminCoinsCommand(name, description string, action adminCoinsAction) *bot.Command {
+	minAmount := 1.0
+	if name == "set" {
+		minAmount = 0
+	}
+	return &bot.Command{
+		Name:        name,
+		Description: description,
+		Options: []*bot.Option{
+			{Name: "user", Description: "The user", Type: discordgo.ApplicationCommandOptionUser, Required: true},
+			{Name: "amount", Description: "Amount of coins", Type: discordgo.ApplicationCommandOptionInteger, Required: true, MinValue: bot.Min(minAmount)},
+			{Name: "reason", Description: "Why (kept in the audit log)", Type: discordgo.ApplicationCommandOptionString, Required: true, Rest: true},
+		},
+		Handler: func(ctx *bot.Context) {
+			target := ctx.User("user")
+			entry, err := action(context.Background(), ctx.Author.ID, target.ID, ctx.Int("amount"), ctx.String("reason"))
+			finishAdminAction(ctx, entry, err)
+		},
+	}
+}
+
+// adminUserCommand monta freeze/unfreeze/reset, que recebem usuário e motivo opcional
+func adminUserCommand(name, description string, action adminUserAction) *bot.Command {
+	return &bot.Command{
+		Name:        name,
+		Description: description,
+		Options: []*bot.Option{
+			{Name: "user", Description: "The user", Type: discordgo.ApplicationCommandOptionUser, Required: true},
+			{Name: "reason", Description: "Why (kept in the audit log)", Type: discordgo.ApplicationCommandOptionString, Rest: true},
+		},
+		Handler: func(ctx *bot.Context) {
+			target := ctx.User("user")
+			entry, err := action(context.Background(), ctx.Author.ID, target.ID, ctx.String("reason"))
+			finishAdminAction(ctx, entry, err)
+		},
+	}
+}
+
+// finishAdminAction responde ao admin e publica a ação no mod-log e na auditoria
+func finishAdminAction(ctx *bot.Context, entry *database.AdminAction, err error) {
+	if err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(ctx.Err(err)))
+		return
+	}
+
+	log.Printf("Admin %s: %s on %s (amount %d, balance %d -> %d): %s",
+		entry.AdminID, entry.Action, entry.TargetID, entry.Amount, entry.BalanceBefore, entry.BalanceAfter, entry.Reason)
+
+	ctx.ReplyEphemeral(audit.AdminActionEmbed(entry))
+	audit.AdminAction(entry)
+}
+
+func cmdAdminAudit(ctx *bot.Context) {
+	target := ctx.User("user")
+	actions, err := service.Admin.History(target.ID, 10)
+	if err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(ctx.Err(err)))
+		return
+	}
+
+	status := ""
+	if database.IsFrozen(target.ID) {
+		status = ctx.T("🧊 **Account frozen**") + "\n\n"
+	}
+	if len(actions) == 0 {
+		ctx.ReplyEphemeral(utils.InfoEmbed(ctx.T("Audit Log"), status+ctx.T("No admin actions on <@%s>.", target.ID)))
+		return
+	}
+
+	var sb strings.Builder
+	sb.WriteString(status)
+	for _, a := range actions {
+		sb.WriteString(ctx.T("<t:%d:d> **%s** by <@%s>", a.CreatedAt.Unix(), ctx.T(audit.AdminActionLabel(a.Action)), a.AdminID))
+		if a.Amount != 0 {
+			sb.WriteString(fmt.Sprintf(" (%d)", a.Amount))
+		}
+		sb.WriteString(fmt.Sprintf(" · %d → %d", a.BalanceBefore, a.BalanceAfter))
+		if a.Reason != "" {
+			sb.WriteString(" · " + a.Reason)
+		}
+		sb.WriteString("\n")
+	}
+	ctx.ReplyEphemeral(utils.InfoEmbed(ctx.T("Audit Log: %s", target.Username), sb.String()))
+}
+
+// cmdAdminLimits só mostra os limites: eles são do usuário e nem um admin os afrouxa
+func cmdAdminLimits(ctx *bot.Context) {
+	target := ctx.User("user")
+	embed := games.LimitsEmbed(ctx.Locale(), target.ID)
+	embed.Title = ctx.T("🛡️ Gambling Limits: %s", target.Username)
+	ctx.ReplyEphemeral(embed)
+}
+
+func cmdAdminReload(ctx *bot.Context) {
+	if err := config.Reload(); err != nil {
+		log.Printf("Config reload by %s failed: %v", ctx.Author.ID, err)
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Reload failed, keeping the current configuration:\n%s", err.Error()))
+		return
+	}
+
+	log.Printf("Config reloaded by %s", ctx.Author.ID)
+	ctx.ReplyEphemeral(utils.SuccessEmbed(ctx.T("Configuration Reloaded"),
+		ctx.T("config.json and economy.json were reloaded.\nDatabase and API port changes still need a restart.")))
+}
@@ -1,9 +1,9 @@
 package commands
 
 import (
+	"estudocoin/internal/bot"
 	"estudocoin/internal/database"
 	"estudocoin/pkg/utils"
-	"fmt"
 	"strings"
 	"time"
 
@@ -11,80 +11,111 @@ import (
 	"github.com/google/uuid"
 )
 
-func HandleSlashApiKey(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	options := i.ApplicationCommandData().Options
-	subCommand := options[0].Name
-	userID := i.Member.User.ID
-
-	switch subCommand {
-	case "create":
-		// Create a new key
-		key := uuid.New().String()
-		name := "My Key"
-		if len(options[0].Options) > 0 {
-			name = options[0].Options[0].StringValue()
-		}
-
-		err := database.CreateAPIKey(key, userID, name)
-		if err != nil {
-			respondEmbed(s, i, utils.ErrorEmbed("Error creating API key."))
-			return
-		}
-
-		// Send via DM
-		channel, err := s.UserChannelCreate(userID)
-		if err != nil {
-			respondEmbed(s, i, utils.ErrorEmbed("I cannot DM you. Please open your DMs."))
-			return
-		}
-
-		msg, err := s.ChannelMessageSend(channel.ID, fmt.Sprintf("🔑 **Your API Key** (%s)\n\n`%s`\n\n⚠️ This message will be deleted in 60 seconds.", name, key))
-		
-		if err == nil {
-			respondEmbed(s, i, utils.SuccessEmbed("Check your DM!", "I sent your API Key securely."))
-			
-			// Auto-delete routine
-			go func() {
-				time.Sleep(60 * time.Second)
-				s.ChannelMessageDelete(channel.ID, msg.ID)
-			}()
-		} else {
-			respondEmbed(s, i, utils.ErrorEmbed("Failed to send DM."))
-		}
-
-	case "list":
-		keys, err := database.ListAPIKeys(userID)
-		if err != nil {
-			respondEmbed(s, i, utils.ErrorEmbed("Error listing keys."))
-			return
-		}
-
-		if len(keys) == 0 {
-			respondEmbed(s, i, utils.InfoEmbed("No Keys", "You don't have any API keys."))
-			return
-		}
-
-		var desc strings.Builder
-		for _, k := range keys {
-			masked := k.Key[:5] + "..."
-			desc.WriteString(fmt.Sprintf("**%s**: `%s` (Created: %s)\n", k.Name, masked, k.CreatedAt.Format("2006-01-02")))
-		}
-		
-		respondEmbed(s, i, utils.GoldEmbed("Your API Keys", desc.String()))
-
-	case "delete":
-		prefix := options[0].Options[0].StringValue()
-		if len(prefix) < 5 {
-			respondEmbed(s, i, utils.ErrorEmbed("Provide at least the first 5 characters of the key."))
-			return
-		}
-
-		err := database.DeleteAPIKey(userID, prefix)
-		if err != nil {
-			respondEmbed(s, i, utils.ErrorEmbed("Error deleting key."))
-			return
-		}
-		
-		respondEmbed(s, i, utils.SuccessEmbed("Key Deleted", "If a key matched that prefix, it has been revoked."))
+// API keys are private and slash-only so the replies never leak into a public channel
+var apiKeyCommands = []*bot.Command{
+	{
+		Name:        "apikey",
+		Description: "Manage your API Keys",
+		SlashOnly:   true,
+		Private:     true,
+		Subcommands: []*bot.Command{
+			{
+				Name:        "create",
+				Description: "Create a new API Key (Sent via DM)",
+				Options: []*bot.Option{
+					{Name: "name", Description: "Optional name for the key", Type: discordgo.ApplicationCommandOptionString},
+				},
+				Handler: cmdApiKeyCreate,
+			},
+			{
+				Name:        "list",
+				Description: "List your active API Keys",
+				Handler:     cmdApiKeyList,
+			},
+			{
+				Name:        "delete",
+				Description: "Delete an API Key",
+				Options: []*bot.Option{
+					{Name: "prefix", Description: "The first few characters of the key to delete", Type: discordgo.ApplicationCommandOptionString, Required: true},
+				},
+				Handler: cmdApiKeyDelete,
+			},
+		},
+	},
+}
+
+func cmdApiKeyCreate(ctx *bot.Context) {
+	s := ctx.Session
+	userID := ctx.Author.ID
+
+	// Create a new key
+	key := uuid.New().String()
+	name := ctx.T("My Key")
+	if ctx.Has("name") {
+		name = ctx.String("name")
+	}
+
+	err := database.CreateAPIKey(key, userID, name)
+	if err != nil {
+		ctx.Reply(ctx.ErrorEmbed("Error creating API key."))
+		return
+	}
+
+	// Send via DM
+	channel, err := s.UserChannelCreate(userID)
+	if err != nil {
+		ctx.Reply(ctx.ErrorEmbed("I cannot DM you. Please open your DMs."))
+		return
 	}
+
+	msg, err := s.ChannelMessageSend(channel.ID, ctx.T("🔑 **Your API Key** (%s)\n\n`%s`\n\n⚠️ This message will be deleted in 60 seconds.", name, key))
+	if err != nil {
+		ctx.Reply(ctx.ErrorEmbed("Failed to send DM."))
+		return
+	}
+
+	ctx.Reply(utils.SuccessEmbed(ctx.T("Check your DM!"), ctx.T("I sent your API Key securely.")))
+
+	// Auto-delete routine
+	go func() {
+		time.Sleep(60 * time.Second)
+		s.ChannelMessageDelete(channel.ID, msg.ID)
+	}()
+}
+
+func cmdApiKeyList(ctx *bot.Context) {
+	keys, err := database.ListAPIKeys(ctx.Author.ID)
+	if err != nil {
+		ctx.Reply(ctx.ErrorEmbed("Error listing keys."))
+		return
+	}
+
+	if len(keys) == 0 {
+		ctx.Reply(utils.InfoEmbed(ctx.T("No Keys"), ctx.T("You don't have any API keys.")))
+		return
+	}
+
+	var desc strings.Builder
+	for _, k := range keys {
+		masked := k.Key[:5] + "..."
+		desc.WriteString(ctx.T("**%s**: `%s` (Created: %s)\n", k.Name, masked, k.CreatedAt.Format("2006-01-02")))
+	}
+
+	ctx.Reply(utils.GoldEmbed(ctx.T("Your API Keys"), desc.String()))
+}
+
+func cmdApiKeyDelete(ctx *bot.Context) {
+	prefix := ctx.String("prefix")
+	if len(prefix) < 5 {
+		ctx.Reply(ctx.ErrorEmbed("Provide at least the first 5 characters of the key."))
+		return
+	}
+
+	err := database.DeleteAPIKey(ctx.Author.ID, prefix)
+	if err != nil {
+		ctx.Reply(ctx.ErrorEmbed("Error deleting key."))
+		return
+	}
+
+	ctx.Reply(utils.SuccessEmbed(ctx.T("Key Deleted"), ctx.T("If a key matched that prefix, it has been revoked.")))
 }
@@ -1,25 +1,30 @@
 package commands
 
 import (
+	"estudocoin/internal/database"
 	"estudocoin/internal/games"
+	"estudocoin/internal/i18n"
 	"estudocoin/pkg/config"
-	"estudocoin/pkg/utils"
 	"strings"
 
 	"github.com/bwmarrin/discordgo"
 )
 
+// wagerButtons são os botões que apostam mais moedas; contas congeladas não podem usá-los
+// (as apostas dos jogos também são barradas em games.CheckStake)
+var wagerButtons = []string{"bj_double_", "bj_insurance_", "bjt_double_", "bjt_split_", "rr_accept_", "rr_join_", "duel_accept_", "slots_spin_", "event_bet_", "poker_call_", "poker_raise_", "poker_allin_", "trade_buy_"}
+
 func ComponentsHandler(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	if i.Type != discordgo.InteractionMessageComponent {
 		return
 	}
 
 	// Check if channel is allowed
-	if !config.Bot.IsChannelAllowed(i.ChannelID) {
+	if !config.Bot().IsChannelAllowed(i.ChannelID) {
 		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
-				Embeds: []*discordgo.MessageEmbed{utils.ErrorEmbed("❌ This bot can only be used in designated channels.")},
+				Embeds: []*discordgo.MessageEmbed{i18n.ForInteraction(i).ErrorEmbed("❌ This bot can only be used in designated channels.")},
 				Flags:  discordgo.MessageFlagsEphemeral,
 			},
 		})
@@ -28,8 +33,21 @@ func ComponentsHandler(s *discordgo.Session, i *discordgo.InteractionCreate) {
 
 	customID := i.MessageComponentData().CustomID
 
+	for _, prefix := range wagerButtons {
+		if strings.HasPrefix(customID, prefix) && database.IsFrozen(interactionUser(i).ID) {
+			respondTradeEphemeral(s, i, i18n.ForInteraction(i).T(games.FrozenMessage))
+			return
+		}
+	}
+
 	if strings.HasPrefix(customID, "aviator_stop_") {
 		games.HandleButton(s, i)
+	} else if strings.HasPrefix(customID, "aviator_round_") {
+		games.HandleAviatorRoundButton(s, i)
+	} else if strings.HasPrefix(customID, "bjt_") {
+		games.HandleBlackjackTableButton(s, i)
+	} else if strings.HasPrefix(customID, "poker_") {
+		games.HandlePokerButton(s, i)
 	} else if strings.HasPrefix(customID, "cup_") {
 		games.HandleCupInteraction(s, i)
 	} else if strings.HasPrefix(customID, "bj_hit_") {
@@ -46,6 +64,8 @@ func ComponentsHandler(s *discordgo.Session, i *discordgo.InteractionCreate) {
 		games.HandleBlackjackInsurance(s, i, userID)
 	} else if strings.HasPrefix(customID, "rr_") {
 		games.HandleRussianRouletteInteraction(s, i)
+	} else if strings.HasPrefix(customID, "duel_") {
+		games.HandleDuelInteraction(s, i)
 	} else if strings.HasPrefix(customID, "slots_spin_") {
 		games.HandleSlotsInteraction(s, i)
 	} else if strings.HasPrefix(customID, "help_nav_") {
@@ -53,8 +73,29 @@ func ComponentsHandler(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	} else if strings.HasPrefix(customID, "loan_accept_") {
 		loanID := strings.TrimPrefix(customID, "loan_accept_")
 		HandleLoanAccept(s, i, loanID)
+	} else if strings.HasPrefix(customID, "event_bet_") {
+		games.HandleEventBetButton(s, i)
+	} else if strings.HasPrefix(customID, "trade_buy_") {
+		HandleTradeConfirm(s, i, customID)
+	} else if strings.HasPrefix(customID, "trade_cancel_") {
+		HandleTradeCancel(s, i, strings.TrimPrefix(customID, "trade_cancel_"))
 	} else if strings.HasPrefix(customID, "loan_decline_") {
 		loanID := strings.TrimPrefix(customID, "loan_decline_")
 		HandleLoanDecline(s, i, loanID)
 	}
-}
\ No newline at end of file
+}
+func ModalHandler(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if i.Type != discordgo.InteractionModalSubmit {
+		return
+	}
+
+	customID := i.ModalSubmitData().CustomID
+
+	if customID == games.EventCreateModalID {
+		games.HandleEventCreateModal(s, i)
+	} else if strings.HasPrefix(customID, "event_betmodal_") {
+		games.HandleEventBetModal(s, i)
+	} else if strings.HasPrefix(customID, "poker_raisemodal_") {
+		games.HandlePokerRaiseModal(s, i)
+	}
+}
@@ -0,0 +1,194 @@
+package commands
+
+import (
+	"context"
+	"estudocoin/internal/bot"
+	"estudocoin/internal/i18n"
+	"estudocoin/internal/service"
+	"estudocoin/pkg/config"
+	"estudocoin/pkg/utils"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+var cryptoCommands = []*bot.Command{
+	{
+		Name:        "crypto",
+		Description: "Trade cryptocurrencies",
+		Subcommands: []*bot.Command{
+			{
+				Name:        "market",
+				Description: "View crypto prices",
+				Aliases:     []string{"list", "prices"},
+				Handler:     handleCryptoMarket,
+			},
+			{
+				Name:        "buy",
+				Description: "Buy crypto",
+				Economic:    true,
+				Options: []*bot.Option{
+					{Name: "symbol", Description: "Coin symbol (BTC, ETH...)", Type: discordgo.ApplicationCommandOptionString, Required: true, Autocomplete: cryptoSymbolChoices},
+					{Name: "amount", Description: "Coins to invest", Type: discordgo.ApplicationCommandOptionInteger, Required: true, MinValue: bot.Min(1)},
+				},
+				Handler: handleCryptoBuy,
+			},
+			{
+				Name:        "sell",
+				Description: "Sell crypto",
+				Economic:    true,
+				Options: []*bot.Option{
+					{Name: "symbol", Description: "Coin symbol (BTC, ETH...)", Type: discordgo.ApplicationCommandOptionString, Required: true, Autocomplete: cryptoHoldingChoices},
+					{Name: "amount", Description: "Amount of coins or \"all\"", Type: discordgo.ApplicationCommandOptionString, Required: true, Autocomplete: cryptoAmountChoices},
+				},
+				Handler: handleCryptoSell,
+			},
+			{
+				Name:        "portfolio",
+				Description: "View your crypto holdings",
+				Aliases:     []string{"p"},
+				Private:     true,
+				Handler:     handleCryptoPortfolio,
+			},
+		},
+		Handler: func(ctx *bot.Context) {
+			if len(ctx.Args) == 0 {
+				ctx.Reply(utils.InfoEmbed(ctx.T("Crypto Market"), ctx.T("Usage: `!crypto <market|buy|sell|portfolio>`")))
+				return
+			}
+			ctx.Reply(ctx.ErrorEmbed("Unknown subcommand. Use `market`, `buy`, `sell`, or `portfolio`."))
+		},
+	},
+}
+
+func handleCryptoMarket(ctx *bot.Context) {
+	quotes, err := service.Crypto.Quotes(context.Background())
+	if err != nil {
+		ctx.Reply(ctx.ErrorEmbed("Error fetching crypto prices. Try again later."))
+		return
+	}
+
+	var sb strings.Builder
+	sb.WriteString(ctx.T("**Major Cryptocurrencies:**") + "\n")
+	for _, q := range quotes {
+		if q.Type == "major" {
+			sb.WriteString(fmt.Sprintf("**%s** (%s): $%s\n", q.Name, q.Symbol, formatPrice(q.Price)))
+		}
+	}
+
+	sb.WriteString("\n" + ctx.T("**Meme Coins (High Volatility!):**") + "\n")
+	for _, q := range quotes {
+		if q.Type == "meme" {
+			sb.WriteString(fmt.Sprintf("**%s** (%s): $%s\n", q.Name, q.Symbol, formatPrice(q.Price)))
+		}
+	}
+
+	ctx.Reply(utils.GoldEmbed(ctx.T("Crypto Market"), sb.String()))
+}
+
+func formatPrice(price float64) string {
+	if price >= 1 {
+		return fmt.Sprintf("%.2f", price)
+	} else if price >= 0.01 {
+		return fmt.Sprintf("%.4f", price)
+	} else if price >= 0.0001 {
+		return fmt.Sprintf("%.6f", price)
+	}
+	return fmt.Sprintf("%.8f", price)
+}
+
+func handleCryptoBuy(ctx *bot.Context) {
+	// Verificar se a crypto existe
+	coin := service.Crypto.Coin(ctx.String("symbol"))
+	if coin == nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Invalid cryptocurrency symbol. Use `!crypto market` to see available options."))
+		return
+	}
+
+	sendBuyQuote(ctx, "crypto", coin.Symbol, ctx.Int("amount"))
+}
+
+func cryptoBoughtEmbed(loc i18n.Locale, order *service.Order) *discordgo.MessageEmbed {
+	// Mensagem especial para meme coins
+	emoji := "🚀"
+	warning := ""
+	if coin := service.Crypto.Coin(order.Symbol); coin != nil && coin.Type == "meme" {
+		emoji = "🎰"
+		warning = "\n" + loc.T("⚠️ **Meme coins are highly volatile! Invest at your own risk.**")
+	}
+
+	return utils.SuccessEmbed(loc.T("Crypto Purchase Successful!"),
+		loc.T("%s You bought **%s %s** for **%d %s** (at $%s/coin).%s",
+			emoji, service.FormatCryptoAmount(order.Quantity), order.Symbol, order.Amount, config.Bot().CurrencyName, formatPrice(order.Price), warning))
+}
+
+func handleCryptoSell(ctx *bot.Context) {
+	amountStr := ctx.String("amount")
+
+	// Verificar se a crypto existe
+	coin := service.Crypto.Coin(ctx.String("symbol"))
+	if coin == nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Invalid cryptocurrency symbol."))
+		return
+	}
+
+	var order *service.Order
+	var err error
+
+	if strings.ToLower(amountStr) == "all" {
+		order, err = service.Crypto.SellAll(context.Background(), ctx.Author.ID, coin.Symbol)
+	} else {
+		coins, perr := strconv.ParseFloat(amountStr, 64)
+		if perr != nil || coins <= 0 {
+			ctx.ReplyEphemeral(ctx.ErrorEmbed("Invalid amount."))
+			return
+		}
+		order, err = service.Crypto.Sell(context.Background(), ctx.Author.ID, coin.Symbol, coins)
+	}
+	if err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(ctx.Err(err)))
+		return
+	}
+
+	emoji := "💰"
+	if coin.Type == "meme" {
+		emoji = "🎰"
+	}
+
+	ctx.Reply(utils.SuccessEmbed(ctx.T("Crypto Sale Successful!"),
+		ctx.T("%s You sold **%s %s** for **%d %s** (at $%s/coin).",
+			emoji, service.FormatCryptoAmount(order.Quantity), order.Symbol, order.Amount, config.Bot().CurrencyName, formatPrice(order.Price))))
+}
+
+func handleCryptoPortfolio(ctx *bot.Context) {
+	// A carteira é mostrada só para o dono no slash
+	ctx.Defer(true)
+
+	portfolio, err := service.Crypto.Portfolio(context.Background(), ctx.Author.ID)
+	if err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(ctx.Err(err)))
+		return
+	}
+
+	if len(portfolio.Holdings) == 0 {
+		ctx.ReplyEphemeral(utils.InfoEmbed(ctx.T("Crypto Portfolio"), ctx.T("You have no cryptocurrency investments.")))
+		return
+	}
+
+	var sb strings.Builder
+	for _, h := range portfolio.Holdings {
+		emoji := "🟢"
+		if h.Type == "meme" {
+			emoji = "🔴"
+		}
+
+		sb.WriteString(ctx.T("%s **%s** (%s): %s coins (~%d %s @ $%s)\n",
+			emoji, h.Name, h.Symbol, service.FormatCryptoAmount(h.Quantity), h.Value, config.Bot().CurrencyName, formatPrice(h.Price)))
+	}
+
+	sb.WriteString(ctx.T("\n**Total Value**: ~%d %s", portfolio.TotalValue, config.Bot().CurrencyName))
+
+	ctx.ReplyEphemeral(utils.GoldEmbed(ctx.T("Your Crypto Portfolio"), sb.String()))
+}
@@ -1,126 +1,141 @@
 package commands
 
 import (
+	"context"
+	"errors"
+	"estudocoin/internal/bot"
 	"estudocoin/internal/database"
-	"estudocoin/internal/webhook"
+	"estudocoin/internal/i18n"
+	"estudocoin/internal/permissions"
+	"estudocoin/internal/service"
 	"estudocoin/pkg/config"
 	"estudocoin/pkg/utils"
 	"fmt"
 	"log"
-	"strconv"
 
 	"github.com/bwmarrin/discordgo"
 )
 
-func CmdDaily(s *discordgo.Session, m *discordgo.MessageCreate) {
-	userID := m.Author.ID
-	info := database.GetDailyStreakInfo(userID)
-
-	if !info.CanClaim {
-		discordTime := fmt.Sprintf("<t:%d:R>", info.NextDaily.Unix())
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed(fmt.Sprintf("You already collected your daily reward! Come back %s.", discordTime)))
-		return
+// dailyEmbed monta a resposta do daily para o Discord
+func dailyEmbed(loc i18n.Locale, claim *service.DailyClaim, err error) *discordgo.MessageEmbed {
+	if errors.Is(err, service.ErrDailyAlreadyClaimed) {
+		discordTime := fmt.Sprintf("<t:%d:R>", claim.NextDaily.Unix())
+		return loc.ErrorEmbed("You already collected your daily reward! Come back %s.", discordTime)
 	}
-
-	info, err := database.ClaimDaily(userID)
-	if err != nil {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Error claiming daily reward."))
-		return
-	}
-
-	// Adiciona as moedas
-	err = database.AddCoins(userID, info.Reward)
 	if err != nil {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Error adding coins."))
-		return
+		return loc.ErrorEmbed(loc.Err(err))
 	}
 
 	streakText := ""
-	if info.Streak > 0 {
-		streakText = fmt.Sprintf("\n\n🔥 **Streak: %d days**", info.Streak+1)
-		if info.Streak+1 >= 50 {
+	if claim.Streak > 1 {
+		streakText = loc.T("\n\n🔥 **Streak: %d days**", claim.Streak)
+		if claim.Streak >= 50 {
 			streakText += " (MAX)"
 		}
 	}
-	if info.MaxStreak > 0 {
-		streakText += fmt.Sprintf("\n🏆 Max Streak: %d", info.MaxStreak)
+	if claim.MaxStreak > 1 {
+		streakText += loc.T("\n🏆 Max Streak: %d", claim.MaxStreak)
 	}
 
-	s.ChannelMessageSendEmbed(m.ChannelID, utils.SuccessEmbed("Daily Collected!", 
-		fmt.Sprintf("You received **%d %s**!%s", info.Reward, config.Bot.CurrencyName, streakText)))
+	return utils.SuccessEmbed(loc.T("Daily Collected!"), 
+		loc.T("You received **%d %s**!%s", claim.Reward, config.Bot().CurrencyName, streakText))
 }
 
-func CmdBalance(s *discordgo.Session, m *discordgo.MessageCreate) {
-	targetUser := m.Author
-	if len(m.Mentions) > 0 {
-		targetUser = m.Mentions[0]
-	}
+var economyCommands = []*bot.Command{
+	{
+		Name:        "daily",
+		Description: "Collect your daily reward",
+		Handler:     CmdDaily,
+	},
+	{
+		Name:        "balance",
+		Description: "Check your or someone else's balance",
+		Aliases:     []string{"saldo", "coins", "money"},
+		Options: []*bot.Option{
+			{Name: "user", Description: "The user to check", Type: discordgo.ApplicationCommandOptionUser},
+		},
+		Handler: CmdBalance,
+	},
+	{
+		Name:        "leaderboard",
+		Description: "See the richest users",
+		Aliases:     []string{"top", "rank"},
+		Handler:     CmdLeaderboard,
+	},
+	{
+		Name:        "pay",
+		Description: "Transfer EstudoCoins to another user",
+		Aliases:     []string{"transfer", "pagar"},
+		Economic:    true,
+		Options: []*bot.Option{
+			{Name: "user", Description: "Recipient of the coins", Type: discordgo.ApplicationCommandOptionUser, Required: true},
+			{Name: "amount", Description: "Amount to transfer", Type: discordgo.ApplicationCommandOptionInteger, Required: true, MinValue: bot.Min(1)},
+		},
+		Handler: CmdPay,
+	},
+}
 
-	balance := database.GetBalance(targetUser.ID)
-	
-	// Debug log
-	log.Printf("[BALANCE] User: %s (ID: %s), Balance: %d", targetUser.Username, targetUser.ID, balance)
-	
-	s.ChannelMessageSendEmbed(m.ChannelID, utils.GoldEmbed("Balance", fmt.Sprintf("**%s** has **%d %s**.", targetUser.Username, balance, config.Bot.CurrencyName)))
+func CmdDaily(ctx *bot.Context) {
+	claim, err := service.Economy.ClaimDaily(context.Background(), ctx.Author.ID)
+	ctx.Reply(dailyEmbed(ctx.Locale(), claim, err))
 }
 
-func CmdPay(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
-	if len(m.Mentions) == 0 || len(args) < 2 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.InfoEmbed("Usage", "!pay @user <amount>"))
-		return
+func CmdBalance(ctx *bot.Context) {
+	targetUser := ctx.Author
+	if u := ctx.User("user"); u != nil {
+		targetUser = u
 	}
 
-	toUser := m.Mentions[0]
-	if toUser.ID == m.Author.ID {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("You cannot pay yourself."))
+	// Admins ainda enxergam saldos escondidos (para moderação)
+	if targetUser.ID != ctx.Author.ID && ctx.Level() < permissions.Admin && database.IsBalanceHidden(targetUser.ID) {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("**%s** keeps their balance private.", targetUser.Username))
 		return
 	}
 
-	var amount int
+	balance := database.GetBalance(targetUser.ID)
 	
-	// Find amount in args
-	found := false
-	for _, arg := range args {
-		if val, err := strconv.Atoi(arg); err == nil {
-			amount = val
-			found = true
-			break
-		}
-	}
+	// Debug log
+	log.Printf("[BALANCE] User: %s (ID: %s), Balance: %d", targetUser.Username, targetUser.ID, balance)
 	
-	if !found || amount <= 0 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Invalid amount."))
+	ctx.Reply(utils.GoldEmbed(ctx.T("Balance"), ctx.T("**%s** has **%d %s**.", targetUser.Username, balance, config.Bot().CurrencyName)))
+}
+
+func CmdPay(ctx *bot.Context) {
+	toUser := ctx.User("user")
+	amount := ctx.Int("amount")
+
+	if toUser.ID == ctx.Author.ID {
+		ctx.Reply(ctx.ErrorEmbed("You cannot pay yourself."))
 		return
 	}
 
-	err := database.TransferCoins(m.Author.ID, toUser.ID, amount)
-	if err != nil {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Insufficient funds or transaction error."))
+	if err := service.Economy.Transfer(context.Background(), ctx.Author.ID, toUser.ID, amount); err != nil {
+		ctx.Reply(ctx.ErrorEmbed("Insufficient funds or transaction error."))
 		return
 	}
 
-	// Trigger Webhook
-	webhook.SendTransferNotification(m.Author.ID, toUser.ID, amount)
-
-	s.ChannelMessageSendEmbed(m.ChannelID, utils.SuccessEmbed("Transfer Successful", fmt.Sprintf("You sent **%d %s** to **%s**.", amount, config.Bot.CurrencyName, toUser.Username)))
+	ctx.Reply(utils.SuccessEmbed(ctx.T("Transfer Successful"), ctx.T("You sent **%d %s** to **%s**.", amount, config.Bot().CurrencyName, toUser.Username)))
 }
 
-func CmdLeaderboard(s *discordgo.Session, m *discordgo.MessageCreate) {
+func CmdLeaderboard(ctx *bot.Context) {
+	// Buscar os nomes no Discord pode demorar
+	ctx.Defer(false)
+
 	users, err := database.GetLeaderboard(10)
 	if err != nil {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Could not retrieve leaderboard."))
+		ctx.Reply(ctx.ErrorEmbed("Could not retrieve leaderboard."))
 		return
 	}
 
 	if len(users) == 0 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.InfoEmbed("Leaderboard", "No users found."))
+		ctx.Reply(utils.InfoEmbed(ctx.T("Leaderboard"), ctx.T("No users found.")))
 		return
 	}
 
 	var description string
 	for i, u := range users {
 		// Try to get user from cache or API to display name
-		discordUser, err := s.User(u.ID)
+		discordUser, err := ctx.Session.User(u.ID)
 		name := u.ID
 		if err == nil {
 			name = discordUser.Username
@@ -128,10 +143,10 @@ func CmdLeaderboard(s *discordgo.Session, m *discordgo.MessageCreate) {
 		
 		// Mostrar patrimônio total com detalhes
 		description += fmt.Sprintf("**%d.** %s - **%d %s** 💰 (🪙 %d | 📈 %d)\n", 
-			i+1, name, u.TotalNetWorth, config.Bot.CurrencyName, u.Balance, u.StockValue)
+			i+1, name, u.TotalNetWorth, config.Bot().CurrencyName, u.Balance, u.StockValue)
 	}
 	
-	description += "\n💰 = Total | 🪙 = Wallet | 📈 = Stocks"
+	description += ctx.T("\n💰 = Total | 🪙 = Wallet | 📈 = Stocks")
 
-	s.ChannelMessageSendEmbed(m.ChannelID, utils.GoldEmbed("🏆 Richest Users (Net Worth)", description))
-}
\ No newline at end of file
+	ctx.Reply(utils.GoldEmbed(ctx.T("🏆 Richest Users (Net Worth)"), description))
+}
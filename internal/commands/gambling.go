@@ -1,39 +1,609 @@
 package commands
 
 import (
+	"estudocoin/internal/bot"
 	"estudocoin/internal/games"
+	"estudocoin/internal/permissions"
+	"estudocoin/pkg/config"
 	"estudocoin/pkg/utils"
+	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/bwmarrin/discordgo"
 )
 
-func CmdBet(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
-	if len(args) < 2 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.InfoEmbed("Gambling", "Usage: `!bet aviator <amount>`, `!bet cups <amount>`, `!bet blackjack <amount>`, `!bet slots <amount>`, or `!roulette @user <amount>`"))
+// betOption é a aposta usada por todos os jogos de cassino
+func betOption(name, description string, min float64) *bot.Option {
+	return &bot.Option{
+		Name:        name,
+		Description: description,
+		Type:        discordgo.ApplicationCommandOptionInteger,
+		Required:    true,
+		MinValue:    bot.Min(min),
+	}
+}
+
+var gamblingCommands = []*bot.Command{
+	{
+		Name:        "bet",
+		Description: "Play casino games",
+		Aliases:     []string{"apostar"},
+		Economic:    true,
+		Subcommands: []*bot.Command{
+			{
+				Name:        "aviator",
+				Description: "Play the Aviator crash game",
+				Options:     []*bot.Option{betOption("amount", fmt.Sprintf("Amount to bet (Min %d)", games.MinBet), games.MinBet)},
+				Handler: func(ctx *bot.Context) {
+					startGame(ctx, ctx.Int("amount"), games.StartAviatorText, games.StartAviatorInteraction)
+				},
+			},
+			{
+				Name:        "crash",
+				Description: "Bet on the channel's multiplayer Aviator flight",
+				Options: []*bot.Option{
+					betOption("amount", fmt.Sprintf("Amount to bet (Min %d)", games.MinBet), games.MinBet),
+					{Name: "auto", Description: "Cash out automatically at this multiplier (e.g. 2.5)", Type: discordgo.ApplicationCommandOptionNumber, MinValue: bot.Min(1.01), MaxValue: games.MaxAutoCashout},
+				},
+				Handler: cmdAviatorRound,
+			},
+			{
+				Name:        "cups",
+				Description: "Play the Cup Game (Double or Nothing)",
+				Options:     []*bot.Option{betOption("amount", fmt.Sprintf("Amount to bet (Min %d)", games.MinCupBet), games.MinCupBet)},
+				Handler: func(ctx *bot.Context) {
+					startGame(ctx, ctx.Int("amount"), games.StartCupGameText, games.StartCupGameInteraction)
+				},
+			},
+			{
+				Name:        "blackjack",
+				Description: "Play a game of Blackjack",
+				Aliases:     []string{"bj", "21"},
+				Options:     []*bot.Option{betOption("amount", "Amount to bet (Min 10)", 10)},
+				Handler: func(ctx *bot.Context) {
+					startGame(ctx, ctx.Int("amount"), games.StartBlackjackText, games.StartBlackjackGame)
+				},
+			},
+			{
+				Name:        "slots",
+				Description: "Spin the slot machine",
+				Aliases:     []string{"slot"},
+				Options:     []*bot.Option{betOption("amount", "Amount to bet (per line on the grid)", 1), slotsLinesOption()},
+				Handler:     cmdSlots,
+			},
+			{
+				Name:        "table",
+				Description: "Sit at the channel's blackjack table (up to 5 players)",
+				Aliases:     []string{"mesa"},
+				Options:     []*bot.Option{betOption("amount", fmt.Sprintf("Amount to bet (Min %d)", games.MinTableBet), games.MinTableBet)},
+				Handler:     cmdBlackjackTable,
+			},
+		},
+		Handler: func(ctx *bot.Context) {
+			ctx.Reply(utils.InfoEmbed(ctx.T("Gambling"), ctx.T("Usage: `!bet aviator <amount>`, `!bet crash <amount> [auto]`, `!bet cups <amount>`, `!bet blackjack <amount>`, `!bet slots <amount>`, `!bet table <amount>`, or `!roulette @user <amount>`")))
+		},
+	},
+	{
+		Name:        "blackjack",
+		Description: "Play a game of Blackjack",
+		Economic:    true,
+		Options:     []*bot.Option{betOption("bet", "Amount to bet (Min 10)", 10)},
+		Handler: func(ctx *bot.Context) {
+			startGame(ctx, ctx.Int("bet"), games.StartBlackjackText, games.StartBlackjackGame)
+		},
+	},
+	{
+		Name:        "slots",
+		Description: "Spin the slot machine",
+		Aliases:     []string{"slot"},
+		Economic:    true,
+		Options:     []*bot.Option{betOption("amount", "Amount to bet (per line on the grid)", 1), slotsLinesOption()},
+		Handler:     cmdSlots,
+	},
+	{
+		Name:        "jackpot",
+		Description: "Slots progressive jackpot and its latest winners",
+		Aliases:     []string{"acumulado"},
+		Handler: func(ctx *bot.Context) {
+			ctx.Reply(games.SlotsJackpotEmbed(ctx.Locale()))
+		},
+	},
+	{
+		Name:        "poker",
+		Description: "Play Texas Hold'em against other players",
+		Economic:    true,
+		Subcommands: []*bot.Command{
+			{
+				Name:        "join",
+				Description: "Buy in at the channel's poker table",
+				Aliases:     []string{"sentar"},
+				Options:     []*bot.Option{betOption("buy_in", "Coins to bring to the table", 1)},
+				Handler:     cmdPokerJoin,
+			},
+			{
+				Name:        "leave",
+				Description: "Leave the poker table and cash out your chips",
+				Aliases:     []string{"sair"},
+				Handler:     cmdPokerLeave,
+			},
+		},
+		Handler: func(ctx *bot.Context) {
+			rules := games.PokerRules()
+			ctx.Reply(utils.InfoEmbed(ctx.T("Poker"), ctx.T("Usage: `!poker join <buy-in>` or `!poker leave`\nBlinds %d/%d, buy-in from %d to %d %s.", rules.SmallBlind, rules.BigBlind, rules.MinBuyIn, rules.MaxBuyIn, config.Bot().CurrencySymbol)))
+		},
+	},
+	{
+		Name:        "lottery",
+		Description: "Community lottery with scheduled draws",
+		Aliases:     []string{"loteria"},
+		Subcommands: []*bot.Command{
+			{
+				Name:        "info",
+				Description: "Jackpot, next draw and your tickets",
+				Handler: func(ctx *bot.Context) {
+					ctx.Reply(games.LotteryEmbed(ctx.Locale(), ctx.Author.ID))
+				},
+			},
+			{
+				Name:        "buy",
+				Description: "Buy tickets for the next draw",
+				Aliases:     []string{"comprar"},
+				Economic:    true,
+				Options: []*bot.Option{
+					{Name: "tickets", Description: "How many tickets to buy", Type: discordgo.ApplicationCommandOptionInteger, Required: true, MinValue: bot.Min(1)},
+				},
+				Handler: cmdLotteryBuy,
+			},
+		},
+		Handler: func(ctx *bot.Context) {
+			ctx.Reply(games.LotteryEmbed(ctx.Locale(), ctx.Author.ID))
+		},
+	},
+
+	{
+		Name:        "roulette",
+		Description: "Russian Roulette against other users",
+		Aliases:     []string{"roleta"},
+		Economic:    true,
+		// !roulette @user <amount> continua valendo como atalho de "challenge"
+		Options: []*bot.Option{
+			{Name: "user", Description: "The user to challenge", Type: discordgo.ApplicationCommandOptionUser},
+			{Name: "amount", Description: "Amount to bet", Type: discordgo.ApplicationCommandOptionInteger, MinValue: bot.Min(1)},
+		},
+		Subcommands: []*bot.Command{
+			{
+				Name:        "challenge",
+				Description: "Challenge a user to Russian Roulette",
+				Options: []*bot.Option{
+					{Name: "user", Description: "The user to challenge", Type: discordgo.ApplicationCommandOptionUser, Required: true},
+					betOption("amount", fmt.Sprintf("Amount to bet (Min %d)", games.MinRussianRouletteBet), games.MinRussianRouletteBet),
+				},
+				Handler: cmdRouletteChallenge,
+			},
+			{
+				Name:        "lobby",
+				Description: fmt.Sprintf("Open a Russian Roulette game for up to %d players", games.MaxLobbyPlayers),
+				Options: []*bot.Option{
+					betOption("amount", fmt.Sprintf("Amount to bet (Min %d)", games.MinRussianRouletteBet), games.MinRussianRouletteBet),
+					{Name: "mode", Description: "How the pot is paid", Type: discordgo.ApplicationCommandOptionString, Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "Last survivor takes all", Value: "all"},
+						{Name: "First death splits the pot", Value: "split"},
+					}},
+				},
+				Handler: cmdRouletteLobby,
+			},
+			{
+				Name:        "bet",
+				Description: "Bet on a lobby player surviving",
+				Options: []*bot.Option{
+					{Name: "player", Description: "The player you back", Type: discordgo.ApplicationCommandOptionUser, Required: true},
+					betOption("amount", fmt.Sprintf("Amount to bet (Min %d)", games.MinSideBet), games.MinSideBet),
+				},
+				Handler: cmdRouletteSideBet,
+			},
+		},
+		Handler: func(ctx *bot.Context) {
+			if ctx.User("user") == nil || !ctx.Has("amount") {
+				ctx.Reply(games.RussianRouletteHelpEmbed(ctx.Locale()))
+				return
+			}
+			cmdRouletteChallenge(ctx)
+		},
+	},
+	duelCommand(games.DuelCoinflip, "Challenge a user to a coinflip duel", []string{"caraoucoroa"}),
+	duelCommand(games.DuelDice, "Challenge a user to a dice duel", []string{"dados"}),
+	{
+		Name:        "wheel",
+		Description: "Bet on the casino roulette wheel",
+		Aliases:     []string{"roleta-cassino"},
+		Subcommands: []*bot.Command{
+			{
+				Name:        "bet",
+				Description: "Place a bet on the current round",
+				// O prefixo continua com a sintaxe !wheel <aposta> <valor>
+				SlashOnly: true,
+				Options: []*bot.Option{
+					{Name: "on", Description: "What to bet on", Type: discordgo.ApplicationCommandOptionString, Required: true, Choices: wheelChoices()},
+					betOption("amount", fmt.Sprintf("Amount to bet (Min %d)", games.MinRouletteBet), games.MinRouletteBet),
+					{Name: "number", Description: "Number to bet on (only for \"number\")", Type: discordgo.ApplicationCommandOptionInteger, MinValue: bot.Min(0), MaxValue: 36},
+					{Name: "dozen", Description: "Dozen to bet on (only for \"dozen\")", Type: discordgo.ApplicationCommandOptionString, Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "1st (1-12)", Value: "1st"},
+						{Name: "2nd (13-24)", Value: "2nd"},
+						{Name: "3rd (25-36)", Value: "3rd"},
+					}},
+				},
+				Handler: cmdWheelBet,
+			},
+			{
+				Name:        "time",
+				Description: "Time left until the next spin",
+				Handler: func(ctx *bot.Context) {
+					ctx.Reply(games.WheelTimeEmbed(ctx.Locale()))
+				},
+			},
+		},
+		Handler: textCommand(games.CmdRoulette),
+	},
+	{
+		Name:        "event",
+		Description: "Community betting events",
+		// !event <id> mostra um evento, como antes
+		Options: []*bot.Option{eventIDOption()},
+		Subcommands: []*bot.Command{
+			{
+				Name:        "create",
+				Description: "Create a betting event",
+				Shortcuts:   []string{"createevent"},
+				Level:       permissions.Moderator,
+				Handler: func(ctx *bot.Context) {
+					if !ctx.IsSlash() {
+						// No prefixo: !createevent <pergunta> | <opção1> | <opção2> | ... | <minutos>
+						games.CmdCreateEvent(ctx.Session, ctx.Message, ctx.Args)
+						return
+					}
+					ctx.ShowModal(games.EventCreateModal(ctx.Locale()))
+				},
+			},
+			{
+				Name:        "list",
+				Description: "List active events",
+				Shortcuts:   []string{"events"},
+				Handler: func(ctx *bot.Context) {
+					ctx.Reply(games.EventsEmbed(ctx.Locale()))
+				},
+			},
+			{
+				Name:        "view",
+				Description: "View an event",
+				Options:     []*bot.Option{eventIDOption()},
+				Handler:     cmdEventView,
+			},
+			{
+				Name:        "bet",
+				Description: "Bet on an event",
+				Shortcuts:   []string{"betevent"},
+				Economic:    true,
+				Options: []*bot.Option{
+					eventIDOption(),
+					{Name: "option", Description: "Option number to bet on", Type: discordgo.ApplicationCommandOptionInteger, Required: true, MinValue: bot.Min(1), MaxValue: 10},
+					betOption("amount", fmt.Sprintf("Amount to bet (Min %d)", games.MinEventBet), games.MinEventBet),
+				},
+				Handler: cmdEventBet,
+			},
+			{
+				Name:        "close",
+				Description: "Close betting on your event early",
+				Shortcuts:   []string{"closeevent"},
+				Level:       permissions.Moderator,
+				Options:     []*bot.Option{eventIDOption()},
+				Handler:     cmdEventClose,
+			},
+			{
+				Name:        "result",
+				Description: "Set the winning option of your event",
+				Shortcuts:   []string{"result"},
+				Level:       permissions.Moderator,
+				Options: []*bot.Option{
+					eventIDOption(),
+					{Name: "option", Description: "Winning option number", Type: discordgo.ApplicationCommandOptionInteger, Required: true, MinValue: bot.Min(1), MaxValue: 10},
+				},
+				Handler: cmdEventResult,
+			},
+		},
+		Handler: cmdEventView,
+	},
+}
+
+// wheelChoices monta as apostas aceitas por /wheel bet
+func wheelChoices() []*discordgo.ApplicationCommandOptionChoice {
+	labels := map[string]string{
+		"red":    "Red (1:1)",
+		"black":  "Black (1:1)",
+		"even":   "Even (1:1)",
+		"odd":    "Odd (1:1)",
+		"low":    "Low 1-18 (1:1)",
+		"high":   "High 19-36 (1:1)",
+		"number": "Single number (35:1)",
+		"dozen":  "Dozen (2:1)",
+	}
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(games.WheelChoices))
+	for _, c := range games.WheelChoices {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: labels[c], Value: c})
+	}
+	return choices
+}
+
+func cmdWheelBet(ctx *bot.Context) {
+	choice := ctx.String("on")
+	value := ""
+	switch choice {
+	case "number":
+		if !ctx.Has("number") {
+			ctx.ReplyEphemeral(ctx.ErrorEmbed("Pick the `number` (0-36) to bet on."))
+			return
+		}
+		value = strconv.Itoa(ctx.Int("number"))
+	case "dozen":
+		if !ctx.Has("dozen") {
+			ctx.ReplyEphemeral(ctx.ErrorEmbed("Pick the `dozen` to bet on."))
+			return
+		}
+		value = ctx.String("dozen")
+	}
+
+	ctx.Reply(games.PlaceWheelBet(ctx.Locale(), ctx.Author, choice, value, ctx.Int("amount")))
+}
+
+// duelCommand monta o comando de um duelo (coinflip ou dice)
+func duelCommand(game, description string, aliases []string) *bot.Command {
+	options := []*bot.Option{betOption("amount", "Amount to bet", 1)}
+	if game == games.DuelDice {
+		options = append(options, &bot.Option{
+			Name:        "sides",
+			Description: fmt.Sprintf("Sides of the die (default %d)", games.DefaultDiceSides),
+			Type:        discordgo.ApplicationCommandOptionInteger,
+			MinValue:    bot.Min(2),
+			MaxValue:    games.MaxDiceSides,
+		})
+	}
+	user := &bot.Option{Name: "user", Description: "The user to challenge", Type: discordgo.ApplicationCommandOptionUser, Required: true}
+
+	// !coinflip @user <amount> continua valendo como atalho de "challenge"
+	fallback := make([]*bot.Option, 0, len(options)+1)
+	fallback = append(fallback, &bot.Option{Name: "user", Description: user.Description, Type: user.Type})
+	for _, opt := range options {
+		o := *opt
+		o.Required = false
+		fallback = append(fallback, &o)
+	}
+
+	return &bot.Command{
+		Name:        game,
+		Description: description,
+		Aliases:     aliases,
+		Economic:    true,
+		Options:     fallback,
+		Subcommands: []*bot.Command{
+			{
+				Name:        "challenge",
+				Description: description,
+				Options:     append([]*bot.Option{user}, options...),
+				Handler:     func(ctx *bot.Context) { cmdDuel(ctx, game) },
+			},
+			{
+				Name:        "open",
+				Description: "Open a duel anyone in the channel can accept",
+				Options:     options,
+				Handler:     func(ctx *bot.Context) { cmdDuel(ctx, game) },
+			},
+		},
+		Handler: func(ctx *bot.Context) {
+			if ctx.User("user") == nil || !ctx.Has("amount") {
+				ctx.Reply(games.DuelHelpEmbed(ctx.Locale(), game))
+				return
+			}
+			cmdDuel(ctx, game)
+		},
+	}
+}
+
+// cmdDuel desafia o usuário informado, ou abre o duelo para o canal sem ele
+func cmdDuel(ctx *bot.Context, game string) {
+	challenged := ctx.User("user")
+	if err := games.ChallengeDuel(ctx.Session, ctx.GuildID, ctx.ChannelID, ctx.Author.ID, challenged, game, ctx.Int("amount"), ctx.Int("sides")); err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(ctx.Err(err)))
+		return
+	}
+
+	// O desafio já foi publicado no canal; o slash só precisa ser confirmado
+	if ctx.IsSlash() {
+		if challenged == nil {
+			ctx.ReplyEphemeral(utils.InfoEmbed(ctx.T("Duel"), ctx.T("Your duel is open to the channel!")))
+		} else {
+			ctx.ReplyEphemeral(utils.InfoEmbed(ctx.T("Duel"), ctx.T("Challenge sent to <@%s>!", challenged.ID)))
+		}
+	}
+}
+
+func cmdRouletteChallenge(ctx *bot.Context) {
+	challenged := ctx.User("user")
+	if err := games.ChallengeRussianRoulette(ctx.Session, ctx.GuildID, ctx.ChannelID, ctx.Author.ID, challenged, ctx.Int("amount")); err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(ctx.Err(err)))
+		return
+	}
+	if ctx.IsSlash() {
+		ctx.ReplyEphemeral(utils.InfoEmbed(ctx.T("🔫 Russian Roulette"), ctx.T("Challenge sent to <@%s>!", challenged.ID)))
+	}
+}
+
+func cmdRouletteLobby(ctx *bot.Context) {
+	if err := games.OpenRouletteLobby(ctx.Session, ctx.GuildID, ctx.ChannelID, ctx.Author, ctx.Int("amount"), ctx.String("mode") == "split"); err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(ctx.Err(err)))
+		return
+	}
+	if ctx.IsSlash() {
+		ctx.ReplyEphemeral(utils.InfoEmbed(ctx.T("🔫 Russian Roulette"), ctx.T("Your lobby is open!")))
+	}
+}
+
+func cmdRouletteSideBet(ctx *bot.Context) {
+	if err := games.PlaceRouletteSideBet(ctx.Session, ctx.ChannelID, ctx.Author.ID, ctx.User("player").ID, ctx.Int("amount")); err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(ctx.Err(err)))
+		return
+	}
+	if !ctx.IsSlash() {
+		ctx.Session.MessageReactionAdd(ctx.ChannelID, ctx.Message.ID, "🎟️")
+		return
+	}
+	ctx.ReplyEphemeral(utils.SuccessEmbed(ctx.T("Bet Placed!"), ctx.T("You bet **%d %s** on <@%s> surviving.", ctx.Int("amount"), config.Bot().CurrencySymbol, ctx.User("player").ID)))
+}
+
+func cmdBlackjackTable(ctx *bot.Context) {
+	if err := games.JoinBlackjackTable(ctx.Session, ctx.ChannelID, ctx.Author, ctx.Int("amount")); err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(ctx.Err(err)))
+		return
+	}
+	ctx.ReplyEphemeral(utils.SuccessEmbed(ctx.T("Bet Placed!"), ctx.T("You sat at the table with **%d %s**. Cards are dealt when the betting window closes.", ctx.Int("amount"), config.Bot().CurrencySymbol)))
+}
+
+// slotsLinesOption escolhe quantas linhas jogar na grade 3x3 (sem ela, rolo clássico)
+func slotsLinesOption() *bot.Option {
+	return &bot.Option{
+		Name:        "lines",
+		Description: "Play the 3x3 grid with this many paylines (1-5)",
+		Type:        discordgo.ApplicationCommandOptionInteger,
+		MinValue:    bot.Min(1),
+		MaxValue:    5,
+	}
+}
+
+func cmdSlots(ctx *bot.Context) {
+	lines := ctx.Int("lines")
+	if ctx.IsSlash() {
+		games.StartSlotsLinesInteraction(ctx.Session, ctx.Interaction, ctx.Int("amount"), lines)
+		return
+	}
+	games.StartSlotsLinesText(ctx.Session, ctx.Message, ctx.Int("amount"), lines)
+}
+
+func cmdPokerJoin(ctx *bot.Context) {
+	if err := games.JoinPokerTable(ctx.Session, ctx.ChannelID, ctx.Author, ctx.Int("buy_in")); err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(ctx.Err(err)))
+		return
+	}
+	ctx.ReplyEphemeral(utils.SuccessEmbed(ctx.T("Seat Taken!"), ctx.T("You sat at the poker table with **%d %s** in chips. You play from the next hand.", ctx.Int("buy_in"), config.Bot().CurrencySymbol)))
+}
+
+func cmdPokerLeave(ctx *bot.Context) {
+	stack, later, err := games.LeavePokerTable(ctx.ChannelID, ctx.Author.ID)
+	if err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(ctx.Err(err)))
+		return
+	}
+	if later {
+		ctx.ReplyEphemeral(utils.InfoEmbed(ctx.T("Leaving the Table"), ctx.T("You will stand up when this hand ends and your chips will go back to your balance.")))
+		return
+	}
+	ctx.ReplyEphemeral(utils.SuccessEmbed(ctx.T("Cashed Out"), ctx.T("You left the table with **%d %s**.", stack, config.Bot().CurrencySymbol)))
+}
+
+func cmdLotteryBuy(ctx *bot.Context) {
+	numbers, err := games.BuyLotteryTickets(ctx.Author.ID, ctx.Int("tickets"))
+	if err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(ctx.Err(err)))
+		return
+	}
+	ctx.Reply(utils.SuccessEmbed(ctx.T("🎟️ Tickets Bought!"), ctx.T("You bought **%d** tickets. Your numbers: %s\nGood luck in the draw!", len(numbers), games.FormatLotteryNumbers(numbers))))
+}
+
+func cmdAviatorRound(ctx *bot.Context) {
+	if err := games.JoinAviatorRound(ctx.Session, ctx.ChannelID, ctx.Author, ctx.Int("amount"), ctx.Float("auto")); err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(ctx.Err(err)))
 		return
 	}
 
-	game := strings.ToLower(args[0])
-	amountStr := args[1]
-	amount, err := strconv.Atoi(amountStr)
+	msg := ctx.T("You're on board with **%d %s**. Cash out before the crash!", ctx.Int("amount"), config.Bot().CurrencySymbol)
+	if ctx.Has("auto") {
+		msg = ctx.T("You're on board with **%d %s**, auto cash-out at **x%.2f**.", ctx.Int("amount"), config.Bot().CurrencySymbol, ctx.Float("auto"))
+	}
+	ctx.ReplyEphemeral(utils.SuccessEmbed(ctx.T("Bet Placed!"), msg))
+}
+
+// eventIDOption é o ID do evento, com sugestões dos eventos ativos
+func eventIDOption() *bot.Option {
+	return &bot.Option{
+		Name:         "event_id",
+		Description:  "Event ID",
+		Type:         discordgo.ApplicationCommandOptionString,
+		Required:     true,
+		Autocomplete: eventIDChoices,
+	}
+}
+
+func eventIDChoices(ctx *bot.Context, value string) []*discordgo.ApplicationCommandOptionChoice {
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	for _, e := range games.ListEvents() {
+		if !matchesSymbol(value, e.ID, e.Question) {
+			continue
+		}
+		name := e.Question
+		if len([]rune(name)) > 80 {
+			name = string([]rune(name)[:77]) + "..."
+		}
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: name, Value: e.ID})
+	}
+	return choices
+}
+
+func cmdEventView(ctx *bot.Context) {
+	event, exists := games.GetEvent(ctx.String("event_id"))
+	if !exists {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Event not found."))
+		return
+	}
+	ctx.Reply(event.ToEmbed(ctx.Locale()))
+}
+
+func cmdEventBet(ctx *bot.Context) {
+	embed, errMsg := games.PlaceEventBet(ctx.Locale(), ctx.Author.ID, ctx.Author.Username, ctx.String("event_id"), ctx.Int("option"), ctx.Int("amount"))
+	if embed == nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(errMsg))
+		return
+	}
+	ctx.Reply(embed)
+}
+
+func cmdEventClose(ctx *bot.Context) {
+	if errMsg := games.CloseEvent(ctx.Locale(), ctx.Author.ID, ctx.String("event_id"), ctx.Level() == permissions.Admin); errMsg != "" {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(errMsg))
+		return
+	}
+	ctx.Reply(utils.SuccessEmbed(ctx.T("Event Closed"), ctx.T("Betting is now closed. Use `/event result` to set the winner.")))
+}
+
+func cmdEventResult(ctx *bot.Context) {
+	embed, errMsg := games.ResolveEvent(ctx.Locale(), ctx.Author.ID, ctx.String("event_id"), ctx.Int("option"), ctx.Level() == permissions.Admin)
+	if embed == nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(errMsg))
+		return
+	}
+	ctx.Reply(embed)
+}
 
-	if err != nil || amount <= 0 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Invalid amount."))
+// startGame inicia o jogo pela variante de texto ou de interação
+func startGame(ctx *bot.Context, amount int,
+	text func(*discordgo.Session, *discordgo.MessageCreate, int),
+	slash func(*discordgo.Session, *discordgo.InteractionCreate, int)) {
+	if ctx.IsSlash() {
+		slash(ctx.Session, ctx.Interaction, amount)
 		return
 	}
+	text(ctx.Session, ctx.Message, amount)
+}
 
-	switch game {
-	case "aviator":
-		games.StartAviatorText(s, m, amount)
-	case "cups":
-		games.StartCupGameText(s, m, amount)
-	case "blackjack", "bj", "21":
-		games.StartBlackjackText(s, m, amount)
-	case "slots", "slot":
-		games.StartSlotsText(s, m, amount)
-	default:
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Game not found. Try: `aviator`, `cups`, `blackjack`, `slots`, or use `!roulette @user <amount>`"))
+// textCommand adapta um comando de texto que lê os argumentos brutos
+func textCommand(cmd func(*discordgo.Session, *discordgo.MessageCreate, []string)) bot.Handler {
+	return func(ctx *bot.Context) {
+		cmd(ctx.Session, ctx.Message, ctx.Args)
 	}
-}
\ No newline at end of file
+}
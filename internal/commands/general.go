@@ -1,6 +1,9 @@
 package commands
 
 import (
+	"estudocoin/internal/bot"
+	"estudocoin/internal/games"
+	"estudocoin/internal/i18n"
 	"estudocoin/pkg/config"
 	"estudocoin/pkg/utils"
 	"fmt"
@@ -19,114 +22,163 @@ type HelpSection struct {
 }
 
 // getHelpSections retorna as seções de help em tempo de execução (para usar config carregado)
-func getHelpSections() []HelpSection {
+func getHelpSections(loc i18n.Locale) []HelpSection {
 	return []HelpSection{
 		{
 			ID:    "economy",
-			Name:  "Economy",
+			Name:  loc.T("Economy"),
 			Emoji: "💰",
-			Value: "`!daily` / `/daily`\nCollect your daily reward (**100-5000**).\n"+
+			Value: loc.T("`!daily` / `/daily`\nCollect your daily reward (**100-5000**).\n"+
 				"🔥 **Streak System:** Day 1 = 100, Day 2 = 200... up to 5000!\n"+
 				"⚠️ Skip a day = streak resets to 100.\n\n"+
 				"`!balance` / `/balance [user]`\nCheck your wallet or someone else's.\n\n"+
 				"`!leaderboard` / `/leaderboard`\nSee the richest users.\n\n"+
-				"`!pay` / `/pay <user> <amount>`\nTransfer coins to another user.",
+				"`!pay` / `/pay <user> <amount>`\nTransfer coins to another user.\n\n"+
+				"`!settings privacy <hidden|public>` / `/settings privacy`\nHide your balance from others and from the leaderboard.\n\n"+
+				"`!limits` / `/limits`\nSet loss limits, a max bet or a self-exclusion period.\n\n"+
+				"`!stats` / `/stats [user] [game]`\nGames played, profit and win rate per game."),
 		},
 		{
 			ID:    "shop",
-			Name:  "Shop",
+			Name:  loc.T("Shop"),
 			Emoji: "🛒",
-			Value: fmt.Sprintf("`!shop` / `/shop`\nView available items.\n\n"+
+			Value: loc.T("`!shop` / `/shop`\nView available items.\n\n"+
 				"`!buy nickname <n>`\nChange your own nickname (**%d %s**).\n\n"+
 				"`!buy rename @user <n>`\nChange someone else's nickname (**%d %s**).\n\n"+
 				"`!buy punishment @user <min>`\nTimeout user (**%d %s/min**) - text & voice.\n*Note: Punishments are accumulative!*\n\n"+
 				"`!buy mute @user <min>`\nMute user in voice (**%d %s/min**) - voice only.\n*User must be in a call!*",
-				config.Economy.CostNicknameSelf, config.Bot.CurrencySymbol, config.Economy.CostNicknameOther, config.Bot.CurrencySymbol, config.Economy.CostPerMinutePunishment, config.Bot.CurrencySymbol, config.Economy.CostPerMinuteMute, config.Bot.CurrencySymbol),
+				config.Economy().CostNicknameSelf, config.Bot().CurrencySymbol, config.Economy().CostNicknameOther, config.Bot().CurrencySymbol, config.Economy().CostPerMinutePunishment, config.Bot().CurrencySymbol, config.Economy().CostPerMinuteMute, config.Bot().CurrencySymbol),
 		},
 		{
 			ID:    "gambling",
-			Name:  "Gambling",
+			Name:  loc.T("Gambling"),
 			Emoji: "🎲",
-			Value: "`!bet aviator <amount>` / `/bet aviator`\nPlay the Aviator crash game.\n*Watch out for turbulence!*\n\n" +
+			Value: loc.T("`!bet aviator <amount>` / `/bet aviator`\nPlay the Aviator crash game.\n*Watch out for turbulence!*\n\n" +
+				"`!bet crash <amount> [auto]` / `/bet crash`\nJoin the channel's shared Aviator flight.\n*Everyone crashes together. Set `auto` to cash out at a target!*\n\n" +
 				"`!bet cups <amount>` / `/bet cups`\nFind the hidden coin under 6 cups.\n*Win 5x, then 10x, 20x, 40x... or Cash Out!*\n\n" +
 				"`!bet blackjack <amount>` / `/blackjack`\nClassic Blackjack vs dealer.\n*Hit, Stand, Double, Insurance.*\n\n" +
-				"`!bet slots <amount>` / `/slots`\nSpin the slot machine!\n*3 = Jackpot | 2 = Win | Up to 25x!*\n\n" +
-				"`!roulette @user <amount>`\nRussian Roulette PvP.\n*Survivor takes all!*",
+				"`!bet table <amount>` / `/bet table`\nBlackjack table for up to 5 players.\n*Split, Double, Surrender. One shoe per channel.*\n\n" +
+				"`!slots <amount> [lines]` / `/slots`\nSlot machine, or a 3x3 grid with up to 5 lines.\n*7️⃣7️⃣7️⃣ wins the `!jackpot` pool!*\n\n" +
+				"`!poker join <buy-in>` / `/poker join`\nTexas Hold'em against other players.\n*Leave with `!poker leave` to cash out.*\n\n" +
+				"`!roulette @user <amount>` / `!roulette lobby <amount>`\nRussian Roulette PvP, up to 6 players.\n*Survivor takes all! Spectators: `!roulette bet @user <amount>`*"),
 		},
 		{
 			ID:    "casino",
-			Name:  "Casino Roulette",
+			Name:  loc.T("Casino Roulette"),
 			Emoji: "🎡",
-			Value: "`!wheel`\nView roulette options and time until spin.\n\n" +
+			Value: loc.T("`!wheel`\nView roulette options and time until spin.\n\n" +
 				"`!wheel number <0-36> <amount>` - **35:1**\n" +
 				"`!wheel red/black <amount>` - **1:1**\n" +
 				"`!wheel even/odd <amount>` - **1:1**\n" +
 				"`!wheel low/high <amount>` - **1:1**\n" +
 				"`!wheel dozen <1st/2nd/3rd> <amount>` - **2:1**\n\n" +
-				"*Rounds every 10 min. Betting closes on spin!*",
+				"`/wheel bet` / `/wheel time` - Same bets with slash commands\n\n" +
+				"*Rounds every 10 min. Betting closes on spin!*"),
+		},
+		{
+			ID:    "lottery",
+			Name:  loc.T("Lottery"),
+			Emoji: "🎟️",
+			Value: loc.T("`!lottery` / `/lottery info`\nJackpot, next draw and your ticket numbers.\n\n"+
+				"`!lottery buy <tickets>` / `/lottery buy`\nBuy tickets (**%d %s** each) for the next draw.\n\n"+
+				"*Holders of the drawn number share the jackpot. No winner? It rolls over!*",
+				config.Economy().Lottery.TicketPrice, config.Bot().CurrencySymbol),
+		},
+		{
+			ID:    "duels",
+			Name:  loc.T("Duels"),
+			Emoji: "⚔️",
+			Value: loc.T("`!coinflip @user <amount>`\nHeads or tails against another user.\n\n"+
+				"`!dice @user <amount> [sides]`\nHighest roll wins. Ties are re-rolled.\n\n"+
+				"*Use `open` instead of a mention to let anyone accept. Minimum bet: **%d %s**.*",
+				games.DuelRules().MinBet, config.Bot().CurrencySymbol),
 		},
 		{
 			ID:    "events",
-			Name:  "Event Betting",
+			Name:  loc.T("Event Betting"),
 			Emoji: "🎯",
-			Value: "`!createevent <q> | <opt1> | <opt2> | <min>`\n*Admin only.* Create betting event.\n\n" +
-				"`!betevent <id> <opt_num> <amount>`\nPlace bet on event.\n\n" +
-				"`!events` - List active events\n" +
-				"`!event <id>` - View event details\n" +
-				"`!closeevent <id>` - Close early\n" +
-				"`!result <id> <opt>` - Set winner\n\n" +
-				"*Dynamic odds: less popular = higher payout!*",
+			Value: loc.T("`!createevent <q> | <opt1> | <opt2> | <min>` / `/event create`\n*Moderators only.* Create betting event.\n\n" +
+				"`!betevent <id> <opt_num> <amount>`\nPlace bet on event, or click an option on the event message.\n\n" +
+				"`!events` / `/event list` - List active events\n" +
+				"`!event <id>` / `/event view` - View event details\n" +
+				"`!closeevent <id>` / `/event close` - Close early\n" +
+				"`!result <id> <opt>` / `/event result` - Set winner (creator or admin)\n\n" +
+				"*Dynamic odds: less popular = higher payout!*"),
 		},
 		{
 			ID:    "stocks",
-			Name:  "Stock Market",
+			Name:  loc.T("Stock Market"),
 			Emoji: "📈",
-			Value: "`!stock market`\nView stocks and prices.\n\n" +
-				"`!stock buy <ticker> <amount>`\nBuy shares.\n\n" +
-				"`!stock sell <ticker> <shares|all>`\nSell shares.\n\n" +
-				"`!stock portfolio`\nView investments.",
+			Value: loc.T("`!stock market` / `/stock market`\nView stocks and prices.\n\n" +
+				"`!stock buy <ticker> <amount>` / `/stock buy`\nBuy shares (confirm the quoted price first).\n\n" +
+				"`!stock sell <ticker> <shares|all>` / `/stock sell`\nSell shares.\n\n" +
+				"`!stock portfolio` / `/stock portfolio`\nView investments (private with `/`)."),
 		},
 		{
 			ID:    "crypto",
-			Name:  "Cryptocurrency",
+			Name:  loc.T("Cryptocurrency"),
 			Emoji: "🪙",
-			Value: "`!crypto market`\nView crypto prices.\n\n" +
-				"`!crypto buy <SYMBOL> <amount>`\nBuy crypto (BTC, ETH, etc).\n\n" +
-				"`!crypto sell <SYMBOL> <amount|all>`\nSell crypto.\n\n" +
-				"`!crypto portfolio`\nView crypto holdings.\n\n" +
-				"⚠️ Meme coins are highly volatile!",
+			Value: loc.T("`!crypto market` / `/crypto market`\nView crypto prices.\n\n" +
+				"`!crypto buy <SYMBOL> <amount>` / `/crypto buy`\nBuy crypto (BTC, ETH, etc) after confirming the quote.\n\n" +
+				"`!crypto sell <SYMBOL> <amount|all>` / `/crypto sell`\nSell crypto.\n\n" +
+				"`!crypto portfolio` / `/crypto portfolio`\nView crypto holdings (private with `/`).\n\n" +
+				"⚠️ Meme coins are highly volatile!"),
 		},
 		{
 			ID:    "voice",
-			Name:  "Voice Rewards",
+			Name:  loc.T("Voice Rewards"),
 			Emoji: "🎙️",
-			Value: fmt.Sprintf("Earn **%d %s/min** in voice channels.\n*Need 2+ people, not muted/deafened.*", config.Economy.VoiceCoinsPerMinute, config.Bot.CurrencySymbol),
+			Value: loc.T("Earn **%d %s/min** in voice channels.\n*Need 2+ people, not muted/deafened.*", config.Economy().VoiceCoinsPerMinute, config.Bot().CurrencySymbol),
 		},
 		{
 			ID:    "loans",
-			Name:  "Loans",
+			Name:  loc.T("Loans"),
 			Emoji: "💳",
-			Value: "`!loan offer @user <amount> <interest> <days>` / `/loan offer`\n"+
+			Value: loc.T("`!loan offer @user <amount> <interest> <days>` / `/loan offer`\n"+
 				"Offer a loan to another user. They have 1 minute to accept.\n\n"+
 				"`!loan pay [loan_id]` / `/loan pay`\n"+
 				"Pay an active loan (pays oldest if no ID specified).\n\n"+
 				"`!loan list [@user]` / `/loan list`\n"+
 				"View active loans.\n\n"+
-				"⚠️ **Auto-collection:** If not paid by due date, funds are automatically deducted!",
+				"⚠️ **Auto-collection:** If not paid by due date, funds are automatically deducted!"),
 		},
 		{
 			ID:    "api",
-			Name:  "Developer & API",
+			Name:  loc.T("Developer & API"),
 			Emoji: "🔧",
-			Value: "`/apikey create` - Generate API key\n"+
+			Value: loc.T("`/apikey create` - Generate API key\n"+
 				"`/apikey list` - View keys\n"+
-				"`/webhook set <url>` - Coin notifications",
+				"`/webhook set <url>` - Coin notifications\n"+
+				"`/webhook deliveries` - Recent delivery attempts"),
+		},
+		{
+			ID:    "language",
+			Name:  loc.T("Language"),
+			Emoji: "🌐",
+			Value: loc.T("`!language` / `/language`\nShow the language the bot uses with you.\n\n"+
+				"`!language set <en|pt-BR|auto>` / `/language set`\nChoose your language. *auto* follows your Discord language.\n\n"+
+				"`!language server <en|pt-BR|auto>` / `/language server`\n*Admins only.* Default language of this server."),
+		},
+		{
+			ID:    "admin",
+			Name:  loc.T("Administration"),
+			Emoji: "🛡️",
+			Value: loc.T("`!admin reload` / `/admin reload`\n"+
+				"Reload config.json and economy.json without restarting.\n\n"+
+				"`/admin coins give|take|set @user <amount> <reason>`\n"+
+				"Fix a balance. Every action is audited.\n\n"+
+				"`/admin freeze|unfreeze @user [reason]`\n"+
+				"Block or allow transfers, games, trading and API use.\n\n"+
+				"`/admin reset @user [reason]` - Wipe balance, holdings and loans\n"+
+				"`/admin audit @user` - Recent admin actions\n"+
+				"`/admin limits @user` - Gambling limits (read-only)\n\n"+
+				"*Admins are the roles/users in `permissions` of config.json, plus members with Manage Server.*"),
 		},
 	}
 }
 
-func getHelpEmbed(sectionIdx int) *discordgo.MessageEmbed {
-	sections := getHelpSections()
+func getHelpEmbed(loc i18n.Locale, sectionIdx int) *discordgo.MessageEmbed {
+	sections := getHelpSections(loc)
 	
 	if sectionIdx < 0 {
 		sectionIdx = len(sections) - 1
@@ -138,28 +190,28 @@ func getHelpEmbed(sectionIdx int) *discordgo.MessageEmbed {
 	section := sections[sectionIdx]
 
 	embed := utils.NewEmbed()
-	embed.Title = fmt.Sprintf("%s %s - Page %d/%d", section.Emoji, section.Name, sectionIdx+1, len(sections))
+	embed.Title = loc.T("%s %s - Page %d/%d", section.Emoji, section.Name, sectionIdx+1, len(sections))
 	embed.Description = section.Value
 	embed.Color = utils.ColorBlue
 	embed.Footer = &discordgo.MessageEmbedFooter{
-		Text: fmt.Sprintf("Use !help <section> to jump | Sections: economy, shop, gambling, casino, events, stocks, crypto, voice, loans, api"),
+		Text: loc.T("Use !help <section> to jump | Sections: economy, shop, gambling, casino, lottery, duels, events, stocks, crypto, voice, loans, api, language, admin"),
 	}
 
 	return embed
 }
 
-func getHelpButtons(sectionIdx int) []discordgo.MessageComponent {
+func getHelpButtons(loc i18n.Locale, sectionIdx int) []discordgo.MessageComponent {
 	return []discordgo.MessageComponent{
 		discordgo.ActionsRow{
 			Components: []discordgo.MessageComponent{
 				discordgo.Button{
-					Label:    "⬅️ Previous",
+					Label:    loc.T("⬅️ Previous"),
 					Style:    discordgo.PrimaryButton,
 					CustomID: fmt.Sprintf("help_nav_%d", sectionIdx-1),
 					Disabled: false,
 				},
 				discordgo.Button{
-					Label:    "➡️ Next",
+					Label:    loc.T("➡️ Next"),
 					Style:    discordgo.PrimaryButton,
 					CustomID: fmt.Sprintf("help_nav_%d", sectionIdx+1),
 					Disabled: false,
@@ -169,8 +221,8 @@ func getHelpButtons(sectionIdx int) []discordgo.MessageComponent {
 	}
 }
 
-func findSectionIndex(sectionID string) int {
-	sections := getHelpSections()
+func findSectionIndex(loc i18n.Locale, sectionID string) int {
+	sections := getHelpSections(loc)
 	sectionID = strings.ToLower(sectionID)
 	for i, section := range sections {
 		if strings.ToLower(section.ID) == sectionID || strings.ToLower(section.Name) == sectionID {
@@ -180,25 +232,31 @@ func findSectionIndex(sectionID string) int {
 	return -1
 }
 
-func CmdHelp(s *discordgo.Session, m *discordgo.MessageCreate) {
-	args := strings.Fields(m.Content)
-	
+var generalCommands = []*bot.Command{
+	{
+		Name:        "help",
+		Description: "Show all commands and features",
+		Aliases:     []string{"ajuda"},
+		Options: []*bot.Option{
+			{Name: "section", Description: "Section to open (economy, shop, gambling...)", Type: discordgo.ApplicationCommandOptionString},
+		},
+		Handler: CmdHelp,
+	},
+}
+
+func CmdHelp(ctx *bot.Context) {
 	// Check if user specified a section
 	sectionIdx := 0
-	if len(args) > 1 {
-		sectionArg := strings.ToLower(args[1])
-		foundIdx := findSectionIndex(sectionArg)
+	if section := ctx.String("section"); section != "" {
+		foundIdx := findSectionIndex(ctx.Locale(), section)
 		if foundIdx >= 0 {
 			sectionIdx = foundIdx
 		}
 	}
 
-	embed := getHelpEmbed(sectionIdx)
-	buttons := getHelpButtons(sectionIdx)
-
-	s.ChannelMessageSendComplex(m.ChannelID, &discordgo.MessageSend{
-		Embeds:     []*discordgo.MessageEmbed{embed},
-		Components: buttons,
+	ctx.Respond(&bot.Response{
+		Embeds:     []*discordgo.MessageEmbed{getHelpEmbed(ctx.Locale(), sectionIdx)},
+		Components: getHelpButtons(ctx.Locale(), sectionIdx),
 	})
 }
 
@@ -215,7 +273,8 @@ func HandleHelpNavigation(s *discordgo.Session, i *discordgo.InteractionCreate,
 	}
 
 	// Wrap around
-	sections := getHelpSections()
+	loc := i18n.ForInteraction(i)
+	sections := getHelpSections(loc)
 	if sectionIdx < 0 {
 		sectionIdx = len(sections) - 1
 	}
@@ -223,8 +282,8 @@ func HandleHelpNavigation(s *discordgo.Session, i *discordgo.InteractionCreate,
 		sectionIdx = 0
 	}
 
-	embed := getHelpEmbed(sectionIdx)
-	buttons := getHelpButtons(sectionIdx)
+	embed := getHelpEmbed(loc, sectionIdx)
+	buttons := getHelpButtons(loc, sectionIdx)
 
 	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseUpdateMessage,
@@ -234,16 +293,3 @@ func HandleHelpNavigation(s *discordgo.Session, i *discordgo.InteractionCreate,
 		},
 	})
 }
-
-func HandleSlashHelp(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	embed := getHelpEmbed(0)
-	buttons := getHelpButtons(0)
-
-	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-		Type: discordgo.InteractionResponseChannelMessageWithSource,
-		Data: &discordgo.InteractionResponseData{
-			Embeds:     []*discordgo.MessageEmbed{embed},
-			Components: buttons,
-		},
-	})
-}
@@ -1,104 +1,98 @@
 package commands
 
 import (
-	"estudocoin/internal/crypto"
-	"estudocoin/internal/games"
-	"estudocoin/internal/stockmarket"
+	"estudocoin/internal/bot"
+	"estudocoin/internal/database"
+	"estudocoin/internal/i18n"
 	"estudocoin/pkg/config"
-	"estudocoin/pkg/utils"
-	"strconv"
 	"strings"
+	"time"
 
 	"github.com/bwmarrin/discordgo"
 )
 
+// Registry contém todos os comandos do bot, de prefixo e de barra
+var Registry = bot.NewRegistry("!")
+
+func init() {
+	Registry.Frozen = database.IsFrozen
+	Registry.Limits = cooldownLimits
+	Registry.Private = config.Bot().IsCommandPrivate
+
+	Registry.Register(generalCommands...)
+	Registry.Register(economyCommands...)
+	Registry.Register(shopCommands...)
+	Registry.Register(gamblingCommands...)
+	Registry.Register(stockCommands...)
+	Registry.Register(cryptoCommands...)
+	Registry.Register(loanCommands...)
+	Registry.Register(apiKeyCommands...)
+	Registry.Register(webhookCommands...)
+	Registry.Register(adminCommands...)
+	Registry.Register(languageCommands...)
+	Registry.Register(settingsCommands...)
+	Registry.Register(limitsCommands...)
+	Registry.Register(statsCommands...)
+}
+
+// cooldownLimits converte os cooldowns do economy.json para o registro.
+// É lido a cada comando, então !admin reload vale na hora.
+func cooldownLimits() bot.Limits {
+	c := config.Economy().Cooldowns
+	limits := bot.Limits{
+		Commands: make(map[string]time.Duration, len(c.Commands)),
+		Rate:     c.RateLimit,
+		Window:   time.Duration(c.RateWindowSeconds) * time.Second,
+	}
+	if limits.Rate > 0 && limits.Window <= 0 {
+		limits.Window = time.Minute
+	}
+	for path, secs := range c.Commands {
+		limits.Commands[strings.ToLower(strings.TrimSpace(path))] = time.Duration(secs * float64(time.Second))
+	}
+	return limits
+}
+
 func MessageCreate(s *discordgo.Session, m *discordgo.MessageCreate) {
 	if m.Author.ID == s.State.User.ID {
 		return
 	}
 
-	if !strings.HasPrefix(m.Content, "!") {
+	if !strings.HasPrefix(m.Content, Registry.Prefix) {
 		return
 	}
 
 	// Check if channel is allowed
-	if !config.Bot.IsChannelAllowed(m.ChannelID) {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("❌ This bot can only be used in designated channels."))
+	if !config.Bot().IsChannelAllowed(m.ChannelID) {
+		s.ChannelMessageSendEmbed(m.ChannelID, i18n.ForMessage(m).ErrorEmbed("❌ This bot can only be used in designated channels."))
 		return
 	}
 
-	args := strings.Fields(m.Content)
-	command := strings.ToLower(args[0])
-	args = args[1:]
+	Registry.HandleMessage(s, m)
+}
 
-	switch command {
-	case "!help", "!ajuda":
-		CmdHelp(s, m)
-	case "!daily":
-		CmdDaily(s, m)
-	case "!balance", "!saldo", "!coins", "!money":
-		CmdBalance(s, m)
-	case "!leaderboard", "!top", "!rank":
-		CmdLeaderboard(s, m)
-	case "!pay", "!transfer", "!pagar":
-		CmdPay(s, m, args)
-	case "!shop", "!store", "!loja":
-		CmdShop(s, m)
-	case "!buy", "!purchase", "!comprar":
-		CmdBuy(s, m, args)
-	case "!bet", "!apostar":
-		CmdBet(s, m, args)
-	case "!roulette", "!roleta":
-		games.CmdRussianRoulette(s, m, args)
-	case "!slots", "!slot":
-		if len(args) < 1 {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Usage: `!slots <amount>`"))
-			return
-		}
-		amount, err := strconv.Atoi(args[0])
-		if err != nil || amount <= 0 {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Invalid amount."))
-			return
-		}
-		games.StartSlotsText(s, m, amount)
+func SlashHandler(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Sugestões não executam nada; o comando em si ainda passa pela checagem de canal
+	if i.Type == discordgo.InteractionApplicationCommandAutocomplete {
+		Registry.HandleAutocomplete(s, i)
 		return
-	case "!stock", "!mercado", "!market":
-		stockmarket.CmdStock(s, m, args)
-	case "!crypto":
-		crypto.CmdCrypto(s, m, args)
-	case "!wheel", "!roleta-cassino":
-		games.CmdRoulette(s, m, args)
-	case "!createevent":
-		games.CmdCreateEvent(s, m, args)
-	case "!betevent":
-		games.CmdPlaceBet(s, m, args)
-	case "!result":
-		games.CmdSetResult(s, m, args)
-	case "!events":
-		games.CmdListEvents(s, m, args)
-	case "!event":
-		games.CmdViewEvent(s, m, args)
-	case "!closeevent":
-		games.CmdCloseEvent(s, m, args)
-	case "!loan":
-		if len(args) < 1 {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.InfoEmbed("Loan System",
-				"**Commands:**\n"+
-					"`!loan offer @user <amount> <interest> <days>` - Offer a loan\n"+
-					"`!loan pay [loan_id]` - Pay a loan\n"+
-					"`!loan list [@user]` - List active loans"))
-			return
-		}
-		subCommand := strings.ToLower(args[0])
-		switch subCommand {
-		case "offer":
-			CmdLoanOffer(s, m, args)
-		case "pay":
-			CmdLoanPay(s, m, args)
-		case "list":
-			CmdLoanList(s, m, args)
-		default:
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Unknown loan command. Use `!loan offer`, `!loan pay`, or `!loan list`"))
-		}
 	}
-}
\ No newline at end of file
+
+	if i.Type != discordgo.InteractionApplicationCommand {
+		return
+	}
+
+	// Check if channel is allowed
+	if !config.Bot().IsChannelAllowed(i.ChannelID) {
+		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Embeds: []*discordgo.MessageEmbed{i18n.ForInteraction(i).ErrorEmbed("❌ This bot can only be used in designated channels.")},
+				Flags:  discordgo.MessageFlagsEphemeral,
+			},
+		})
+		return
+	}
+
+	Registry.HandleInteraction(s, i)
+}
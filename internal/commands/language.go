@@ -0,0 +1,119 @@
+package commands
+
+import (
+	"estudocoin/internal/bot"
+	"estudocoin/internal/i18n"
+	"estudocoin/internal/permissions"
+	"estudocoin/pkg/utils"
+	"log"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// localeAuto limpa a escolha de idioma (volta à detecção automática)
+const localeAuto = "auto"
+
+var languageCommands = []*bot.Command{
+	{
+		Name:        "language",
+		Description: "Choose the language of the bot",
+		Aliases:     []string{"idioma", "lang"},
+		Subcommands: []*bot.Command{
+			{
+				Name:        "set",
+				Description: "Set your language",
+				Options:     []*bot.Option{localeOption()},
+				Handler:     cmdLanguageSet,
+			},
+			{
+				Name:        "server",
+				Description: "Set the default language of this server",
+				Level:       permissions.Admin,
+				Options:     []*bot.Option{localeOption()},
+				Handler:     cmdLanguageServer,
+			},
+		},
+		Handler: cmdLanguageView,
+	},
+}
+
+func localeOption() *bot.Option {
+	choices := []*discordgo.ApplicationCommandOptionChoice{
+		{Name: "Automatic", Value: localeAuto},
+	}
+	for _, l := range i18n.Locales {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: l.Name(), Value: string(l)})
+	}
+	return &bot.Option{
+		Name:        "language",
+		Description: "Language to use",
+		Type:        discordgo.ApplicationCommandOptionString,
+		Required:    true,
+		Choices:     choices,
+	}
+}
+
+// parseLocaleOption converte a opção em Locale ("" = automático)
+func parseLocaleOption(value string) (i18n.Locale, bool) {
+	if value == localeAuto {
+		return "", true
+	}
+	return i18n.Parse(value)
+}
+
+func cmdLanguageView(ctx *bot.Context) {
+	loc := ctx.Locale()
+	choice := loc.T("Automatic")
+	if l := i18n.UserChoice(ctx.Author.ID); l != "" {
+		choice = l.Name()
+	}
+	ctx.ReplyEphemeral(utils.InfoEmbed(loc.T("Language"),
+		loc.T("Current language: **%s**\nYour choice: **%s**\n\nUse `/language set` to change it.", loc.Name(), choice)))
+}
+
+func cmdLanguageSet(ctx *bot.Context) {
+	l, ok := parseLocaleOption(ctx.String("language"))
+	if !ok {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Unknown language."))
+		return
+	}
+	if err := i18n.SetUser(ctx.Author.ID, l); err != nil {
+		log.Printf("Error saving locale of %s: %v", ctx.Author.ID, err)
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Could not save your language."))
+		return
+	}
+
+	// Responde já no idioma novo
+	loc := ctx.Locale()
+	if l != "" {
+		loc = l
+	} else if ctx.IsSlash() {
+		loc = i18n.ForInteraction(ctx.Interaction)
+	} else {
+		loc = i18n.ForMessage(ctx.Message)
+	}
+	ctx.ReplyEphemeral(utils.SuccessEmbed(loc.T("Language"), loc.T("The bot will now talk to you in **%s**.", loc.Name())))
+}
+
+func cmdLanguageServer(ctx *bot.Context) {
+	if ctx.GuildID == "" {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("This command only works in a server."))
+		return
+	}
+	l, ok := parseLocaleOption(ctx.String("language"))
+	if !ok {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Unknown language."))
+		return
+	}
+	if err := i18n.SetGuild(ctx.GuildID, l); err != nil {
+		log.Printf("Error saving locale of guild %s: %v", ctx.GuildID, err)
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Could not save the server language."))
+		return
+	}
+
+	name := ctx.T("the default of the bot")
+	if l != "" {
+		name = l.Name()
+	}
+	ctx.ReplyEphemeral(utils.SuccessEmbed(ctx.T("Language"), ctx.T("Default server language set to **%s**.", name)))
+}
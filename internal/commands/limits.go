@@ -0,0 +1,100 @@
+package commands
+
+import (
+	"estudocoin/internal/bot"
+	"estudocoin/internal/games"
+	"estudocoin/pkg/utils"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// exclusionDurations são os períodos de autoexclusão aceitos por /limits exclude
+var exclusionDurations = map[string]time.Duration{
+	"1d":  24 * time.Hour,
+	"3d":  3 * 24 * time.Hour,
+	"7d":  7 * 24 * time.Hour,
+	"14d": 14 * 24 * time.Hour,
+	"30d": 30 * 24 * time.Hour,
+}
+
+// Os limites são pessoais, então as respostas vão só para o usuário
+var limitsCommands = []*bot.Command{
+	{
+		Name:        "limits",
+		Description: "Responsible gambling: loss limits, max bet and self-exclusion",
+		Aliases:     []string{"limites"},
+		Private:     true,
+		Subcommands: []*bot.Command{
+			{
+				Name:        "view",
+				Description: "Your limits and what you lost today and this week",
+				Handler:     cmdLimitsView,
+			},
+			{
+				Name:        "set",
+				Description: "Set a limit (0 removes it). Looser limits only apply after a cooling-off delay",
+				Options: []*bot.Option{
+					{Name: "limit", Description: "Which limit", Type: discordgo.ApplicationCommandOptionString, Required: true, Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "Daily loss", Value: games.LimitDailyLoss},
+						{Name: "Weekly loss", Value: games.LimitWeeklyLoss},
+						{Name: "Max bet", Value: games.LimitMaxBet},
+					}},
+					{Name: "amount", Description: "Amount of coins (0 = no limit)", Type: discordgo.ApplicationCommandOptionInteger, Required: true, MinValue: bot.Min(0)},
+				},
+				Handler: cmdLimitsSet,
+			},
+			{
+				Name:        "exclude",
+				Description: "Block all your bets for a while. It can't be undone",
+				Options: []*bot.Option{
+					{Name: "duration", Description: "How long", Type: discordgo.ApplicationCommandOptionString, Required: true, Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "24 hours", Value: "1d"},
+						{Name: "3 days", Value: "3d"},
+						{Name: "7 days", Value: "7d"},
+						{Name: "14 days", Value: "14d"},
+						{Name: "30 days", Value: "30d"},
+					}},
+				},
+				Handler: cmdLimitsExclude,
+			},
+		},
+		Handler: cmdLimitsView,
+	},
+}
+
+func cmdLimitsView(ctx *bot.Context) {
+	ctx.ReplyEphemeral(games.LimitsEmbed(ctx.Locale(), ctx.Author.ID))
+}
+
+func cmdLimitsSet(ctx *bot.Context) {
+	effective, err := games.SetLimit(ctx.Author.ID, ctx.String("limit"), ctx.Int("amount"))
+	if err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(ctx.Err(err)))
+		return
+	}
+
+	if time.Until(effective) > time.Minute {
+		ctx.ReplyEphemeral(utils.InfoEmbed(ctx.T("Limit Scheduled"),
+			ctx.T("Loosening a limit takes %d hours. Your new limit applies <t:%d:R>; until then the current one stays.",
+				int(games.CoolingOff().Hours()), effective.Unix())))
+		return
+	}
+	ctx.ReplyEphemeral(utils.SuccessEmbed(ctx.T("Limit Updated"), ctx.T("Your new limit applies now.")))
+}
+
+func cmdLimitsExclude(ctx *bot.Context) {
+	duration, ok := exclusionDurations[ctx.String("duration")]
+	if !ok {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Choose 1d, 3d, 7d, 14d or 30d."))
+		return
+	}
+
+	until, err := games.SelfExclude(ctx.Author.ID, duration)
+	if err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(ctx.Err(err)))
+		return
+	}
+	ctx.ReplyEphemeral(utils.SuccessEmbed(ctx.T("Self-Exclusion Active"),
+		ctx.T("You can't place any bet until <t:%d:f>. This can't be undone, not even by an admin.", until.Unix())))
+}
@@ -1,314 +1,244 @@
 package commands
 
 import (
+	"context"
+	"errors"
+	"estudocoin/internal/bot"
 	"estudocoin/internal/database"
+	"estudocoin/internal/i18n"
+	"estudocoin/internal/service"
 	"estudocoin/pkg/config"
 	"estudocoin/pkg/utils"
 	"fmt"
-	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
 )
 
-// PendingLoanRequest representa uma solicitação de empréstimo pendente
-type PendingLoanRequest struct {
-	Loan      *database.Loan
-	Timeout   *time.Timer
-	MessageID string
-}
-
-var (
-	// loans armazena todos os empréstimos ativos: loanID -> Loan
-	loans = make(map[string]*database.Loan)
-	loansMu sync.RWMutex
-
-	// pendingLoans armazena solicitações pendentes: borrowerID -> PendingLoanRequest
-	pendingLoans = make(map[string]*PendingLoanRequest)
-	pendingMu    sync.Mutex
-
-	// loanIDCounter para gerar IDs únicos
-	loanIDCounter int64
-	loanIDMu      sync.Mutex
-)
-
-// generateLoanID gera um ID único para o empréstimo
-func generateLoanID() string {
-	loanIDMu.Lock()
-	defer loanIDMu.Unlock()
-	loanIDCounter++
-	return fmt.Sprintf("loan_%d_%d", time.Now().Unix(), loanIDCounter)
+var loanCommands = []*bot.Command{
+	{
+		Name:        "loan",
+		Description: "Lend coins to other users",
+		Subcommands: []*bot.Command{
+			{
+				Name:        "offer",
+				Description: "Offer a loan to another user",
+				Economic:    true,
+				Options: []*bot.Option{
+					{Name: "user", Description: "The user to lend money to", Type: discordgo.ApplicationCommandOptionUser, Required: true},
+					{Name: "amount", Description: "Amount to lend", Type: discordgo.ApplicationCommandOptionInteger, Required: true, MinValue: bot.Min(1)},
+					{Name: "interest", Description: "Interest percentage (e.g. 10 for 10%)", Type: discordgo.ApplicationCommandOptionNumber, Required: true, MinValue: bot.Min(0), MaxValue: 100},
+					{Name: "days", Description: "Days until payment is due", Type: discordgo.ApplicationCommandOptionInteger, Required: true, MinValue: bot.Min(1), MaxValue: 365},
+				},
+				Handler: CmdLoanOffer,
+			},
+			{
+				Name:        "pay",
+				Description: "Pay back a loan",
+				Economic:    true,
+				Options: []*bot.Option{
+					{Name: "loan_id", Description: "Loan ID (defaults to your first active loan)", Type: discordgo.ApplicationCommandOptionString},
+				},
+				Handler: CmdLoanPay,
+			},
+			{
+				Name:        "list",
+				Description: "List active loans",
+				Private:     true,
+				Options: []*bot.Option{
+					{Name: "user", Description: "Show the loans of another user", Type: discordgo.ApplicationCommandOptionUser},
+				},
+				Handler: CmdLoanList,
+			},
+		},
+		Handler: func(ctx *bot.Context) {
+			if len(ctx.Args) > 0 {
+				ctx.Reply(ctx.ErrorEmbed("Unknown loan command. Use `!loan offer`, `!loan pay`, or `!loan list`"))
+				return
+			}
+			ctx.Reply(utils.InfoEmbed(ctx.T("Loan System"),
+				ctx.T("**Commands:**\n"+
+					"`!loan offer @user <amount> <interest> <days>` - Offer a loan\n"+
+					"`!loan pay [loan_id]` - Pay a loan\n"+
+					"`!loan list [@user]` - List active loans")))
+		},
+	},
 }
 
 // CmdLoanOffer cria uma oferta de empréstimo para outro usuário
 // Uso: !loan offer @user <amount> <interest_rate> <days>
-func CmdLoanOffer(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
-	if len(args) < 5 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.InfoEmbed("Loan System",
-			"**Usage:** `!loan offer @user <amount> <interest_rate> <days>`\n\n"+
-				"**Parameters:**\n"+
-				"• `@user` - The user you want to lend money to\n"+
-				"• `amount` - Amount to lend\n"+
-				"• `interest_rate` - Interest percentage (e.g., 10 for 10%)\n"+
-				"• `days` - Days until payment is due\n\n"+
-				"**Example:** `!loan offer @John 1000 10 7`\n"+
-				"(Lend 1000 with 10% interest, due in 7 days)"))
-		return
-	}
-
-	// Verificar menção
-	if len(m.Mentions) == 0 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Please mention a user to lend money to."))
+func CmdLoanOffer(ctx *bot.Context) {
+	target := ctx.User("user")
+	if err := sendLoanOffer(ctx.Session, ctx.ChannelID, ctx.GuildID, ctx.Author.ID, target,
+		ctx.Int("amount"), ctx.Float("interest"), ctx.Int("days")); err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(ctx.Err(err)))
 		return
 	}
 
-	borrower := m.Mentions[0]
-	lenderID := m.Author.ID
-
-	// Não pode emprestar para si mesmo
-	if borrower.ID == lenderID {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("You cannot lend money to yourself!"))
-		return
+	// A oferta já foi publicada no canal; o slash só precisa ser confirmado
+	if ctx.IsSlash() {
+		ctx.ReplyEphemeral(utils.InfoEmbed(ctx.T("Loan System"), ctx.T("📩 Loan offer sent to <@%s>!", target.ID)))
 	}
+}
 
+// sendLoanOffer registra a oferta e publica a mensagem com os botões de aceitar/recusar
+func sendLoanOffer(s *discordgo.Session, channelID, guildID, lenderID string, borrower *discordgo.User, amount int, interestRate float64, days int) error {
 	// Não pode emprestar para bots
 	if borrower.Bot {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("You cannot lend money to bots!"))
-		return
-	}
-
-	// Parse amount (args[2] porque args[0]=offer, args[1]=@usuario)
-	amount, err := strconv.Atoi(args[2])
-	if err != nil || amount <= 0 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Invalid amount. Must be a positive number."))
-		return
-	}
-
-	// Parse interest rate
-	interestRate, err := strconv.ParseFloat(args[3], 64)
-	if err != nil || interestRate < 0 || interestRate > 100 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Invalid interest rate. Must be between 0 and 100."))
-		return
+		return i18n.Errorf("You cannot lend money to bots!")
 	}
 
-	// Parse days
-	days, err := strconv.Atoi(args[4])
-	if err != nil || days <= 0 || days > 365 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Invalid number of days. Must be between 1 and 365."))
-		return
-	}
-
-	// Verificar se o usuário já tem uma solicitação pendente
-	pendingMu.Lock()
-	if _, exists := pendingLoans[borrower.ID]; exists {
-		pendingMu.Unlock()
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed(fmt.Sprintf("<@%s> already has a pending loan request!", borrower.ID)))
-		return
+	loan, err := service.Loans.Offer(context.Background(), lenderID, borrower.ID, amount, interestRate, days, channelID, guildID)
+	if err != nil {
+		return err
 	}
-	pendingMu.Unlock()
 
-	// Verificar saldo do credor
-	lenderBalance := database.GetBalance(lenderID)
-	if lenderBalance < amount {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed(fmt.Sprintf("Insufficient balance! You have %d %s", lenderBalance, config.Bot.CurrencySymbol)))
-		return
+	// A oferta é pública: usa o idioma do servidor
+	loc := i18n.ForGuild(guildID)
+	msg, err := s.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Embeds:     []*discordgo.MessageEmbed{loanOfferEmbed(loc, loan)},
+		Components: loanOfferButtons(loc, loan),
+	})
+	if err != nil {
+		service.Loans.CancelOffer(loan)
+		return i18n.Errorf("Error creating loan offer.")
 	}
 
-	// Calcular valor total
-	interest := int(float64(amount) * (interestRate / 100))
-	totalOwed := amount + interest
-	dueDate := time.Now().Add(time.Duration(days) * 24 * time.Hour)
-
-	// Criar o empréstimo
-	loan := &database.Loan{
-		ID:           generateLoanID(),
-		LenderID:     lenderID,
-		BorrowerID:   borrower.ID,
-		Amount:       amount,
-		InterestRate: interestRate,
-		DueDate:      dueDate,
-		TotalOwed:    totalOwed,
-		Paid:         false,
-		CreatedAt:    time.Now(),
-		ChannelID:    m.ChannelID,
-		GuildID:      m.GuildID,
-	}
+	// Guardar a mensagem para atualizá-la ao expirar
+	service.Loans.SetOfferMessage(loan.BorrowerID, loan.ID, msg.ID)
+	return nil
+}
 
-	// Criar mensagem de confirmação
-	embed := &discordgo.MessageEmbed{
-		Title:       "💰 Loan Offer",
-		Description: fmt.Sprintf("<@%s> wants to lend money to <@%s>!", lenderID, borrower.ID),
+// loanOfferEmbed monta a mensagem de confirmação da oferta
+func loanOfferEmbed(loc i18n.Locale, loan *database.Loan) *discordgo.MessageEmbed {
+	return &discordgo.MessageEmbed{
+		Title:       loc.T("💰 Loan Offer"),
+		Description: loc.T("<@%s> wants to lend money to <@%s>!", loan.LenderID, loan.BorrowerID),
 		Color:       0xFFD700,
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name:   "💵 Amount",
-				Value:  fmt.Sprintf("%d %s", amount, config.Bot.CurrencySymbol),
+				Name:   loc.T("💵 Amount"),
+				Value:  fmt.Sprintf("%d %s", loan.Amount, config.Bot().CurrencySymbol),
 				Inline: true,
 			},
 			{
-				Name:   "📈 Interest Rate",
-				Value:  fmt.Sprintf("%.1f%%", interestRate),
+				Name:   loc.T("📈 Interest Rate"),
+				Value:  fmt.Sprintf("%.1f%%", loan.InterestRate),
 				Inline: true,
 			},
 			{
-				Name:   "💸 Total to Pay",
-				Value:  fmt.Sprintf("%d %s", totalOwed, config.Bot.CurrencySymbol),
+				Name:   loc.T("💸 Total to Pay"),
+				Value:  fmt.Sprintf("%d %s", loan.TotalOwed, config.Bot().CurrencySymbol),
 				Inline: true,
 			},
 			{
-				Name:   "📅 Due Date",
-				Value:  fmt.Sprintf("<t:%d:f>", dueDate.Unix()),
+				Name:   loc.T("📅 Due Date"),
+				Value:  fmt.Sprintf("<t:%d:f>", loan.DueDate.Unix()),
 				Inline: true,
 			},
 			{
-				Name:   "⏱️ Time to Accept",
-				Value:  "1 minute",
+				Name:   loc.T("⏱️ Time to Accept"),
+				Value:  loc.T("1 minute"),
 				Inline: true,
 			},
 		},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: fmt.Sprintf("Loan ID: %s", loan.ID),
+			Text: loc.T("Loan ID: %s", loan.ID),
 		},
 	}
+}
 
-	buttons := []discordgo.MessageComponent{
+func loanOfferButtons(loc i18n.Locale, loan *database.Loan) []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{
 		discordgo.ActionsRow{
 			Components: []discordgo.MessageComponent{
 				discordgo.Button{
-					Label:    "✅ Accept",
+					Label:    loc.T("✅ Accept"),
 					Style:    discordgo.SuccessButton,
 					CustomID: fmt.Sprintf("loan_accept_%s", loan.ID),
 				},
 				discordgo.Button{
-					Label:    "❌ Decline",
+					Label:    loc.T("❌ Decline"),
 					Style:    discordgo.DangerButton,
 					CustomID: fmt.Sprintf("loan_decline_%s", loan.ID),
 				},
 			},
 		},
 	}
+}
 
-	msg, err := s.ChannelMessageSendComplex(m.ChannelID, &discordgo.MessageSend{
-		Embeds:     []*discordgo.MessageEmbed{embed},
-		Components: buttons,
-	})
-
-	if err != nil {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Error creating loan offer."))
-		return
-	}
-
-	// Configurar timeout de 1 minuto
-	timeout := time.AfterFunc(1*time.Minute, func() {
-		expireLoanOffer(s, loan.ID, m.ChannelID, msg.ID)
-	})
-
-	// Armazenar solicitação pendente
-	pendingMu.Lock()
-	pendingLoans[borrower.ID] = &PendingLoanRequest{
-		Loan:      loan,
-		Timeout:   timeout,
-		MessageID: msg.ID,
+// loanOfferClosedMessage retorna o texto que substitui a oferta depois de encerrada
+func loanOfferClosedMessage(loc i18n.Locale, loan *database.Loan, outcome service.OfferOutcome, err error) string {
+	switch outcome {
+	case service.OfferAccepted:
+		return loc.T("✅ **Loan Accepted!**\n<@%s> received **%d %s** from <@%s>.\nTotal to pay: **%d %s** by <t:%d:f>",
+			loan.BorrowerID, loan.Amount, config.Bot().CurrencySymbol, loan.LenderID,
+			loan.TotalOwed, config.Bot().CurrencySymbol, loan.DueDate.Unix())
+	case service.OfferDeclined:
+		return loc.T("❌ <@%s> declined the loan offer.", loan.BorrowerID)
+	case service.OfferExpired:
+		return loc.T("⏰ **Loan offer expired!** <@%s> did not respond in time.", loan.BorrowerID)
+	default:
+		return "❌ " + loc.Err(err)
 	}
-	pendingMu.Unlock()
 }
 
 // CmdLoanPay permite ao devedor pagar um empréstimo
 // Uso: !loan pay [loan_id] ou !loan pay (paga o primeiro empréstimo ativo)
-func CmdLoanPay(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
-	borrowerID := m.Author.ID
-
-	// Buscar empréstimos ativos do usuário
-	loansMu.RLock()
-	var userLoans []*database.Loan
-	for _, loan := range loans {
-		if loan.BorrowerID == borrowerID && !loan.Paid {
-			userLoans = append(userLoans, loan)
-		}
-	}
-	loansMu.RUnlock()
-
-	if len(userLoans) == 0 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("You don't have any active loans to pay!"))
+func CmdLoanPay(ctx *bot.Context) {
+	loan, err := service.Loans.Pay(context.Background(), ctx.Author.ID, ctx.String("loan_id"))
+	if err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(ctx.Err(err)))
 		return
 	}
 
-	var loanToPay *database.Loan
-
-	// Se especificou um ID, procurar por ele
-	if len(args) >= 2 {
-		loanID := args[1]
-		for _, loan := range userLoans {
-			if loan.ID == loanID {
-				loanToPay = loan
-				break
-			}
-		}
-		if loanToPay == nil {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Loan not found or already paid!"))
-			return
-		}
-	} else {
-		// Pega o empréstimo mais antigo (primeiro da lista)
-		loanToPay = userLoans[0]
-	}
-
-	// Verificar saldo
-	balance := database.GetBalance(borrowerID)
-	if balance < loanToPay.TotalOwed {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed(
-			fmt.Sprintf("Insufficient balance! You need %d %s but have %d %s.",
-				loanToPay.TotalOwed, config.Bot.CurrencySymbol, balance, config.Bot.CurrencySymbol)))
-		return
-	}
+	// Enviar confirmação
+	ctx.Reply(loanPaidEmbed(ctx.Locale(), loan))
+}
 
-	// Realizar pagamento
-	processLoanPayment(s, m.ChannelID, loanToPay, borrowerID)
+func loanPaidEmbed(loc i18n.Locale, loan *database.Loan) *discordgo.MessageEmbed {
+	return utils.SuccessEmbed(loc.T("Loan Paid!"),
+		loc.T("<@%s> paid **%d %s** to <@%s>**!**\nLoan `%s` is now fully repaid! 🎉",
+			loan.BorrowerID, loan.TotalOwed, config.Bot().CurrencySymbol, loan.LenderID, loan.ID))
 }
 
 // CmdLoanList lista todos os empréstimos ativos do usuário
 // Uso: !loan list ou !loan list @user (para ver de outro usuário)
-func CmdLoanList(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
-	targetID := m.Author.ID
-	targetName := m.Author.Username
+func CmdLoanList(ctx *bot.Context) {
+	target := ctx.Author
 	isOwn := true
 
 	// Se mencionou alguém, mostra os empréstimos dele
-	if len(m.Mentions) > 0 {
-		targetID = m.Mentions[0].ID
-		targetName = m.Mentions[0].Username
-		isOwn = false
+	if user := ctx.User("user"); user != nil {
+		target = user
+		isOwn = target.ID == ctx.Author.ID
 	}
 
-	loansMu.RLock()
-	var userLoans []*database.Loan
-	for _, loan := range loans {
-		if (loan.BorrowerID == targetID || loan.LenderID == targetID) && !loan.Paid {
-			userLoans = append(userLoans, loan)
-		}
-	}
-	loansMu.RUnlock()
+	ctx.Reply(loanListEmbed(ctx.Locale(), target, isOwn))
+}
+
+// loanListEmbed monta a lista de empréstimos ativos de um usuário
+func loanListEmbed(loc i18n.Locale, target *discordgo.User, isOwn bool) *discordgo.MessageEmbed {
+	targetID := target.ID
+	userLoans := service.Loans.Active(targetID)
 
 	if len(userLoans) == 0 {
 		if isOwn {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.InfoEmbed("Loans", "You don't have any active loans!"))
-		} else {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.InfoEmbed("Loans", fmt.Sprintf("%s doesn't have any active loans!", targetName)))
+			return utils.InfoEmbed(loc.T("Loans"), loc.T("You don't have any active loans!"))
 		}
-		return
+		return utils.InfoEmbed(loc.T("Loans"), loc.T("%s doesn't have any active loans!", target.Username))
 	}
 
 	// Construir a lista
 	var description strings.Builder
-	description.WriteString(fmt.Sprintf("**Active Loans for %s**\n\n", targetName))
+	description.WriteString(loc.T("**Active Loans for %s**", target.Username) + "\n\n")
 
 	for i, loan := range userLoans {
-		role := "Borrower"
+		role := loc.T("Borrower")
 		otherParty := loan.LenderID
 		if loan.LenderID == targetID {
-			role = "Lender"
+			role = loc.T("Lender")
 			otherParty = loan.BorrowerID
 		}
 
@@ -318,7 +248,7 @@ func CmdLoanList(s *discordgo.Session, m *discordgo.MessageCreate, args []string
 			statusEmoji = "🟡"
 		}
 		if timeLeft < 0 {
-			statusEmoji = "🔴 OVERDUE"
+			statusEmoji = loc.T("🔴 OVERDUE")
 		}
 
 		// Truncar ID de forma segura
@@ -327,105 +257,51 @@ func CmdLoanList(s *discordgo.Session, m *discordgo.MessageCreate, args []string
 			idDisplay = idDisplay[:20] + "..."
 		}
 
-		description.WriteString(fmt.Sprintf(
+		description.WriteString(loc.T(
 			"**%d.** `%s`\n"+
 			"Role: %s | Other: <@%s>\n"+
 			"Amount: %d %s | Total: %d %s\n"+
 			"Due: %s %s\n\n",
 			i+1, idDisplay,
 			role, otherParty,
-			loan.Amount, config.Bot.CurrencySymbol, loan.TotalOwed, config.Bot.CurrencySymbol,
-			statusEmoji, formatDuration(timeLeft),
+			loan.Amount, config.Bot().CurrencySymbol, loan.TotalOwed, config.Bot().CurrencySymbol,
+			statusEmoji, formatDuration(loc, timeLeft),
 		))
 	}
 
-	s.ChannelMessageSendEmbed(m.ChannelID, utils.InfoEmbed("📋 Active Loans", description.String()))
+	return utils.InfoEmbed(loc.T("📋 Active Loans"), description.String())
 }
 
 // HandleLoanAccept aceita uma oferta de empréstimo
 func HandleLoanAccept(s *discordgo.Session, i *discordgo.InteractionCreate, loanID string) {
 	userID := i.Member.User.ID
+	loc := i18n.ForInteraction(i)
+
+	// A resposta da interação atualiza a mensagem; não editar pelo callback
+	service.Loans.SetOfferMessage(userID, loanID, "")
 
-	pendingMu.Lock()
-	request, exists := pendingLoans[userID]
-	if !exists || request.Loan.ID != loanID {
-		pendingMu.Unlock()
+	loan, err := service.Loans.Accept(context.Background(), userID, loanID)
+	if errors.Is(err, service.ErrLoanOfferNotFound) {
 		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
-				Content: "❌ This loan offer has expired or is invalid!",
+				Content: "❌ " + loc.Err(err),
 				Flags:   discordgo.MessageFlagsEphemeral,
 			},
 		})
 		return
 	}
 
-	// Cancelar timeout
-	request.Timeout.Stop()
-	delete(pendingLoans, userID)
-	pendingMu.Unlock()
-
-	loan := request.Loan
-
-	// Verificar se o credor ainda tem saldo
-	lenderBalance := database.GetBalance(loan.LenderID)
-	if lenderBalance < loan.Amount {
-		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-			Type: discordgo.InteractionResponseUpdateMessage,
-			Data: &discordgo.InteractionResponseData{
-				Content:    fmt.Sprintf("❌ <@%s> no longer has sufficient balance!", loan.LenderID),
-				Embeds:     []*discordgo.MessageEmbed{},
-				Components: []discordgo.MessageComponent{},
-			},
-		})
-		return
-	}
-
-	// Transferir dinheiro
-	err := database.TransferCoins(loan.LenderID, loan.BorrowerID, loan.Amount)
-	if err != nil {
-		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-			Type: discordgo.InteractionResponseUpdateMessage,
-			Data: &discordgo.InteractionResponseData{
-				Content:    "❌ Error processing loan transaction!",
-				Embeds:     []*discordgo.MessageEmbed{},
-				Components: []discordgo.MessageComponent{},
-			},
-		})
-		return
-	}
-
-	// Salvar empréstimo no banco
-	err = database.SaveLoan(loan)
+	outcome := service.OfferAccepted
 	if err != nil {
-		// Tentar reverter a transferência
-		database.TransferCoins(loan.BorrowerID, loan.LenderID, loan.Amount)
-		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-			Type: discordgo.InteractionResponseUpdateMessage,
-			Data: &discordgo.InteractionResponseData{
-				Content:    "❌ Error saving loan to database!",
-				Embeds:     []*discordgo.MessageEmbed{},
-				Components: []discordgo.MessageComponent{},
-			},
-		})
-		return
+		outcome = service.OfferFailed
 	}
 
-	// Adicionar à lista de empréstimos ativos
-	loansMu.Lock()
-	loans[loan.ID] = loan
-	loansMu.Unlock()
-
-	// Agendar cobrança automática
-	scheduleAutoCollection(s, loan)
-
 	// Atualizar mensagem
 	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseUpdateMessage,
 		Data: &discordgo.InteractionResponseData{
-			Content: fmt.Sprintf("✅ **Loan Accepted!**\n<@%s> received **%d %s** from <@%s>.\nTotal to pay: **%d %s** by <t:%d:f>",
-				loan.BorrowerID, loan.Amount, config.Bot.CurrencySymbol, loan.LenderID,
-				loan.TotalOwed, config.Bot.CurrencySymbol, loan.DueDate.Unix()),
+			Content:    loanOfferClosedMessage(i18n.ForGuild(i.GuildID), loan, outcome, err),
 			Embeds:     []*discordgo.MessageEmbed{},
 			Components: []discordgo.MessageComponent{},
 		},
@@ -435,189 +311,87 @@ func HandleLoanAccept(s *discordgo.Session, i *discordgo.InteractionCreate, loan
 // HandleLoanDecline recusa uma oferta de empréstimo
 func HandleLoanDecline(s *discordgo.Session, i *discordgo.InteractionCreate, loanID string) {
 	userID := i.Member.User.ID
+	loc := i18n.ForInteraction(i)
+	service.Loans.SetOfferMessage(userID, loanID, "")
 
-	pendingMu.Lock()
-	request, exists := pendingLoans[userID]
-	if !exists || request.Loan.ID != loanID {
-		pendingMu.Unlock()
+	loan, err := service.Loans.Decline(context.Background(), userID, loanID)
+	if err != nil {
 		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
-				Content: "❌ This loan offer has expired or is invalid!",
+				Content: "❌ " + loc.Err(err),
 				Flags:   discordgo.MessageFlagsEphemeral,
 			},
 		})
 		return
 	}
 
-	request.Timeout.Stop()
-	delete(pendingLoans, userID)
-	pendingMu.Unlock()
-
 	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseUpdateMessage,
 		Data: &discordgo.InteractionResponseData{
-			Content:    fmt.Sprintf("❌ <@%s> declined the loan offer.", userID),
+			Content:    loanOfferClosedMessage(i18n.ForGuild(i.GuildID), loan, service.OfferDeclined, nil),
 			Embeds:     []*discordgo.MessageEmbed{},
 			Components: []discordgo.MessageComponent{},
 		},
 	})
 }
 
-// expireLoanOffer expira uma oferta de empréstimo após timeout
-func expireLoanOffer(s *discordgo.Session, loanID, channelID, messageID string) {
-	pendingMu.Lock()
-	var borrowerID string
-	for uid, req := range pendingLoans {
-		if req.Loan.ID == loanID {
-			borrowerID = uid
-			delete(pendingLoans, uid)
-			break
-		}
+// formatDuration formata a duração para exibição
+func formatDuration(loc i18n.Locale, d time.Duration) string {
+	if d < 0 {
+		d = -d
+		return loc.T("%d days overdue", int(d.Hours()/24))
+	}
+
+	days := int(d.Hours() / 24)
+	hours := int(d.Hours()) % 24
+
+	if days > 0 {
+		return fmt.Sprintf("%dd %dh", days, hours)
 	}
-	pendingMu.Unlock()
+	return fmt.Sprintf("%dh", hours)
+}
 
-	if borrowerID != "" {
-		content := fmt.Sprintf("⏰ **Loan offer expired!** <@%s> did not respond in time.", borrowerID)
+// LoadActiveLoans carrega empréstimos ativos do banco ao iniciar e
+// liga os avisos do serviço de empréstimos às mensagens do Discord
+func LoadActiveLoans(s *discordgo.Session) {
+	// Substituir a mensagem da oferta por um texto final, sem botões
+	service.Loans.OnOfferClosed = func(loan *database.Loan, messageID string, outcome service.OfferOutcome, err error) {
+		content := loanOfferClosedMessage(i18n.ForGuild(loan.GuildID), loan, outcome, err)
 		embeds := []*discordgo.MessageEmbed{}
 		components := []discordgo.MessageComponent{}
 		s.ChannelMessageEditComplex(&discordgo.MessageEdit{
-			Channel:    channelID,
+			Channel:    loan.ChannelID,
 			ID:         messageID,
 			Content:    &content,
 			Embeds:     &embeds,
 			Components: &components,
 		})
 	}
-}
-
-// processLoanPayment processa o pagamento de um empréstimo
-func processLoanPayment(s *discordgo.Session, channelID string, loan *database.Loan, payerID string) {
-	// Transferir do devedor para o credor
-	err := database.TransferCoins(loan.BorrowerID, loan.LenderID, loan.TotalOwed)
-	if err != nil {
-		s.ChannelMessageSendEmbed(channelID, utils.ErrorEmbed(
-			fmt.Sprintf("Error processing payment. You need %d %s.", loan.TotalOwed, config.Bot.CurrencySymbol)))
-		return
-	}
-
-	// Marcar como pago
-	loansMu.Lock()
-	loan.Paid = true
-	delete(loans, loan.ID)
-	loansMu.Unlock()
-
-	// Atualizar no banco
-	database.MarkLoanAsPaid(loan.ID)
-
-	// Enviar confirmação
-	s.ChannelMessageSendEmbed(channelID, utils.SuccessEmbed("Loan Paid!",
-		fmt.Sprintf("<@%s> paid **%d %s** to <@%s>**!**\nLoan `%s` is now fully repaid! 🎉",
-			payerID, loan.TotalOwed, config.Bot.CurrencySymbol, loan.LenderID, loan.ID)))
-}
-
-// scheduleAutoCollection agenda a cobrança automática no vencimento
-func scheduleAutoCollection(s *discordgo.Session, loan *database.Loan) {
-	timeUntilDue := loan.DueDate.Sub(time.Now())
-	if timeUntilDue <= 0 {
-		// Já venceu, cobrar imediatamente
-		go autoCollectLoan(s, loan)
-		return
-	}
-
-	// Agendar cobrança
-	time.AfterFunc(timeUntilDue, func() {
-		autoCollectLoan(s, loan)
-	})
-}
-
-// autoCollectLoan cobra automaticamente o empréstimo no vencimento
-func autoCollectLoan(s *discordgo.Session, loan *database.Loan) {
-	loansMu.RLock()
-	// Verificar se ainda existe e não foi pago
-	currentLoan, exists := loans[loan.ID]
-	loansMu.RUnlock()
-
-	if !exists || currentLoan.Paid {
-		return
-	}
 
-	// Tentar cobrar
-	borrowerBalance := database.GetBalance(loan.BorrowerID)
-
-	if borrowerBalance >= loan.TotalOwed {
-		// Tem saldo suficiente, cobrar
-		database.TransferCoins(loan.BorrowerID, loan.LenderID, loan.TotalOwed)
-
-		loansMu.Lock()
-		loan.Paid = true
-		delete(loans, loan.ID)
-		loansMu.Unlock()
-
-		database.MarkLoanAsPaid(loan.ID)
-
-		// Notificar
-		s.ChannelMessageSendEmbed(loan.ChannelID, utils.SuccessEmbed("Auto Payment Executed",
-			fmt.Sprintf("💰 Loan auto-collected!\n<@%s> paid **%d %s** to <@%s>.\nLoan `%s` is now fully repaid! ✅",
-				loan.BorrowerID, loan.TotalOwed, config.Bot.CurrencySymbol, loan.LenderID, loan.ID)))
-	} else {
-		// Não tem saldo suficiente, deixar negativo
-		// Primeiro zera o saldo atual (vai para o credor)
-		if borrowerBalance > 0 {
-			database.TransferCoins(loan.BorrowerID, loan.LenderID, borrowerBalance)
+	// Notificar a cobrança automática no canal do empréstimo
+	service.Loans.OnCollected = func(loan *database.Loan, collected int, defaulted bool) {
+		if loan.ChannelID == "" {
+			return
+		}
+		loc := i18n.ForGuild(loan.GuildID)
+		if !defaulted {
+			s.ChannelMessageSendEmbed(loan.ChannelID, utils.SuccessEmbed(loc.T("Auto Payment Executed"),
+				loc.T("💰 Loan auto-collected!\n<@%s> paid **%d %s** to <@%s>.\nLoan `%s` is now fully repaid! ✅",
+					loan.BorrowerID, loan.TotalOwed, config.Bot().CurrencySymbol, loan.LenderID, loan.ID)))
+			return
 		}
 
-		// Adiciona o restante como dívida (saldo negativo)
-		remaining := loan.TotalOwed - borrowerBalance
-		database.AddCoins(loan.BorrowerID, -remaining)
-
-		loansMu.Lock()
-		loan.Paid = true
-		delete(loans, loan.ID)
-		loansMu.Unlock()
-
-		database.MarkLoanAsPaid(loan.ID)
-
-		// Notificar
+		remaining := loan.TotalOwed - collected
 		s.ChannelMessageSendEmbed(loan.ChannelID, &discordgo.MessageEmbed{
-			Title:       "⚠️ Loan Defaulted",
-			Description: fmt.Sprintf("**LOAN DEFAULTED**\n<@%s> didn't have enough funds!\n"+
+			Title:       loc.T("⚠️ Loan Defaulted"),
+			Description: loc.T("**LOAN DEFAULTED**\n<@%s> didn't have enough funds!\n"+
 				"Collected: **%d %s** | Remaining debt: **%d %s**\n"+
 				"Loan `%s` marked as paid with negative balance! 💸",
-				loan.BorrowerID, borrowerBalance, config.Bot.CurrencySymbol, remaining, config.Bot.CurrencySymbol, loan.ID),
+				loan.BorrowerID, collected, config.Bot().CurrencySymbol, remaining, config.Bot().CurrencySymbol, loan.ID),
 			Color: 0xFF0000,
 		})
 	}
-}
-
-// formatDuration formata a duração para exibição
-func formatDuration(d time.Duration) string {
-	if d < 0 {
-		d = -d
-		return fmt.Sprintf("%d days overdue", int(d.Hours()/24))
-	}
-
-	days := int(d.Hours() / 24)
-	hours := int(d.Hours()) % 24
-
-	if days > 0 {
-		return fmt.Sprintf("%dd %dh", days, hours)
-	}
-	return fmt.Sprintf("%dh", hours)
-}
-
-// LoadActiveLoans carrega empréstimos ativos do banco ao iniciar
-func LoadActiveLoans(s *discordgo.Session) {
-	activeLoans, err := database.GetActiveLoans()
-	if err != nil {
-		return
-	}
 
-	loansMu.Lock()
-	for _, loan := range activeLoans {
-		loans[loan.ID] = loan
-		// Reagendar cobrança
-		go scheduleAutoCollection(s, loan)
-	}
-	loansMu.Unlock()
+	service.Loans.Load()
 }
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"estudocoin/internal/bot"
+	"estudocoin/internal/database"
+	"estudocoin/pkg/utils"
+	"log"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// As configurações são pessoais, então as respostas vão só para o usuário
+var settingsCommands = []*bot.Command{
+	{
+		Name:        "settings",
+		Description: "Your personal settings",
+		Aliases:     []string{"configuracoes"},
+		Private:     true,
+		Subcommands: []*bot.Command{
+			{
+				Name:        "privacy",
+				Description: "Choose who can see your balance",
+				Options: []*bot.Option{
+					{Name: "balance", Description: "Show or hide your balance in !balance @user and the leaderboard", Type: discordgo.ApplicationCommandOptionString, Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "Hidden", Value: "hidden"},
+						{Name: "Public", Value: "public"},
+					}},
+				},
+				Handler: cmdSettingsPrivacy,
+			},
+		},
+		Handler: cmdSettingsPrivacy,
+	},
+}
+
+func cmdSettingsPrivacy(ctx *bot.Context) {
+	if ctx.Has("balance") {
+		hidden := ctx.String("balance") == "hidden"
+		if err := database.SetBalanceHidden(ctx.Author.ID, hidden); err != nil {
+			log.Printf("[SETTINGS] Error saving privacy of %s: %v", ctx.Author.ID, err)
+			ctx.ReplyEphemeral(ctx.ErrorEmbed("Could not save your settings."))
+			return
+		}
+	}
+
+	status := ctx.T("🔓 **Public**: anyone can see your balance and you appear in the leaderboard.")
+	if database.IsBalanceHidden(ctx.Author.ID) {
+		status = ctx.T("🔒 **Hidden**: only you can see your balance and you don't appear in the leaderboard.")
+	}
+	ctx.ReplyEphemeral(utils.InfoEmbed(ctx.T("Privacy"),
+		ctx.T("%s\n\nUse `/settings privacy` to change it.", status)))
+}
@@ -1,21 +1,76 @@
 package commands
 
 import (
+	"estudocoin/internal/audit"
+	"estudocoin/internal/bot"
 	"estudocoin/internal/database"
 	"estudocoin/internal/games"
 	"estudocoin/pkg/config"
 	"estudocoin/pkg/utils"
 	"fmt"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
 )
 
-func CmdShop(s *discordgo.Session, m *discordgo.MessageCreate) {
-	sym := config.Bot.CurrencySymbol
-	desc := fmt.Sprintf(`
+var shopCommands = []*bot.Command{
+	{
+		Name:        "shop",
+		Description: "View available items in the shop",
+		Aliases:     []string{"store", "loja"},
+		Handler:     CmdShop,
+	},
+	{
+		Name:        "buy",
+		Description: "Buy items from the shop",
+		Aliases:     []string{"purchase", "comprar"},
+		Subcommands: []*bot.Command{
+			{
+				Name:        "nickname",
+				Description: "Change your own nickname",
+				Options: []*bot.Option{
+					{Name: "new_name", Description: "The new nickname", Type: discordgo.ApplicationCommandOptionString, Required: true, Rest: true},
+				},
+				Handler: cmdBuyNickname,
+			},
+			{
+				Name:        "rename",
+				Description: "Change someone else's nickname",
+				Options: []*bot.Option{
+					{Name: "user", Description: "The user to rename", Type: discordgo.ApplicationCommandOptionUser, Required: true},
+					{Name: "new_name", Description: "The new nickname", Type: discordgo.ApplicationCommandOptionString, Required: true, Rest: true},
+				},
+				Handler: cmdBuyRename,
+			},
+			{
+				Name:        "punishment",
+				Description: "Timeout a user (text & voice)",
+				Aliases:     []string{"timeout"},
+				Options: []*bot.Option{
+					{Name: "user", Description: "The user to time out", Type: discordgo.ApplicationCommandOptionUser, Required: true},
+					{Name: "minutes", Description: "Duration in minutes", Type: discordgo.ApplicationCommandOptionInteger, Required: true, MinValue: bot.Min(1)},
+				},
+				Handler: cmdBuyPunishment,
+			},
+			{
+				Name:        "mute",
+				Description: "Mute a user in voice (must be in a call)",
+				Options: []*bot.Option{
+					{Name: "user", Description: "The user to mute", Type: discordgo.ApplicationCommandOptionUser, Required: true},
+					{Name: "minutes", Description: "Duration in minutes", Type: discordgo.ApplicationCommandOptionInteger, Required: true, MinValue: bot.Min(1)},
+				},
+				Handler: cmdBuyMute,
+			},
+		},
+		Handler: func(ctx *bot.Context) {
+			ctx.Reply(utils.InfoEmbed(ctx.T("Shop"), ctx.T("Use `!shop` to see available items.")))
+		},
+	},
+}
+
+func CmdShop(ctx *bot.Context) {
+	sym := config.Bot().CurrencySymbol
+	desc := ctx.T(`
 **Available Items:**
 
 1. **Change Own Nickname**
@@ -33,185 +88,148 @@ func CmdShop(s *discordgo.Session, m *discordgo.MessageCreate) {
 4. **Mute User** (Voice only - must be in call)
    Cost: %d %s per minute
    Command: `+"`!buy mute @user <minutes>`"+`
-`, config.Economy.CostNicknameSelf, sym, config.Economy.CostNicknameOther, sym, config.Economy.CostPerMinutePunishment, sym, config.Economy.CostPerMinuteMute, sym)
 
-	s.ChannelMessageSendEmbed(m.ChannelID, utils.GoldEmbed(fmt.Sprintf("🛒 %s Shop", config.Bot.BotName), desc))
+*Every item is also available as* `+"`/buy`"+`.
+`, config.Economy().CostNicknameSelf, sym, config.Economy().CostNicknameOther, sym, config.Economy().CostPerMinutePunishment, sym, config.Economy().CostPerMinuteMute, sym)
+
+	ctx.Reply(utils.GoldEmbed(ctx.T("🛒 %s Shop", config.Bot().BotName), desc))
+}
+
+func cmdBuyNickname(ctx *bot.Context) {
+	userID := ctx.Author.ID
+	newName := ctx.String("new_name")
+
+	if database.GetBalance(userID) < config.Economy().CostNicknameSelf {
+		ctx.Reply(ctx.ErrorEmbed("Insufficient funds."))
+		return
+	}
+
+	err := ctx.Session.GuildMemberNickname(ctx.GuildID, userID, newName)
+	if err != nil {
+		ctx.Reply(ctx.ErrorEmbed("Could not change nickname (check my permissions)."))
+		return
+	}
+
+	database.CollectLostBet(userID, config.Economy().CostNicknameSelf)
+	ctx.Reply(utils.SuccessEmbed(ctx.T("Purchase Successful"), ctx.T("Your nickname has been changed!")))
+}
+
+func cmdBuyRename(ctx *bot.Context) {
+	userID := ctx.Author.ID
+	targetUser := ctx.User("user")
+	newName := ctx.String("new_name")
+
+	if database.GetBalance(userID) < config.Economy().CostNicknameOther {
+		ctx.Reply(ctx.ErrorEmbed("Insufficient funds."))
+		return
+	}
+
+	err := ctx.Session.GuildMemberNickname(ctx.GuildID, targetUser.ID, newName)
+	if err != nil {
+		ctx.Reply(ctx.ErrorEmbed("Error changing nickname (check permissions/hierarchy)."))
+		return
+	}
+
+	database.CollectLostBet(userID, config.Economy().CostNicknameOther)
+	audit.ShopPurchase(userID, targetUser.ID, "Rename", fmt.Sprintf("New nickname: %s", newName), config.Economy().CostNicknameOther)
+	ctx.Reply(utils.SuccessEmbed(ctx.T("Purchase Successful"), ctx.T("Nickname of %s changed.", targetUser.Username)))
 }
 
-func CmdBuy(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
-	if len(args) < 1 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.InfoEmbed("Shop", "Use `!shop` to see available items."))
+func cmdBuyPunishment(ctx *bot.Context) {
+	userID := ctx.Author.ID
+	targetUser := ctx.User("user")
+	minutes := ctx.Int("minutes")
+
+	cost := minutes * config.Economy().CostPerMinutePunishment
+	if database.GetBalance(userID) < cost {
+		ctx.Reply(ctx.ErrorEmbed("Insufficient funds. Cost: %d %s.", cost, config.Bot().CurrencySymbol))
+		return
+	}
+
+	waitForGame(ctx, targetUser, ctx.T("punishment"))
+
+	// Check existing timeout
+	member, err := ctx.Session.GuildMember(ctx.GuildID, targetUser.ID)
+	if err != nil {
+		ctx.Edit(&bot.Response{Embeds: []*discordgo.MessageEmbed{ctx.ErrorEmbed("Member not found.")}})
 		return
 	}
 
-	item := strings.ToLower(args[0])
-	userID := m.Author.ID
-
-	switch item {
-	case "nickname":
-		if len(args) < 2 {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Usage: `!buy nickname <new name>`"))
-			return
-		}
-		newName := strings.Join(args[1:], " ")
-		if database.GetBalance(userID) < config.Economy.CostNicknameSelf {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Insufficient funds."))
-			return
-		}
-		
-		err := s.GuildMemberNickname(m.GuildID, userID, newName)
-		if err != nil {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Could not change nickname (check my permissions)."))
-			return
-		}
-
-		database.CollectLostBet(userID, config.Economy.CostNicknameSelf)
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.SuccessEmbed("Purchase Successful", "Your nickname has been changed!"))
-
-	case "rename":
-		if len(m.Mentions) == 0 || len(args) < 3 {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Usage: `!buy rename @user <new name>`"))
-			return
-		}
-		targetUser := m.Mentions[0]
-		
-		// Find name after mention
-		nameStartIndex := 2
-		newName := strings.Join(args[nameStartIndex:], " ")
-
-		if database.GetBalance(userID) < config.Economy.CostNicknameOther {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Insufficient funds."))
-			return
-		}
-
-		err := s.GuildMemberNickname(m.GuildID, targetUser.ID, newName)
-		if err != nil {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Error changing nickname (check permissions/hierarchy)."))
-			return
-		}
-
-		database.CollectLostBet(userID, config.Economy.CostNicknameOther)
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.SuccessEmbed("Purchase Successful", fmt.Sprintf("Nickname of %s changed.", targetUser.Username)))
-
-	case "punishment", "timeout":
-		if len(m.Mentions) == 0 || len(args) < 3 {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Usage: `!buy punishment @user <minutes>`"))
-			return
-		}
-		targetUser := m.Mentions[0]
-		
-		minutesStr := args[len(args)-1] 
-		minutes, err := strconv.Atoi(minutesStr)
-		if err != nil || minutes <= 0 {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Invalid time."))
-			return
-		}
-
-		cost := minutes * config.Economy.CostPerMinutePunishment
-		if database.GetBalance(userID) < cost {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed(fmt.Sprintf("Insufficient funds. Cost: %d %s.", cost, config.Bot.CurrencySymbol)))
-			return
-		}
-
-		// Verificar se o usuário está em um jogo ativo
-		if games.IsUserInGame(targetUser.ID) {
-			msg, _ := s.ChannelMessageSendEmbed(m.ChannelID, utils.InfoEmbed("⏳ Aguardando", 
-				fmt.Sprintf("%s está em um jogo ativo. Aguardando o jogo terminar para aplicar o punishment...", targetUser.Username)))
-			
-			// Esperar o jogo acabar
-			games.WaitForGameFinish(targetUser.ID)
-			
-			// Deletar mensagem de espera
-			if msg != nil {
-				s.ChannelMessageDelete(m.ChannelID, msg.ID)
-			}
-		}
-
-		// Check existing timeout
-		member, err := s.GuildMember(m.GuildID, targetUser.ID)
-		if err != nil {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Member not found."))
-			return
-		}
-
-		var until time.Time
-		if member.CommunicationDisabledUntil != nil && member.CommunicationDisabledUntil.After(time.Now()) {
-			// Extend existing
-			until = member.CommunicationDisabledUntil.Add(time.Duration(minutes) * time.Minute)
-		} else {
-			// Start new
-			until = time.Now().Add(time.Duration(minutes) * time.Minute)
-		}
-
-		err = s.GuildMemberTimeout(m.GuildID, targetUser.ID, &until)
-		if err != nil {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Error applying timeout (check permissions/hierarchy)."))
-			return
-		}
-
-		database.CollectLostBet(userID, cost)
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.SuccessEmbed("Punishment Applied!", fmt.Sprintf("%s has been timed out until %s.", targetUser.Username, until.Format("15:04:05"))))
-
-	case "mute":
-		if len(m.Mentions) == 0 || len(args) < 3 {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Usage: `!buy mute @user <minutes>`"))
-			return
-		}
-		targetUser := m.Mentions[0]
-		
-		minutesStr := args[len(args)-1] 
-		minutes, err := strconv.Atoi(minutesStr)
-		if err != nil || minutes <= 0 {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Invalid time."))
-			return
-		}
-
-		cost := minutes * config.Economy.CostPerMinuteMute
-		if database.GetBalance(userID) < cost {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed(fmt.Sprintf("Insufficient funds. Cost: %d %s.", cost, config.Bot.CurrencySymbol)))
-			return
-		}
-
-		// Verificar se o usuário está em um jogo ativo
-		if games.IsUserInGame(targetUser.ID) {
-			msg, _ := s.ChannelMessageSendEmbed(m.ChannelID, utils.InfoEmbed("⏳ Aguardando", 
-				fmt.Sprintf("%s está em um jogo ativo. Aguardando o jogo terminar para aplicar o mute...", targetUser.Username)))
-			
-			// Esperar o jogo acabar
-			games.WaitForGameFinish(targetUser.ID)
-			
-			// Deletar mensagem de espera
-			if msg != nil {
-				s.ChannelMessageDelete(m.ChannelID, msg.ID)
-			}
-		}
-
-		// Check if target user is in a voice channel
-		voiceState, err := s.State.VoiceState(m.GuildID, targetUser.ID)
-		if err != nil || voiceState == nil || voiceState.ChannelID == "" {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed(fmt.Sprintf("%s is not in a voice channel! You can only mute users who are currently in a call.", targetUser.Username)))
-			return
-		}
-
-		// Apply server mute (voice only, not timeout)
-		err = s.GuildMemberMute(m.GuildID, targetUser.ID, true)
-		if err != nil {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Error muting user (check permissions/hierarchy)."))
-			return
-		}
-
-		// Remove coins
-		database.CollectLostBet(userID, cost)
-
-		// Schedule unmute after duration
-		go func() {
-			time.Sleep(time.Duration(minutes) * time.Minute)
-			s.GuildMemberMute(m.GuildID, targetUser.ID, false)
-		}()
-
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.SuccessEmbed("User Muted!", fmt.Sprintf("%s has been muted in voice for %d minutes.", targetUser.Username, minutes)))
-
-	default:
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Item not found."))
+	var until time.Time
+	if member.CommunicationDisabledUntil != nil && member.CommunicationDisabledUntil.After(time.Now()) {
+		// Extend existing
+		until = member.CommunicationDisabledUntil.Add(time.Duration(minutes) * time.Minute)
+	} else {
+		// Start new
+		until = time.Now().Add(time.Duration(minutes) * time.Minute)
 	}
+
+	err = ctx.Session.GuildMemberTimeout(ctx.GuildID, targetUser.ID, &until)
+	if err != nil {
+		ctx.Edit(&bot.Response{Embeds: []*discordgo.MessageEmbed{ctx.ErrorEmbed("Error applying timeout (check permissions/hierarchy).")}})
+		return
+	}
+
+	database.CollectLostBet(userID, cost)
+	audit.ShopPurchase(userID, targetUser.ID, "Timeout", fmt.Sprintf("%d minutes (until <t:%d:t>)", minutes, until.Unix()), cost)
+	ctx.Edit(&bot.Response{Embeds: []*discordgo.MessageEmbed{utils.SuccessEmbed(ctx.T("Punishment Applied!"),
+		ctx.T("%s has been timed out until %s.", targetUser.Username, until.Format("15:04:05")))}})
+}
+
+func cmdBuyMute(ctx *bot.Context) {
+	userID := ctx.Author.ID
+	targetUser := ctx.User("user")
+	minutes := ctx.Int("minutes")
+	guildID := ctx.GuildID
+
+	cost := minutes * config.Economy().CostPerMinuteMute
+	if database.GetBalance(userID) < cost {
+		ctx.Reply(ctx.ErrorEmbed("Insufficient funds. Cost: %d %s.", cost, config.Bot().CurrencySymbol))
+		return
+	}
+
+	waitForGame(ctx, targetUser, ctx.T("mute"))
+
+	// Check if target user is in a voice channel
+	voiceState, err := ctx.Session.State.VoiceState(guildID, targetUser.ID)
+	if err != nil || voiceState == nil || voiceState.ChannelID == "" {
+		ctx.Edit(&bot.Response{Embeds: []*discordgo.MessageEmbed{ctx.ErrorEmbed("%s is not in a voice channel! You can only mute users who are currently in a call.", targetUser.Username)}})
+		return
+	}
+
+	// Apply server mute (voice only, not timeout)
+	err = ctx.Session.GuildMemberMute(guildID, targetUser.ID, true)
+	if err != nil {
+		ctx.Edit(&bot.Response{Embeds: []*discordgo.MessageEmbed{ctx.ErrorEmbed("Error muting user (check permissions/hierarchy).")}})
+		return
+	}
+
+	// Remove coins
+	database.CollectLostBet(userID, cost)
+	audit.ShopPurchase(userID, targetUser.ID, "Voice Mute", fmt.Sprintf("%d minutes", minutes), cost)
+
+	// Schedule unmute after duration
+	s := ctx.Session
+	go func() {
+		time.Sleep(time.Duration(minutes) * time.Minute)
+		s.GuildMemberMute(guildID, targetUser.ID, false)
+	}()
+
+	ctx.Edit(&bot.Response{Embeds: []*discordgo.MessageEmbed{utils.SuccessEmbed(ctx.T("User Muted!"),
+		ctx.T("%s has been muted in voice for %d minutes.", targetUser.Username, minutes))}})
+}
+
+// waitForGame segura a punição até o alvo terminar o jogo atual.
+// Sem jogo ativo, só confirma o comando para que Edit tenha o que substituir.
+func waitForGame(ctx *bot.Context, target *discordgo.User, action string) {
+	if !games.IsUserInGame(target.ID) {
+		ctx.Defer(false)
+		return
+	}
+
+	ctx.Reply(utils.InfoEmbed(ctx.T("⏳ Waiting"),
+		ctx.T("%s is in an active game. Waiting for the game to end to apply the %s...", target.Username, action)))
+
+	// Esperar o jogo acabar
+	games.WaitForGameFinish(target.ID)
 }
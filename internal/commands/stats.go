@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"estudocoin/internal/bot"
+	"estudocoin/internal/database"
+	"estudocoin/internal/games"
+	"estudocoin/internal/permissions"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+var statsCommands = []*bot.Command{
+	{
+		Name:        "stats",
+		Description: "Gambling statistics: games played, wagered, profit and win rate",
+		Aliases:     []string{"estatisticas", "historico"},
+		Options: []*bot.Option{
+			{Name: "user", Description: "The user to check", Type: discordgo.ApplicationCommandOptionUser},
+			{Name: "game", Description: "Only this game", Type: discordgo.ApplicationCommandOptionString, Choices: []*discordgo.ApplicationCommandOptionChoice{
+				{Name: "Blackjack", Value: "blackjack"},
+				{Name: "Slots", Value: "slots"},
+				{Name: "Aviator", Value: "aviator"},
+				{Name: "Cups", Value: "cups"},
+				{Name: "Wheel", Value: "wheel"},
+				{Name: "Poker", Value: "poker"},
+				{Name: "Coinflip", Value: games.DuelCoinflip},
+				{Name: "Dice", Value: games.DuelDice},
+				{Name: "Russian Roulette", Value: "russianroulette"},
+				{Name: "Roulette Side Bets", Value: "russianroulette_sidebet"},
+				{Name: "Lottery", Value: "lottery"},
+				{Name: "Events", Value: "event"},
+			}},
+		},
+		Handler: cmdStats,
+	},
+}
+
+func cmdStats(ctx *bot.Context) {
+	target := ctx.Author
+	if u := ctx.User("user"); u != nil {
+		target = u
+	}
+
+	// O lucro revela quanto a pessoa ganhou, então segue a mesma
+	// privacidade do saldo (admins ainda enxergam)
+	if target.ID != ctx.Author.ID && ctx.Level() < permissions.Admin && database.IsBalanceHidden(target.ID) {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("**%s** keeps their balance private.", target.Username))
+		return
+	}
+
+	ctx.Reply(games.StatsEmbed(ctx.Locale(), target, ctx.String("game")))
+}
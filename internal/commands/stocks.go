@@ -0,0 +1,147 @@
+package commands
+
+import (
+	"context"
+	"estudocoin/internal/bot"
+	"estudocoin/internal/i18n"
+	"estudocoin/internal/service"
+	"estudocoin/pkg/config"
+	"estudocoin/pkg/utils"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+var stockCommands = []*bot.Command{
+	{
+		Name:        "stock",
+		Description: "Trade on the stock market",
+		Aliases:     []string{"mercado", "market"},
+		Subcommands: []*bot.Command{
+			{
+				Name:        "market",
+				Description: "View stocks and prices",
+				Aliases:     []string{"list"},
+				Handler:     handleStockMarket,
+			},
+			{
+				Name:        "buy",
+				Description: "Buy shares",
+				Economic:    true,
+				Options: []*bot.Option{
+					{Name: "ticker", Description: "Company ticker", Type: discordgo.ApplicationCommandOptionString, Required: true, Autocomplete: stockTickerChoices},
+					{Name: "amount", Description: "Coins to invest", Type: discordgo.ApplicationCommandOptionInteger, Required: true, MinValue: bot.Min(1)},
+				},
+				Handler: handleStockBuy,
+			},
+			{
+				Name:        "sell",
+				Description: "Sell shares",
+				Economic:    true,
+				Options: []*bot.Option{
+					{Name: "ticker", Description: "Company ticker", Type: discordgo.ApplicationCommandOptionString, Required: true, Autocomplete: stockHoldingChoices},
+					{Name: "shares", Description: "Number of shares or \"all\"", Type: discordgo.ApplicationCommandOptionString, Required: true, Autocomplete: stockSharesChoices},
+				},
+				Handler: handleStockSell,
+			},
+			{
+				Name:        "portfolio",
+				Description: "View your investments",
+				Aliases:     []string{"p"},
+				Private:     true,
+				Handler:     handleStockPortfolio,
+			},
+		},
+		Handler: func(ctx *bot.Context) {
+			if len(ctx.Args) == 0 {
+				ctx.Reply(utils.InfoEmbed(ctx.T("Stock Market"), ctx.T("Usage: `!stock <market|buy|sell|portfolio>`")))
+				return
+			}
+			ctx.Reply(ctx.ErrorEmbed("Unknown subcommand. Use `market`, `buy`, `sell`, or `portfolio`."))
+		},
+	},
+}
+
+func handleStockMarket(ctx *bot.Context) {
+	var sb strings.Builder
+	multiplier := config.Economy().StockPriceMultiplier
+	if multiplier <= 0 {
+		multiplier = 1
+	}
+	sb.WriteString(ctx.T("Current Market Prices (Updates every 10m, Earnings Multiplier: %.1fx):", multiplier) + "\n\n")
+
+	for _, quote := range service.Market.Quotes(context.Background(), false) {
+		priceStr := fmt.Sprintf("%.2f", quote.Price)
+		if quote.Price == 0 {
+			priceStr = ctx.T("Fetching...")
+		}
+		sb.WriteString(fmt.Sprintf("**%s** (%s): $%s\n", quote.Name, quote.Ticker, priceStr))
+	}
+
+	ctx.Reply(utils.GoldEmbed(ctx.T("Stock Market"), sb.String()))
+}
+
+func handleStockBuy(ctx *bot.Context) {
+	company := service.Market.Company(ctx.String("ticker"))
+	if company == nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Invalid Ticker. Check `!stock market`."))
+		return
+	}
+
+	sendBuyQuote(ctx, "stock", company.Ticker, ctx.Int("amount"))
+}
+
+func stockBoughtEmbed(loc i18n.Locale, order *service.Order) *discordgo.MessageEmbed {
+	return utils.SuccessEmbed(loc.T("Investment Successful"), loc.T("You bought **%.4f** shares of **%s** for **%d %s** (at $%.2f/share).", order.Quantity, order.Symbol, order.Amount, config.Bot().CurrencyName, order.Price))
+}
+
+func handleStockSell(ctx *bot.Context) {
+	ticker := ctx.String("ticker")
+	amountStr := ctx.String("shares")
+
+	var order *service.Order
+	var err error
+
+	if strings.ToLower(amountStr) == "all" {
+		order, err = service.Market.SellAll(context.Background(), ctx.Author.ID, ticker)
+	} else {
+		shares, perr := strconv.ParseFloat(amountStr, 64)
+		if perr != nil || shares <= 0 {
+			ctx.ReplyEphemeral(ctx.ErrorEmbed("Invalid number of shares."))
+			return
+		}
+		order, err = service.Market.Sell(context.Background(), ctx.Author.ID, ticker, shares)
+	}
+	if err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(ctx.Err(err)))
+		return
+	}
+
+	ctx.Reply(utils.SuccessEmbed(ctx.T("Sale Successful"), ctx.T("You sold **%.4f** shares of **%s** for **%d %s** (at $%.2f/share).", order.Quantity, order.Symbol, order.Amount, config.Bot().CurrencyName, order.Price)))
+}
+
+func handleStockPortfolio(ctx *bot.Context) {
+	// A carteira é mostrada só para o dono no slash
+	ctx.Defer(true)
+
+	portfolio, err := service.Market.Portfolio(context.Background(), ctx.Author.ID)
+	if err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(ctx.Err(err)))
+		return
+	}
+
+	if len(portfolio.Holdings) == 0 {
+		ctx.ReplyEphemeral(utils.InfoEmbed(ctx.T("Portfolio"), ctx.T("You have no investments.")))
+		return
+	}
+
+	var sb strings.Builder
+	for _, h := range portfolio.Holdings {
+		sb.WriteString(ctx.T("**%s**: %.4f shares (~%d %s @ $%.2f)\n", h.Symbol, h.Quantity, h.Value, config.Bot().CurrencyName, h.Price))
+	}
+
+	sb.WriteString(ctx.T("\n**Total Value**: ~%d %s", portfolio.TotalValue, config.Bot().CurrencyName))
+	ctx.ReplyEphemeral(utils.GoldEmbed(ctx.T("Your Portfolio"), sb.String()))
+}
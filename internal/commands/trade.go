@@ -0,0 +1,314 @@
+package commands
+
+import (
+	"context"
+	"estudocoin/internal/bot"
+	"estudocoin/internal/crypto"
+	"estudocoin/internal/database"
+	"estudocoin/internal/i18n"
+	"estudocoin/internal/service"
+	"estudocoin/internal/stockmarket"
+	"estudocoin/pkg/config"
+	"estudocoin/pkg/utils"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	// Tempo que uma cotação de compra fica válida
+	tradeQuoteTTL = 60 * time.Second
+	// Variação máxima de preço aceita entre a cotação e a confirmação
+	tradeMaxSlippage = 0.01
+)
+
+// sendBuyQuote mostra o preço cotado com os botões de confirmar/cancelar.
+// A compra só é executada em HandleTradeConfirm.
+func sendBuyQuote(ctx *bot.Context, market, symbol string, amount int) {
+	if database.GetBalance(ctx.Author.ID) < amount {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Insufficient funds."))
+		return
+	}
+
+	// A cotação pode depender de uma API externa
+	ctx.Defer(true)
+
+	price, err := tradeQuote(market, symbol)
+	if err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(ctx.Err(err)))
+		return
+	}
+
+	embed, components := buyQuoteMessage(ctx.Locale(), market, ctx.Author.ID, symbol, amount, price, "")
+	ctx.Respond(&bot.Response{
+		Embeds:     []*discordgo.MessageEmbed{embed},
+		Components: components,
+		Ephemeral:  true,
+	})
+}
+
+func tradeQuote(market, symbol string) (float64, error) {
+	if market == "crypto" {
+		return service.Crypto.Quote(context.Background(), symbol)
+	}
+	return service.Market.Quote(context.Background(), symbol)
+}
+
+// buyQuoteMessage monta a cotação. Os dados da ordem vão no custom ID do botão:
+// trade_buy_<market>_<userID>_<symbol>_<amount>_<price>_<expires>
+func buyQuoteMessage(loc i18n.Locale, market, userID, symbol string, amount int, price float64, note string) (*discordgo.MessageEmbed, []discordgo.MessageComponent) {
+	expires := time.Now().Add(tradeQuoteTTL)
+
+	var title, priceStr, quantity, warning string
+	if market == "crypto" {
+		coin := service.Crypto.Coin(symbol)
+		title = loc.T("Buy %s (%s)?", coin.Name, coin.Symbol)
+		priceStr = loc.T("$%s/coin", formatPrice(price))
+		quantity = fmt.Sprintf("~%s %s", service.FormatCryptoAmount(float64(amount)/price), coin.Symbol)
+		if coin.Type == "meme" {
+			warning = "\n" + loc.T("⚠️ **Meme coins are highly volatile! Invest at your own risk.**")
+		}
+	} else {
+		company := service.Market.Company(symbol)
+		title = loc.T("Buy %s (%s)?", company.Name, company.Ticker)
+		priceStr = loc.T("$%.2f/share", price)
+		quantity = loc.T("~%.4f shares", float64(amount)/price)
+	}
+
+	desc := loc.T("**Quoted price:** %s\n**You pay:** %d %s\n**You get:** %s\n\nQuote expires <t:%d:R>. The order fills at the market price when you confirm.%s",
+		priceStr, amount, config.Bot().CurrencyName, quantity, expires.Unix(), warning)
+	if note != "" {
+		desc = note + "\n\n" + desc
+	}
+
+	customID := fmt.Sprintf("trade_buy_%s_%s_%s_%d_%s_%d",
+		market, userID, symbol, amount, strconv.FormatFloat(price, 'g', -1, 64), expires.Unix())
+
+	components := []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    loc.T("✅ Confirm"),
+					Style:    discordgo.SuccessButton,
+					CustomID: customID,
+				},
+				discordgo.Button{
+					Label:    loc.T("❌ Cancel"),
+					Style:    discordgo.DangerButton,
+					CustomID: "trade_cancel_" + userID,
+				},
+			},
+		},
+	}
+
+	return utils.InfoEmbed(title, desc), components
+}
+
+// HandleTradeConfirm executa a compra cotada, se a cotação ainda valer
+func HandleTradeConfirm(s *discordgo.Session, i *discordgo.InteractionCreate, customID string) {
+	parts := strings.Split(strings.TrimPrefix(customID, "trade_buy_"), "_")
+	if len(parts) != 6 {
+		return
+	}
+	market, ownerID, symbol := parts[0], parts[1], parts[2]
+	amount, _ := strconv.Atoi(parts[3])
+	quoted, _ := strconv.ParseFloat(parts[4], 64)
+	expires, _ := strconv.ParseInt(parts[5], 10, 64)
+	loc := i18n.ForInteraction(i)
+
+	if interactionUser(i).ID != ownerID {
+		respondTradeEphemeral(s, i, "This order isn't yours.")
+		return
+	}
+
+	if time.Now().Unix() > expires {
+		updateTradeMessage(s, i, utils.InfoEmbed(loc.T("Quote Expired"), loc.T("⏰ This quote has expired. Run the command again for a fresh price.")), nil)
+		return
+	}
+
+	price, err := tradeQuote(market, symbol)
+	if err != nil {
+		updateTradeMessage(s, i, loc.ErrorEmbed(loc.Err(err)), nil)
+		return
+	}
+
+	// Preço andou demais desde a cotação: mostrar o novo preço e pedir outra confirmação
+	if quoted <= 0 || math.Abs(price-quoted)/quoted > tradeMaxSlippage {
+		embed, components := buyQuoteMessage(loc, market, ownerID, symbol, amount, price, loc.T("📉 **The price moved since your quote.** Please confirm the new price."))
+		updateTradeMessage(s, i, embed, components)
+		return
+	}
+
+	var embed *discordgo.MessageEmbed
+	if market == "crypto" {
+		order, err := service.Crypto.Buy(context.Background(), ownerID, symbol, amount)
+		if err != nil {
+			embed = loc.ErrorEmbed(loc.Err(err))
+		} else {
+			embed = cryptoBoughtEmbed(loc, order)
+		}
+	} else {
+		order, err := service.Market.Buy(context.Background(), ownerID, symbol, amount)
+		if err != nil {
+			embed = loc.ErrorEmbed(loc.Err(err))
+		} else {
+			embed = stockBoughtEmbed(loc, order)
+		}
+	}
+	updateTradeMessage(s, i, embed, nil)
+}
+
+// HandleTradeCancel descarta a cotação
+func HandleTradeCancel(s *discordgo.Session, i *discordgo.InteractionCreate, ownerID string) {
+	if interactionUser(i).ID != ownerID {
+		respondTradeEphemeral(s, i, "This order isn't yours.")
+		return
+	}
+	loc := i18n.ForInteraction(i)
+	updateTradeMessage(s, i, utils.InfoEmbed(loc.T("Order Cancelled"), loc.T("No coins were spent.")), nil)
+}
+
+func interactionUser(i *discordgo.InteractionCreate) *discordgo.User {
+	if i.Member != nil {
+		return i.Member.User
+	}
+	return i.User
+}
+
+func updateTradeMessage(s *discordgo.Session, i *discordgo.InteractionCreate, embed *discordgo.MessageEmbed, components []discordgo.MessageComponent) {
+	if components == nil {
+		components = []discordgo.MessageComponent{}
+	}
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: components,
+		},
+	})
+}
+
+// respondTradeEphemeral responde com um erro só para quem clicou; msg é traduzida
+func respondTradeEphemeral(s *discordgo.Session, i *discordgo.InteractionCreate, msg string) {
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Embeds: []*discordgo.MessageEmbed{i18n.ForInteraction(i).ErrorEmbed(msg)},
+			Flags:  discordgo.MessageFlagsEphemeral,
+		},
+	})
+}
+
+// matchesSymbol compara o texto digitado com o símbolo ou o nome do ativo
+func matchesSymbol(value, symbol, name string) bool {
+	value = strings.ToLower(strings.TrimSpace(value))
+	return value == "" ||
+		strings.HasPrefix(strings.ToLower(symbol), value) ||
+		strings.Contains(strings.ToLower(name), value)
+}
+
+// stockTickerChoices sugere as empresas negociáveis
+func stockTickerChoices(ctx *bot.Context, value string) []*discordgo.ApplicationCommandOptionChoice {
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	for _, c := range stockmarket.Companies {
+		if matchesSymbol(value, c.Ticker, c.Name) {
+			choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+				Name:  fmt.Sprintf("%s - %s", c.Ticker, c.Name),
+				Value: c.Ticker,
+			})
+		}
+	}
+	return choices
+}
+
+// stockHoldingChoices sugere só as ações que o usuário possui
+func stockHoldingChoices(ctx *bot.Context, value string) []*discordgo.ApplicationCommandOptionChoice {
+	investments, err := database.GetAllInvestmentsByUser(ctx.Author.ID)
+	if err != nil {
+		return nil
+	}
+
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	for _, inv := range investments {
+		name := ""
+		if c := service.Market.Company(inv.Ticker); c != nil {
+			name = c.Name
+		}
+		if inv.Shares > 0 && matchesSymbol(value, inv.Ticker, name) {
+			choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+				Name:  ctx.T("%s - %.4f shares", inv.Ticker, inv.Shares),
+				Value: inv.Ticker,
+			})
+		}
+	}
+	return choices
+}
+
+// stockSharesChoices sugere "all" e a quantidade exata da ação escolhida
+func stockSharesChoices(ctx *bot.Context, value string) []*discordgo.ApplicationCommandOptionChoice {
+	choices := []*discordgo.ApplicationCommandOptionChoice{{Name: "all", Value: "all"}}
+	shares, _ := database.GetInvestment(ctx.Author.ID, strings.ToUpper(ctx.String("ticker")))
+	if shares > 0 {
+		exact := strconv.FormatFloat(shares, 'f', -1, 64)
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: exact, Value: exact})
+	}
+	if value != "" && !strings.EqualFold(value, "all") {
+		choices = append([]*discordgo.ApplicationCommandOptionChoice{{Name: value, Value: value}}, choices...)
+	}
+	return choices
+}
+
+// cryptoSymbolChoices sugere as criptomoedas negociáveis
+func cryptoSymbolChoices(ctx *bot.Context, value string) []*discordgo.ApplicationCommandOptionChoice {
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	for _, c := range crypto.AvailableCryptos {
+		if matchesSymbol(value, c.Symbol, c.Name) {
+			choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+				Name:  fmt.Sprintf("%s - %s", c.Symbol, c.Name),
+				Value: c.Symbol,
+			})
+		}
+	}
+	return choices
+}
+
+// cryptoHoldingChoices sugere só as criptomoedas que o usuário possui
+func cryptoHoldingChoices(ctx *bot.Context, value string) []*discordgo.ApplicationCommandOptionChoice {
+	investments, err := database.GetAllCryptoInvestmentsByUser(ctx.Author.ID)
+	if err != nil {
+		return nil
+	}
+
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	for _, inv := range investments {
+		name := ""
+		if c := service.Crypto.Coin(inv.Symbol); c != nil {
+			name = c.Name
+		}
+		if inv.Coins > 0 && matchesSymbol(value, inv.Symbol, name) {
+			choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+				Name:  ctx.T("%s - %s coins", inv.Symbol, service.FormatCryptoAmount(inv.Coins)),
+				Value: inv.Symbol,
+			})
+		}
+	}
+	return choices
+}
+
+// cryptoAmountChoices sugere "all" e a quantidade exata da crypto escolhida
+func cryptoAmountChoices(ctx *bot.Context, value string) []*discordgo.ApplicationCommandOptionChoice {
+	choices := []*discordgo.ApplicationCommandOptionChoice{{Name: "all", Value: "all"}}
+	coins, _ := database.GetCryptoInvestment(ctx.Author.ID, strings.ToUpper(ctx.String("symbol")))
+	if coins > 0 {
+		exact := strconv.FormatFloat(coins, 'f', -1, 64)
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: exact, Value: exact})
+	}
+	if value != "" && !strings.EqualFold(value, "all") {
+		choices = append([]*discordgo.ApplicationCommandOptionChoice{{Name: value, Value: value}}, choices...)
+	}
+	return choices
+}
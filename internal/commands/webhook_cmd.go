@@ -1,59 +1,279 @@
 package commands
 
 import (
+	"estudocoin/internal/bot"
 	"estudocoin/internal/database"
 	"estudocoin/internal/webhook"
+	"estudocoin/pkg/config"
 	"estudocoin/pkg/utils"
-	"net/url"
+	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/bwmarrin/discordgo"
 )
 
-func HandleSlashWebhook(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	options := i.ApplicationCommandData().Options
-	subCommand := options[0].Name
-	userID := i.Member.User.ID
-
-	switch subCommand {
-	case "set":
-		rawURL := options[0].Options[0].StringValue()
-		
-		// Validate URL
-		parsed, err := url.ParseRequestURI(rawURL)
-		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
-			respondEmbed(s, i, utils.ErrorEmbed("Invalid URL. Must start with http:// or https://"))
-			return
+// webhookEventChoices monta as opções de evento para /webhook subscribe
+func webhookEventChoices() []*discordgo.ApplicationCommandOptionChoice {
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(webhook.Events))
+	for _, e := range webhook.Events {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  e.Name,
+			Value: e.Name,
+		})
+	}
+	return choices
+}
+
+// Webhooks are private and slash-only so the URL and the signing secret are
+// never shown in a public channel
+var webhookCommands = []*bot.Command{
+	{
+		Name:        "webhook",
+		Description: "Manage your Webhook for API notifications",
+		SlashOnly:   true,
+		Private:     true,
+		Subcommands: []*bot.Command{
+			{
+				Name:        "set",
+				Description: "Set your webhook URL",
+				Options: []*bot.Option{
+					{Name: "url", Description: "The URL to receive POST requests", Type: discordgo.ApplicationCommandOptionString, Required: true},
+				},
+				Handler: cmdWebhookSet,
+			},
+			{
+				Name:        "test",
+				Description: "Send a test payload to your configured webhook",
+				Handler:     cmdWebhookTest,
+			},
+			{
+				Name:        "delete",
+				Description: "Remove your webhook configuration",
+				Handler:     cmdWebhookDelete,
+			},
+			{
+				Name:        "deliveries",
+				Description: "Show your most recent webhook deliveries",
+				Handler:     cmdWebhookDeliveries,
+			},
+			{
+				Name:        "rotate",
+				Description: "Generate a new signing secret for your webhook",
+				Handler:     cmdWebhookRotate,
+			},
+			{
+				Name:        "subscribe",
+				Description: "Receive an event on your webhook",
+				Options: []*bot.Option{
+					{Name: "event", Description: "Event to receive", Type: discordgo.ApplicationCommandOptionString, Required: true, Choices: webhookEventChoices()},
+					{Name: "threshold", Description: "Game events only: minimum win/loss to notify", Type: discordgo.ApplicationCommandOptionInteger, MinValue: bot.Min(0)},
+				},
+				Handler: cmdWebhookSubscribe,
+			},
+			{
+				Name:        "unsubscribe",
+				Description: "Stop receiving an event on your webhook",
+				Options: []*bot.Option{
+					{Name: "event", Description: "Event to stop receiving", Type: discordgo.ApplicationCommandOptionString, Required: true, Choices: webhookEventChoices()},
+				},
+				Handler: cmdWebhookUnsubscribe,
+			},
+			{
+				Name:        "events",
+				Description: "List webhook events and your subscriptions",
+				Handler:     cmdWebhookEvents,
+			},
+		},
+	},
+}
+
+func cmdWebhookSet(ctx *bot.Context) {
+	userID := ctx.Author.ID
+
+	rawURL := ctx.String("url")
+
+	// Validate URL and destination (blocks internal/private addresses)
+	if err := webhook.ValidateURL(rawURL); err != nil {
+		msg := ctx.T("Invalid URL: %s", err.Error())
+		if errors.Is(err, webhook.ErrBlockedDestination) {
+			msg = ctx.T("This URL points to a private or reserved address and can't be used.")
 		}
+		ctx.ReplyEphemeral(ctx.ErrorEmbed(msg))
+		return
+	}
 
-		err = database.SetWebhook(userID, rawURL)
-		if err != nil {
-			respondEmbed(s, i, utils.ErrorEmbed("Database error saving webhook."))
-			return
+	err := database.SetWebhook(userID, rawURL)
+	if err != nil {
+		ctx.Reply(ctx.ErrorEmbed("Database error saving webhook."))
+		return
+	}
+
+	secret, err := webhook.EnsureSecret(userID)
+	if err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Webhook saved, but the signing secret could not be created."))
+		return
+	}
+
+	ctx.ReplyEphemeral(utils.SuccessEmbed(ctx.T("Webhook Configured"),
+		ctx.T("Your webhook URL has been saved.\n\n**Signing secret:** `%s`\n"+
+			"Every request carries an `%s` header with `sha256=HMAC(secret, timestamp + \".\" + body)`.",
+			secret, webhook.SignatureHeader)))
+}
+
+func cmdWebhookTest(ctx *bot.Context) {
+	userID := ctx.Author.ID
+
+	targetURL, err := database.GetWebhook(userID)
+	if err != nil || targetURL == "" {
+		ctx.Reply(ctx.ErrorEmbed("You don't have a webhook configured."))
+		return
+	}
+
+	statusCode, err := webhook.SendTestWebhook(userID)
+	if err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Test Failed: %s", err.Error()))
+		return
+	}
+
+	ctx.ReplyEphemeral(utils.SuccessEmbed(ctx.T("Test Sent"), ctx.T("We sent a signed test payload to your URL (HTTP %d).", statusCode)))
+}
+
+func cmdWebhookDelete(ctx *bot.Context) {
+	userID := ctx.Author.ID
+
+	err := database.SetWebhook(userID, "") // Setting empty removes it effectively
+	if err != nil {
+		ctx.Reply(ctx.ErrorEmbed("Error removing webhook."))
+		return
+	}
+	_ = database.CancelPendingWebhookDeliveries(userID, "webhook removed")
+	ctx.Reply(utils.SuccessEmbed(ctx.T("Webhook Removed"), ctx.T("You will no longer receive notifications.")))
+}
+
+func cmdWebhookDeliveries(ctx *bot.Context) {
+	userID := ctx.Author.ID
+
+	deliveries, err := database.GetRecentWebhookDeliveries(userID, 10)
+	if err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Database error loading deliveries."))
+		return
+	}
+	if len(deliveries) == 0 {
+		ctx.ReplyEphemeral(utils.InfoEmbed(ctx.T("Webhook Deliveries"), ctx.T("No deliveries yet.")))
+		return
+	}
+
+	var sb strings.Builder
+	for _, d := range deliveries {
+		status := "⏳"
+		switch d.Status {
+		case database.WebhookStatusDelivered:
+			status = "✅"
+		case database.WebhookStatusFailed:
+			status = "❌"
 		}
 
-		respondEmbed(s, i, utils.SuccessEmbed("Webhook Configured", "Your webhook URL has been saved."))
+		code := "—"
+		if d.LastStatusCode > 0 {
+			code = fmt.Sprintf("%d", d.LastStatusCode)
+		}
 
-	case "test":
-		targetURL, err := database.GetWebhook(userID)
-		if err != nil || targetURL == "" {
-			respondEmbed(s, i, utils.ErrorEmbed("You don't have a webhook configured."))
-			return
+		sb.WriteString(ctx.T("%s `%s` • HTTP %s • %d attempt(s) • <t:%d:R>\n",
+			status, d.Event, code, d.Attempts, d.CreatedAt.Unix()))
+		if d.Status == database.WebhookStatusPending && d.Attempts > 0 {
+			sb.WriteString(ctx.T("  ↳ next retry <t:%d:R>\n", d.NextAttemptAt.Unix()))
 		}
+		if d.LastError != "" && d.Status != database.WebhookStatusDelivered {
+			sb.WriteString(fmt.Sprintf("  ↳ %s\n", d.LastError))
+		}
+	}
+
+	ctx.ReplyEphemeral(utils.InfoEmbed(ctx.T("Webhook Deliveries"), sb.String()))
+}
+
+func cmdWebhookRotate(ctx *bot.Context) {
+	userID := ctx.Author.ID
+
+	targetURL, err := database.GetWebhook(userID)
+	if err != nil || targetURL == "" {
+		ctx.Reply(ctx.ErrorEmbed("You don't have a webhook configured."))
+		return
+	}
+
+	secret, err := webhook.RotateSecret(userID)
+	if err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Error rotating secret."))
+		return
+	}
+	ctx.ReplyEphemeral(utils.SuccessEmbed(ctx.T("Secret Rotated"), ctx.T("**New signing secret:** `%s`", secret)))
+}
+
+func cmdWebhookSubscribe(ctx *bot.Context) {
+	userID := ctx.Author.ID
+
+	event := ctx.String("event")
+	if !webhook.IsValidEvent(event) {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Unknown event."))
+		return
+	}
+	targetURL, err := database.GetWebhook(userID)
+	if err != nil || targetURL == "" {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Set a webhook first with `/webhook set <url>`."))
+		return
+	}
+
+	if err := webhook.Subscribe(userID, event); err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Database error saving subscription."))
+		return
+	}
 
-		err = webhook.TestWebhook(targetURL)
-		if err != nil {
-			respondEmbed(s, i, utils.ErrorEmbed("Test Failed: "+err.Error()))
+	extra := ""
+	if ctx.Has("threshold") {
+		threshold := ctx.Int("threshold")
+		if err := database.SetWebhookGameThreshold(userID, threshold); err != nil {
+			ctx.ReplyEphemeral(ctx.ErrorEmbed("Database error saving threshold."))
 			return
 		}
+		extra = ctx.T("\nGame events are sent for wins/losses of at least **%d %s**.", threshold, config.Bot().CurrencySymbol)
+	}
 
-		respondEmbed(s, i, utils.SuccessEmbed("Test Sent", "We sent a test payload to your URL."))
+	ctx.ReplyEphemeral(utils.SuccessEmbed(ctx.T("Subscribed"), ctx.T("You will receive `%s` events.%s", event, extra)))
+}
 
-	case "delete":
-		err := database.SetWebhook(userID, "") // Setting empty removes it effectively
-		if err != nil {
-			respondEmbed(s, i, utils.ErrorEmbed("Error removing webhook."))
-			return
+func cmdWebhookUnsubscribe(ctx *bot.Context) {
+	userID := ctx.Author.ID
+
+	event := ctx.String("event")
+	if err := webhook.Unsubscribe(userID, event); err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Database error saving subscription."))
+		return
+	}
+	ctx.ReplyEphemeral(utils.SuccessEmbed(ctx.T("Unsubscribed"), ctx.T("You will no longer receive `%s` events.", event)))
+}
+
+func cmdWebhookEvents(ctx *bot.Context) {
+	userID := ctx.Author.ID
+
+	subscribed, threshold, err := webhook.Subscriptions(userID)
+	if err != nil {
+		ctx.ReplyEphemeral(ctx.ErrorEmbed("Database error loading subscriptions."))
+		return
+	}
+	active := make(map[string]bool, len(subscribed))
+	for _, e := range subscribed {
+		active[e] = true
+	}
+
+	var sb strings.Builder
+	for _, e := range webhook.Events {
+		mark := "⬜"
+		if active[e.Name] {
+			mark = "✅"
 		}
-		respondEmbed(s, i, utils.SuccessEmbed("Webhook Removed", "You will no longer receive notifications."))
+		sb.WriteString(fmt.Sprintf("%s `%s` - %s\n", mark, e.Name, ctx.T(e.Description)))
 	}
+	sb.WriteString(ctx.T("\nGame threshold: **%d %s**", threshold, config.Bot().CurrencySymbol))
+
+	ctx.ReplyEphemeral(utils.InfoEmbed(ctx.T("Webhook Events"), sb.String()))
 }
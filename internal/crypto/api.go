@@ -1,6 +1,7 @@
 package crypto
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -12,6 +13,11 @@ const CoinGeckoBaseURL = "https://api.coingecko.com/api/v3"
 
 // GetCryptoPrices busca os preços atuais de todas as criptomoedas
 func GetCryptoPrices() (map[string]float64, error) {
+	return GetCryptoPricesContext(context.Background())
+}
+
+// GetCryptoPricesContext busca os preços atuais, abortando se o contexto for cancelado
+func GetCryptoPricesContext(ctx context.Context) (map[string]float64, error) {
 	// Construir lista de IDs
 	var ids []string
 	for _, c := range AvailableCryptos {
@@ -25,7 +31,12 @@ func GetCryptoPrices() (map[string]float64, error) {
 		Timeout: 10 * time.Second,
 	}
 
-	resp, err := client.Get(url)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -53,13 +64,23 @@ func GetCryptoPrices() (map[string]float64, error) {
 
 // GetSingleCryptoPrice busca o preço de uma única criptomoeda
 func GetSingleCryptoPrice(cryptoID string) (float64, error) {
+	return GetSingleCryptoPriceContext(context.Background(), cryptoID)
+}
+
+// GetSingleCryptoPriceContext busca o preço de uma criptomoeda respeitando o contexto
+func GetSingleCryptoPriceContext(ctx context.Context, cryptoID string) (float64, error) {
 	url := fmt.Sprintf("%s/simple/price?ids=%s&vs_currencies=usd", CoinGeckoBaseURL, cryptoID)
 	
 	client := http.Client{
 		Timeout: 5 * time.Second,
 	}
 
-	resp, err := client.Get(url)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := client.Do(req)
 	if err != nil {
 		return 0, err
 	}
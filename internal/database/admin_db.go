@@ -0,0 +1,200 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Ações administrativas registradas na auditoria
+const (
+	AdminActionGive     = "give"
+	AdminActionTake     = "take"
+	AdminActionSet      = "set"
+	AdminActionFreeze   = "freeze"
+	AdminActionUnfreeze = "unfreeze"
+	AdminActionReset    = "reset"
+)
+
+// AdminAction é uma entrada da auditoria de ações administrativas
+type AdminAction struct {
+	ID            string
+	AdminID       string
+	TargetID      string
+	Action        string
+	Amount        int
+	BalanceBefore int
+	BalanceAfter  int
+	Reason        string
+	CreatedAt     time.Time
+}
+
+// CreateAdminTables cria a tabela de auditoria das ações administrativas
+func (p *PostgresDatabase) CreateAdminTables() error {
+	createAuditSQL := `CREATE TABLE IF NOT EXISTS admin_audit (
+		id TEXT PRIMARY KEY,
+		admin_id TEXT NOT NULL,
+		target_id TEXT NOT NULL,
+		action TEXT NOT NULL,
+		amount INTEGER DEFAULT 0,
+		balance_before INTEGER DEFAULT 0,
+		balance_after INTEGER DEFAULT 0,
+		reason TEXT,
+		created_at TIMESTAMP
+	);`
+	if _, err := p.db.Exec(createAuditSQL); err != nil {
+		return err
+	}
+	_, err := p.db.Exec(`CREATE INDEX IF NOT EXISTS idx_admin_audit_target ON admin_audit (target_id, created_at);`)
+	return err
+}
+
+// CreateAdminTables cria a tabela de auditoria das ações administrativas para SQLite
+func (s *SQLiteDatabase) CreateAdminTables() error {
+	createAuditSQL := `CREATE TABLE IF NOT EXISTS admin_audit (
+		"id" TEXT NOT NULL PRIMARY KEY,
+		"admin_id" TEXT NOT NULL,
+		"target_id" TEXT NOT NULL,
+		"action" TEXT NOT NULL,
+		"amount" INTEGER DEFAULT 0,
+		"balance_before" INTEGER DEFAULT 0,
+		"balance_after" INTEGER DEFAULT 0,
+		"reason" TEXT,
+		"created_at" DATETIME
+	);`
+	if _, err := s.db.Exec(createAuditSQL); err != nil {
+		return err
+	}
+	_, err := s.db.Exec(`CREATE INDEX IF NOT EXISTS idx_admin_audit_target ON admin_audit (target_id, created_at);`)
+	return err
+}
+
+// LogAdminAction grava uma ação na auditoria
+func LogAdminAction(a *AdminAction) error {
+	query := prepareQuery(`INSERT INTO admin_audit
+		(id, admin_id, target_id, action, amount, balance_before, balance_after, reason, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	_, err := DB.Exec(query, a.ID, a.AdminID, a.TargetID, a.Action, a.Amount,
+		a.BalanceBefore, a.BalanceAfter, a.Reason, a.CreatedAt)
+	return err
+}
+
+// GetAdminActions retorna as ações mais recentes sobre um usuário
+func GetAdminActions(targetID string, limit int) ([]*AdminAction, error) {
+	query := prepareQuery(`SELECT id, admin_id, target_id, action, amount, balance_before, balance_after, reason, created_at
+		FROM admin_audit WHERE target_id = ? ORDER BY created_at DESC LIMIT ?`)
+	rows, err := DB.Query(query, targetID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var actions []*AdminAction
+	for rows.Next() {
+		a := &AdminAction{}
+		var reason sql.NullString
+		if err := rows.Scan(&a.ID, &a.AdminID, &a.TargetID, &a.Action, &a.Amount,
+			&a.BalanceBefore, &a.BalanceAfter, &reason, &a.CreatedAt); err != nil {
+			return nil, err
+		}
+		a.Reason = reason.String
+		actions = append(actions, a)
+	}
+	return actions, rows.Err()
+}
+
+// IsFrozen informa se a conta do usuário está congelada por um admin
+func IsFrozen(userID string) bool {
+	var frozen sql.NullBool
+	query := prepareQuery("SELECT frozen FROM users WHERE id = ?")
+	if err := DB.QueryRow(query, userID).Scan(&frozen); err != nil {
+		return false
+	}
+	return frozen.Bool
+}
+
+// SetFrozen congela ou descongela a conta de um usuário
+func SetFrozen(userID string, frozen bool) error {
+	GetBalance(userID) // garante que o usuário existe
+	query := prepareQuery("UPDATE users SET frozen = ? WHERE id = ?")
+	_, err := DB.Exec(query, frozen, userID)
+	return err
+}
+
+// ChangeBalance soma delta ao saldo numa transação e retorna o saldo antes e depois.
+// Retorna ErrInsufficientBalance se o saldo ficaria negativo.
+func ChangeBalance(ctx context.Context, userID string, delta int) (int, int, error) {
+	return updateBalance(ctx, userID, func(before int) (int, error) {
+		if before+delta < 0 {
+			return 0, ErrInsufficientBalance
+		}
+		return before + delta, nil
+	})
+}
+
+// SetBalance define o saldo do usuário e retorna o saldo anterior
+func SetBalance(ctx context.Context, userID string, amount int) (int, error) {
+	before, _, err := updateBalance(ctx, userID, func(int) (int, error) {
+		return amount, nil
+	})
+	return before, err
+}
+
+func updateBalance(ctx context.Context, userID string, next func(before int) (int, error)) (int, int, error) {
+	GetBalance(userID) // garante que o usuário existe
+
+	tx, err := DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer tx.Rollback()
+
+	var before int
+	if err := tx.QueryRowContext(ctx, prepareQuery("SELECT balance FROM users WHERE id = ?"), userID).Scan(&before); err != nil {
+		return 0, 0, err
+	}
+
+	after, err := next(before)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if _, err := tx.ExecContext(ctx, prepareQuery("UPDATE users SET balance = ? WHERE id = ?"), after, userID); err != nil {
+		return 0, 0, err
+	}
+	return before, after, tx.Commit()
+}
+
+// ResetUser zera o saldo, apaga as ações e criptos e encerra os empréstimos
+// em aberto do usuário (como credor ou devedor). Retorna o saldo anterior.
+func ResetUser(ctx context.Context, userID string) (int, error) {
+	tx, err := DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var before int
+	err = tx.QueryRowContext(ctx, prepareQuery("SELECT balance FROM users WHERE id = ?"), userID).Scan(&before)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, err
+	}
+
+	queries := []string{
+		"UPDATE users SET balance = 0 WHERE id = ?",
+		"DELETE FROM stock_investments WHERE user_id = ?",
+		"DELETE FROM crypto_investments WHERE user_id = ?",
+	}
+	for _, q := range queries {
+		if _, err := tx.ExecContext(ctx, prepareQuery(q), userID); err != nil {
+			return 0, err
+		}
+	}
+
+	query := prepareQuery("UPDATE loans SET paid = ? WHERE (lender_id = ? OR borrower_id = ?) AND paid = ?")
+	if _, err := tx.ExecContext(ctx, query, true, userID, userID, false); err != nil {
+		return 0, err
+	}
+
+	return before, tx.Commit()
+}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -127,17 +128,17 @@ func GetBalance(userID string) int {
 	return 0
 }
 
-// GetLeaderboard retorna o ranking de saldos (excluindo o bot e incluindo investimentos)
+// GetLeaderboard retorna o ranking de saldos (excluindo o bot e quem escondeu o saldo, incluindo investimentos)
 func GetLeaderboard(limit int) ([]UserBalance, error) {
 	// Buscar todos os usuários (exceto o bot) com seus saldos
 	var rows *sql.Rows
 	var err error
 	
 	if BotUserID != "" {
-		query := prepareQuery("SELECT id, balance FROM users WHERE id != ? ORDER BY balance DESC")
+		query := prepareQuery("SELECT id, balance, hide_balance FROM users WHERE id != ? ORDER BY balance DESC")
 		rows, err = DB.Query(query, BotUserID)
 	} else {
-		query := prepareQuery("SELECT id, balance FROM users ORDER BY balance DESC")
+		query := prepareQuery("SELECT id, balance, hide_balance FROM users ORDER BY balance DESC")
 		rows, err = DB.Query(query)
 	}
 	
@@ -150,7 +151,12 @@ func GetLeaderboard(limit int) ([]UserBalance, error) {
 	var users []UserBalance
 	for rows.Next() {
 		var u UserBalance
-		if err := rows.Scan(&u.ID, &u.Balance); err != nil {
+		var hidden sql.NullBool
+		if err := rows.Scan(&u.ID, &u.Balance, &hidden); err != nil {
+			continue
+		}
+		// Quem escondeu o saldo não aparece no ranking
+		if hidden.Bool {
 			continue
 		}
 		// Pular o bot se ainda estiver na lista
@@ -240,16 +246,39 @@ func CollectLostBet(userID string, amount int) error {
 	return TransferCoins(userID, BotUserID, amount)
 }
 
+// PayBot credita no perfil do bot moedas que já saíram dos saldos dos
+// jogadores, como o rake das mesas de pôquer
+func PayBot(amount int) error {
+	if BotUserID == "" || amount <= 0 {
+		return nil
+	}
+	return AddCoins(BotUserID, amount)
+}
+
 // TransferCoins transfere moedas entre usuários
 func TransferCoins(fromID, toID string, amount int) error {
-	tx, err := DB.Begin()
+	return TransferCoinsContext(context.Background(), fromID, toID, amount)
+}
+
+// TransferCoinsContext transfere moedas entre usuários respeitando o contexto
+func TransferCoinsContext(ctx context.Context, fromID, toID string, amount int) error {
+	tx, err := DB.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
+	if err := transferTx(ctx, tx, fromID, toID, amount); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// transferTx transfere moedas dentro de uma transação já aberta. Retorna
+// sql.ErrNoRows se o remetente não tiver saldo.
+func transferTx(ctx context.Context, tx *sql.Tx, fromID, toID string, amount int) error {
 	var fromBalance int
-	err = tx.QueryRow(prepareQuery("SELECT balance FROM users WHERE id = ?"), fromID).Scan(&fromBalance)
+	err := tx.QueryRowContext(ctx, prepareQuery("SELECT balance FROM users WHERE id = ?"), fromID).Scan(&fromBalance)
 	if err != nil {
 		return err
 	}
@@ -258,24 +287,23 @@ func TransferCoins(fromID, toID string, amount int) error {
 		return sql.ErrNoRows
 	}
 
-	_, err = tx.Exec(prepareQuery("UPDATE users SET balance = balance - ? WHERE id = ?"), amount, fromID)
+	_, err = tx.ExecContext(ctx, prepareQuery("UPDATE users SET balance = balance - ? WHERE id = ?"), amount, fromID)
 	if err != nil {
 		return err
 	}
+	return addCoinsTx(ctx, tx, toID, amount)
+}
 
+// addCoinsTx é o AddCoins dentro de uma transação
+func addCoinsTx(ctx context.Context, tx *sql.Tx, userID string, amount int) error {
 	if config.DBType == "postgres" {
-		_, err = tx.Exec(`INSERT INTO users (id, balance) VALUES ($1, $2) 
-						  ON CONFLICT(id) DO UPDATE SET balance = users.balance + $2`,
-			toID, amount)
-	} else {
-		_, err = tx.Exec("INSERT INTO users (id, balance) VALUES (?, ?) ON CONFLICT(id) DO UPDATE SET balance = balance + ?",
-			toID, amount, amount)
-	}
-	if err != nil {
+		_, err := tx.ExecContext(ctx, `INSERT INTO users (id, balance) VALUES ($1, $2)
+						  ON CONFLICT(id) DO UPDATE SET balance = users.balance + $2`, userID, amount)
 		return err
 	}
-
-	return tx.Commit()
+	_, err := tx.ExecContext(ctx, "INSERT INTO users (id, balance) VALUES (?, ?) ON CONFLICT(id) DO UPDATE SET balance = balance + ?",
+		userID, amount, amount)
+	return err
 }
 
 // DailyStreakInfo contém informações sobre a streak de daily do usuário
@@ -400,6 +428,9 @@ func ClaimDaily(userID string) (*DailyStreakInfo, error) {
 		}
 	}
 
+	// Reflete o que acabou de ser salvo
+	info.CanClaim = false
+	info.NextDaily = now.Add(24 * time.Hour)
 	return info, nil
 }
 
@@ -508,6 +539,48 @@ func SaveLoan(loan *Loan) error {
 	return err
 }
 
+// FundLoan transfere o valor do credor para o devedor e grava o empréstimo
+// numa única transação, para que nenhum dos dois passos fique pela metade.
+// Retorna sql.ErrNoRows se o credor não tiver saldo.
+func FundLoan(ctx context.Context, loan *Loan) error {
+	tx, err := DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := transferTx(ctx, tx, loan.LenderID, loan.BorrowerID, loan.Amount); err != nil {
+		return err
+	}
+	query := prepareQuery(`INSERT INTO loans (id, lender_id, borrower_id, amount, interest_rate, due_date, total_owed, paid, created_at, channel_id, guild_id)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	_, err = tx.ExecContext(ctx, query, loan.ID, loan.LenderID, loan.BorrowerID, loan.Amount,
+		loan.InterestRate, loan.DueDate, loan.TotalOwed, loan.Paid, loan.CreatedAt, loan.ChannelID, loan.GuildID)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// PayLoan quita um empréstimo numa única transação: transfere owed do
+// devedor para o credor e marca o empréstimo como pago. Retorna
+// sql.ErrNoRows se o devedor não tiver saldo.
+func PayLoan(ctx context.Context, loanID, borrowerID, lenderID string, owed int) error {
+	tx, err := DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := transferTx(ctx, tx, borrowerID, lenderID, owed); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, prepareQuery("UPDATE loans SET paid = ? WHERE id = ?"), true, loanID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
 // MarkLoanAsPaid marca um empréstimo como pago
 func MarkLoanAsPaid(loanID string) error {
 	query := prepareQuery("UPDATE loans SET paid = ? WHERE id = ?")
@@ -515,6 +588,46 @@ func MarkLoanAsPaid(loanID string) error {
 	return err
 }
 
+// CollectLoan cobra um empréstimo vencido numa única transação: o credor
+// recebe o que o devedor tiver (até owed), o devedor é debitado de owed
+// inteiro (o saldo pode ficar negativo) e o empréstimo é marcado como pago.
+// Retorna quanto foi de fato repassado ao credor.
+func CollectLoan(loanID, borrowerID, lenderID string, owed int) (int, error) {
+	ctx := context.Background()
+	tx, err := DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	query := "SELECT balance FROM users WHERE id = ?"
+	if config.DBType == "postgres" {
+		query += " FOR UPDATE"
+	}
+	var balance int
+	err = tx.QueryRowContext(ctx, prepareQuery(query), borrowerID).Scan(&balance)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, err
+	}
+
+	collected := owed
+	if balance < owed {
+		collected = max(balance, 0)
+	}
+	if err := addCoinsTx(ctx, tx, borrowerID, -owed); err != nil {
+		return 0, err
+	}
+	if collected > 0 {
+		if err := addCoinsTx(ctx, tx, lenderID, collected); err != nil {
+			return 0, err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, prepareQuery("UPDATE loans SET paid = ? WHERE id = ?"), true, loanID); err != nil {
+		return 0, err
+	}
+	return collected, tx.Commit()
+}
+
 // GetActiveLoans retorna todos os empréstimos ativos (não pagos)
 func GetActiveLoans() ([]*Loan, error) {
 	query := prepareQuery("SELECT id, lender_id, borrower_id, amount, interest_rate, due_date, total_owed, paid, created_at, channel_id, guild_id FROM loans WHERE paid = ?")
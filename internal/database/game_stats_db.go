@@ -0,0 +1,85 @@
+package database
+
+import "time"
+
+// GameResult é uma partida encerrada do histórico
+type GameResult struct {
+	UserID   string
+	Game     string
+	Stake    int
+	Payout   int
+	Detail   string
+	PlayedAt time.Time
+}
+
+// GameStats resume as partidas de um usuário em um jogo
+type GameStats struct {
+	Game       string
+	Played     int
+	Wagered    int
+	Net        int // lucro líquido (negativo se perdeu)
+	BiggestWin int // maior lucro em uma partida, 0 se nunca ganhou
+	Wins       int // partidas que pagaram mais do que a aposta
+}
+
+// GetGameStats retorna as estatísticas do usuário por jogo, do mais jogado
+// para o menos jogado. Com game vazio traz todos os jogos.
+func GetGameStats(userID, game string) ([]GameStats, error) {
+	sqlText := `SELECT game, COUNT(*), COALESCE(SUM(stake), 0), COALESCE(SUM(payout - stake), 0),
+		COALESCE(MAX(payout - stake), 0), COALESCE(SUM(CASE WHEN payout > stake THEN 1 ELSE 0 END), 0)
+		FROM game_results WHERE user_id = ?`
+	args := []interface{}{userID}
+	if game != "" {
+		sqlText += " AND game = ?"
+		args = append(args, game)
+	}
+	sqlText += " GROUP BY game ORDER BY COUNT(*) DESC, game"
+
+	rows, err := DB.Query(prepareQuery(sqlText), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []GameStats
+	for rows.Next() {
+		var st GameStats
+		if err := rows.Scan(&st.Game, &st.Played, &st.Wagered, &st.Net, &st.BiggestWin, &st.Wins); err != nil {
+			return nil, err
+		}
+		if st.BiggestWin < 0 {
+			st.BiggestWin = 0
+		}
+		stats = append(stats, st)
+	}
+	return stats, rows.Err()
+}
+
+// GetGameHistory retorna as partidas do usuário, das mais recentes para as
+// mais antigas. Com game vazio traz todos os jogos.
+func GetGameHistory(userID, game string, limit, offset int) ([]GameResult, error) {
+	sqlText := "SELECT game, stake, payout, COALESCE(detail, ''), played_at FROM game_results WHERE user_id = ?"
+	args := []interface{}{userID}
+	if game != "" {
+		sqlText += " AND game = ?"
+		args = append(args, game)
+	}
+	sqlText += " ORDER BY played_at DESC LIMIT ? OFFSET ?"
+	args = append(args, limit, offset)
+
+	rows, err := DB.Query(prepareQuery(sqlText), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []GameResult
+	for rows.Next() {
+		r := GameResult{UserID: userID}
+		if err := rows.Scan(&r.Game, &r.Stake, &r.Payout, &r.Detail, &r.PlayedAt); err != nil {
+			return nil, err
+		}
+		results = append(results, r)
+	}
+	return results, rows.Err()
+}
@@ -0,0 +1,150 @@
+package database
+
+import (
+	"database/sql"
+	"time"
+)
+
+// GamblingLimits são os limites de jogo responsável que o próprio usuário
+// escolheu. Zero significa sem limite. Afrouxar um limite não vale na hora:
+// cada valor Pending entra em vigor no seu próprio horário, então afrouxar um
+// limite não reinicia a espera dos outros.
+type GamblingLimits struct {
+	UserID        string
+	DailyLoss     int
+	WeeklyLoss    int
+	MaxBet        int
+	ExcludedUntil time.Time // zero = sem autoexclusão
+
+	PendingDailyLoss    int
+	PendingDailyLossAt  time.Time // zero = nenhuma mudança pendente
+	PendingWeeklyLoss   int
+	PendingWeeklyLossAt time.Time
+	PendingMaxBet       int
+	PendingMaxBetAt     time.Time
+}
+
+// CreateLimitsTables cria as tabelas de limites e do histórico de resultados
+func (p *PostgresDatabase) CreateLimitsTables() error {
+	createLimitsSQL := `CREATE TABLE IF NOT EXISTS gambling_limits (
+		user_id TEXT PRIMARY KEY,
+		daily_loss INTEGER DEFAULT 0,
+		weekly_loss INTEGER DEFAULT 0,
+		max_bet INTEGER DEFAULT 0,
+		excluded_until TIMESTAMP,
+		pending_daily_loss INTEGER DEFAULT 0,
+		pending_daily_loss_at TIMESTAMP,
+		pending_weekly_loss INTEGER DEFAULT 0,
+		pending_weekly_loss_at TIMESTAMP,
+		pending_max_bet INTEGER DEFAULT 0,
+		pending_max_bet_at TIMESTAMP
+	);`
+	if _, err := p.db.Exec(createLimitsSQL); err != nil {
+		return err
+	}
+	createResultsSQL := `CREATE TABLE IF NOT EXISTS game_results (
+		user_id TEXT NOT NULL,
+		game TEXT NOT NULL,
+		stake INTEGER NOT NULL,
+		payout INTEGER NOT NULL,
+		detail TEXT,
+		played_at TIMESTAMP
+	);`
+	if _, err := p.db.Exec(createResultsSQL); err != nil {
+		return err
+	}
+	_, err := p.db.Exec(`CREATE INDEX IF NOT EXISTS idx_game_results_user ON game_results (user_id, played_at);`)
+	return err
+}
+
+// CreateLimitsTables cria as tabelas de limites para SQLite
+func (s *SQLiteDatabase) CreateLimitsTables() error {
+	createLimitsSQL := `CREATE TABLE IF NOT EXISTS gambling_limits (
+		"user_id" TEXT NOT NULL PRIMARY KEY,
+		"daily_loss" INTEGER DEFAULT 0,
+		"weekly_loss" INTEGER DEFAULT 0,
+		"max_bet" INTEGER DEFAULT 0,
+		"excluded_until" DATETIME,
+		"pending_daily_loss" INTEGER DEFAULT 0,
+		"pending_daily_loss_at" DATETIME,
+		"pending_weekly_loss" INTEGER DEFAULT 0,
+		"pending_weekly_loss_at" DATETIME,
+		"pending_max_bet" INTEGER DEFAULT 0,
+		"pending_max_bet_at" DATETIME
+	);`
+	if _, err := s.db.Exec(createLimitsSQL); err != nil {
+		return err
+	}
+	createResultsSQL := `CREATE TABLE IF NOT EXISTS game_results (
+		"user_id" TEXT NOT NULL,
+		"game" TEXT NOT NULL,
+		"stake" INTEGER NOT NULL,
+		"payout" INTEGER NOT NULL,
+		"detail" TEXT,
+		"played_at" DATETIME
+	);`
+	if _, err := s.db.Exec(createResultsSQL); err != nil {
+		return err
+	}
+	_, err := s.db.Exec(`CREATE INDEX IF NOT EXISTS idx_game_results_user ON game_results (user_id, played_at);`)
+	return err
+}
+
+// GetGamblingLimits retorna os limites do usuário (todos zerados se ele nunca definiu)
+func GetGamblingLimits(userID string) (*GamblingLimits, error) {
+	query := prepareQuery(`SELECT daily_loss, weekly_loss, max_bet, excluded_until,
+		pending_daily_loss, pending_daily_loss_at, pending_weekly_loss, pending_weekly_loss_at,
+		pending_max_bet, pending_max_bet_at
+		FROM gambling_limits WHERE user_id = ?`)
+	l := &GamblingLimits{UserID: userID}
+	var excludedUntil, dailyAt, weeklyAt, maxBetAt sql.NullTime
+	err := DB.QueryRow(query, userID).Scan(&l.DailyLoss, &l.WeeklyLoss, &l.MaxBet, &excludedUntil,
+		&l.PendingDailyLoss, &dailyAt, &l.PendingWeeklyLoss, &weeklyAt, &l.PendingMaxBet, &maxBetAt)
+	if err == sql.ErrNoRows {
+		return l, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	l.ExcludedUntil = excludedUntil.Time
+	l.PendingDailyLossAt, l.PendingWeeklyLossAt, l.PendingMaxBetAt = dailyAt.Time, weeklyAt.Time, maxBetAt.Time
+	return l, nil
+}
+
+// SaveGamblingLimits grava os limites do usuário
+func SaveGamblingLimits(l *GamblingLimits) error {
+	query := prepareQuery(`INSERT INTO gambling_limits (user_id, daily_loss, weekly_loss, max_bet, excluded_until,
+		pending_daily_loss, pending_daily_loss_at, pending_weekly_loss, pending_weekly_loss_at,
+		pending_max_bet, pending_max_bet_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(user_id) DO UPDATE SET daily_loss = excluded.daily_loss, weekly_loss = excluded.weekly_loss,
+		max_bet = excluded.max_bet, excluded_until = excluded.excluded_until,
+		pending_daily_loss = excluded.pending_daily_loss, pending_daily_loss_at = excluded.pending_daily_loss_at,
+		pending_weekly_loss = excluded.pending_weekly_loss, pending_weekly_loss_at = excluded.pending_weekly_loss_at,
+		pending_max_bet = excluded.pending_max_bet, pending_max_bet_at = excluded.pending_max_bet_at`)
+	_, err := DB.Exec(query, l.UserID, l.DailyLoss, l.WeeklyLoss, l.MaxBet, nullTime(l.ExcludedUntil),
+		l.PendingDailyLoss, nullTime(l.PendingDailyLossAt), l.PendingWeeklyLoss, nullTime(l.PendingWeeklyLossAt),
+		l.PendingMaxBet, nullTime(l.PendingMaxBetAt))
+	return err
+}
+
+// RecordGameResult guarda o resultado de uma partida encerrada. detail
+// descreve o desfecho (ponto de queda, cartas...) e pode ser vazio.
+func RecordGameResult(userID, game string, stake, payout int, detail string) error {
+	query := prepareQuery("INSERT INTO game_results (user_id, game, stake, payout, detail, played_at) VALUES (?, ?, ?, ?, ?, ?)")
+	_, err := DB.Exec(query, userID, game, stake, payout, detail, time.Now().UTC())
+	return err
+}
+
+// GetNetLoss retorna quanto o usuário perdeu nos jogos desde since (negativo se lucrou)
+func GetNetLoss(userID string, since time.Time) (int, error) {
+	query := prepareQuery("SELECT COALESCE(SUM(stake - payout), 0) FROM game_results WHERE user_id = ? AND played_at >= ?")
+	var loss int
+	err := DB.QueryRow(query, userID, since).Scan(&loss)
+	return loss, err
+}
+
+// nullTime grava o horário zero como NULL
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
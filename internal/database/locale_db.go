@@ -0,0 +1,41 @@
+package database
+
+import "database/sql"
+
+// GetUserLocale retorna o idioma escolhido pelo usuário ("" = automático)
+func GetUserLocale(userID string) (string, error) {
+	var locale sql.NullString
+	query := prepareQuery("SELECT locale FROM users WHERE id = ?")
+	err := DB.QueryRow(query, userID).Scan(&locale)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return locale.String, err
+}
+
+// SetUserLocale define o idioma do usuário. Vazio volta ao automático.
+func SetUserLocale(userID, locale string) error {
+	GetBalance(userID) // garante que o usuário existe
+	query := prepareQuery("UPDATE users SET locale = ? WHERE id = ?")
+	_, err := DB.Exec(query, sql.NullString{String: locale, Valid: locale != ""}, userID)
+	return err
+}
+
+// GetGuildLocale retorna o idioma padrão do servidor ("" = padrão do config.json)
+func GetGuildLocale(guildID string) (string, error) {
+	var locale sql.NullString
+	query := prepareQuery("SELECT locale FROM guild_settings WHERE guild_id = ?")
+	err := DB.QueryRow(query, guildID).Scan(&locale)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return locale.String, err
+}
+
+// SetGuildLocale define o idioma padrão do servidor. Vazio volta ao padrão do config.json.
+func SetGuildLocale(guildID, locale string) error {
+	query, args := DB.UpsertSyntax("guild_settings", []string{"guild_id"}, []string{"locale"},
+		[]interface{}{guildID, sql.NullString{String: locale, Valid: locale != ""}})
+	_, err := DB.Exec(query, args...)
+	return err
+}
@@ -0,0 +1,225 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// LotteryRound é um período de venda de bilhetes que termina no sorteio
+type LotteryRound struct {
+	ID            int
+	Jackpot       int
+	DrawAt        time.Time
+	Drawn         bool
+	WinningNumber int
+}
+
+// LotteryTicket é um bilhete comprado em um sorteio
+type LotteryTicket struct {
+	RoundID int
+	UserID  string
+	Number  int
+}
+
+// CreateLotteryTables cria as tabelas da loteria
+func (p *PostgresDatabase) CreateLotteryTables() error {
+	createRoundsSQL := `CREATE TABLE IF NOT EXISTS lottery_rounds (
+		id INTEGER PRIMARY KEY,
+		jackpot INTEGER DEFAULT 0,
+		draw_at TIMESTAMP,
+		drawn BOOLEAN DEFAULT FALSE,
+		winning_number INTEGER DEFAULT 0
+	);`
+	if _, err := p.db.Exec(createRoundsSQL); err != nil {
+		return err
+	}
+	createTicketsSQL := `CREATE TABLE IF NOT EXISTS lottery_tickets (
+		round_id INTEGER NOT NULL,
+		user_id TEXT NOT NULL,
+		number INTEGER NOT NULL
+	);`
+	if _, err := p.db.Exec(createTicketsSQL); err != nil {
+		return err
+	}
+	_, err := p.db.Exec(`CREATE INDEX IF NOT EXISTS idx_lottery_tickets_round ON lottery_tickets (round_id, user_id);`)
+	return err
+}
+
+// CreateLotteryTables cria as tabelas da loteria para SQLite
+func (s *SQLiteDatabase) CreateLotteryTables() error {
+	createRoundsSQL := `CREATE TABLE IF NOT EXISTS lottery_rounds (
+		"id" INTEGER NOT NULL PRIMARY KEY,
+		"jackpot" INTEGER DEFAULT 0,
+		"draw_at" DATETIME,
+		"drawn" INTEGER DEFAULT 0,
+		"winning_number" INTEGER DEFAULT 0
+	);`
+	if _, err := s.db.Exec(createRoundsSQL); err != nil {
+		return err
+	}
+	createTicketsSQL := `CREATE TABLE IF NOT EXISTS lottery_tickets (
+		"round_id" INTEGER NOT NULL,
+		"user_id" TEXT NOT NULL,
+		"number" INTEGER NOT NULL
+	);`
+	if _, err := s.db.Exec(createTicketsSQL); err != nil {
+		return err
+	}
+	_, err := s.db.Exec(`CREATE INDEX IF NOT EXISTS idx_lottery_tickets_round ON lottery_tickets (round_id, user_id);`)
+	return err
+}
+
+// GetOpenLotteryRound retorna o sorteio que ainda vende bilhetes (sql.ErrNoRows se não houver)
+func GetOpenLotteryRound() (*LotteryRound, error) {
+	query := prepareQuery("SELECT id, jackpot, draw_at, drawn, winning_number FROM lottery_rounds WHERE drawn = ? ORDER BY id DESC LIMIT 1")
+	r := &LotteryRound{}
+	err := DB.QueryRow(query, false).Scan(&r.ID, &r.Jackpot, &r.DrawAt, &r.Drawn, &r.WinningNumber)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// CreateLotteryRound abre um novo sorteio com o prêmio acumulado
+func CreateLotteryRound(jackpot int, drawAt time.Time) (*LotteryRound, error) {
+	tx, err := DB.BeginTx(context.Background(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	r, err := createLotteryRoundTx(tx, jackpot, drawAt)
+	if err != nil {
+		return nil, err
+	}
+	return r, tx.Commit()
+}
+
+func createLotteryRoundTx(tx *sql.Tx, jackpot int, drawAt time.Time) (*LotteryRound, error) {
+	var last sql.NullInt64
+	if err := tx.QueryRow("SELECT MAX(id) FROM lottery_rounds").Scan(&last); err != nil {
+		return nil, err
+	}
+	r := &LotteryRound{ID: int(last.Int64) + 1, Jackpot: jackpot, DrawAt: drawAt}
+	query := prepareQuery("INSERT INTO lottery_rounds (id, jackpot, draw_at, drawn, winning_number) VALUES (?, ?, ?, ?, ?)")
+	if _, err := tx.Exec(query, r.ID, r.Jackpot, r.DrawAt, false, 0); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// BuyLotteryTickets cobra os bilhetes do usuário, grava os números e soma ao
+// prêmio o valor pago menos a parte do bot, tudo na mesma transação.
+// Retorna sql.ErrNoRows se o saldo não for suficiente.
+func BuyLotteryTickets(userID string, roundID int, numbers []int, cost, houseCut int) error {
+	ctx := context.Background()
+	tx, err := DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var balance int
+	err = tx.QueryRowContext(ctx, prepareQuery("SELECT balance FROM users WHERE id = ?"), userID).Scan(&balance)
+	if err != nil {
+		return err
+	}
+	if balance < cost {
+		return sql.ErrNoRows
+	}
+	if _, err := tx.ExecContext(ctx, prepareQuery("UPDATE users SET balance = balance - ? WHERE id = ?"), cost, userID); err != nil {
+		return err
+	}
+
+	insert := prepareQuery("INSERT INTO lottery_tickets (round_id, user_id, number) VALUES (?, ?, ?)")
+	for _, number := range numbers {
+		if _, err := tx.ExecContext(ctx, insert, roundID, userID, number); err != nil {
+			return err
+		}
+	}
+
+	query := prepareQuery("UPDATE lottery_rounds SET jackpot = jackpot + ? WHERE id = ?")
+	if _, err := tx.ExecContext(ctx, query, cost-houseCut, roundID); err != nil {
+		return err
+	}
+	if BotUserID != "" && houseCut > 0 {
+		if err := addCoinsTx(ctx, tx, BotUserID, houseCut); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// GetLotteryTickets retorna todos os bilhetes de um sorteio
+func GetLotteryTickets(roundID int) ([]LotteryTicket, error) {
+	query := prepareQuery("SELECT round_id, user_id, number FROM lottery_tickets WHERE round_id = ?")
+	rows, err := DB.Query(query, roundID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tickets []LotteryTicket
+	for rows.Next() {
+		var t LotteryTicket
+		if err := rows.Scan(&t.RoundID, &t.UserID, &t.Number); err != nil {
+			return nil, err
+		}
+		tickets = append(tickets, t)
+	}
+	return tickets, rows.Err()
+}
+
+// GetUserLotteryNumbers retorna os números dos bilhetes de um usuário em um sorteio
+func GetUserLotteryNumbers(roundID int, userID string) ([]int, error) {
+	query := prepareQuery("SELECT number FROM lottery_tickets WHERE round_id = ? AND user_id = ? ORDER BY number")
+	rows, err := DB.Query(query, roundID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var numbers []int
+	for rows.Next() {
+		var n int
+		if err := rows.Scan(&n); err != nil {
+			return nil, err
+		}
+		numbers = append(numbers, n)
+	}
+	return numbers, rows.Err()
+}
+
+// FinishLotteryRound fecha o sorteio, paga os ganhadores e abre o próximo com o
+// prêmio acumulado (zero quando houve ganhador), tudo na mesma transação para
+// que um reinício não pague nem sorteie duas vezes
+func FinishLotteryRound(roundID, winningNumber int, payouts map[string]int, nextJackpot int, nextDrawAt time.Time) (*LotteryRound, error) {
+	ctx := context.Background()
+	tx, err := DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := prepareQuery("UPDATE lottery_rounds SET drawn = ?, winning_number = ? WHERE id = ? AND drawn = ?")
+	result, err := tx.ExecContext(ctx, query, true, winningNumber, roundID, false)
+	if err != nil {
+		return nil, err
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	for userID, amount := range payouts {
+		if err := addCoinsTx(ctx, tx, userID, amount); err != nil {
+			return nil, err
+		}
+	}
+
+	next, err := createLotteryRoundTx(tx, nextJackpot, nextDrawAt)
+	if err != nil {
+		return nil, err
+	}
+	return next, tx.Commit()
+}
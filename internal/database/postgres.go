@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -108,6 +109,11 @@ func (p *PostgresDatabase) Begin() (*sql.Tx, error) {
 	return p.db.Begin()
 }
 
+// BeginTx inicia uma transação vinculada ao contexto
+func (p *PostgresDatabase) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	return p.db.BeginTx(ctx, opts)
+}
+
 // Placeholder retorna $N para PostgreSQL (1-indexed)
 func (p *PostgresDatabase) Placeholder(index int) string {
 	return fmt.Sprintf("$%d", index)
@@ -174,8 +180,14 @@ func (p *PostgresDatabase) CreateTables() error {
 		balance INTEGER DEFAULT 0,
 		last_daily TIMESTAMP,
 		webhook_url TEXT,
+		webhook_secret TEXT,
+		webhook_events TEXT,
+		webhook_game_threshold INTEGER DEFAULT 0,
 		daily_streak INTEGER DEFAULT 0,
-		max_daily_streak INTEGER DEFAULT 0
+		max_daily_streak INTEGER DEFAULT 0,
+		frozen BOOLEAN DEFAULT FALSE,
+		locale TEXT,
+		hide_balance BOOLEAN DEFAULT FALSE
 	);`
 	if _, err := p.db.Exec(createTableSQL); err != nil {
 		log.Printf("Warning: error creating users table (may already exist): %v", err)
@@ -185,6 +197,12 @@ func (p *PostgresDatabase) CreateTables() error {
 	migrationQueries := []string{
 		`ALTER TABLE users ADD COLUMN IF NOT EXISTS daily_streak INTEGER DEFAULT 0;`,
 		`ALTER TABLE users ADD COLUMN IF NOT EXISTS max_daily_streak INTEGER DEFAULT 0;`,
+		`ALTER TABLE users ADD COLUMN IF NOT EXISTS webhook_secret TEXT;`,
+		`ALTER TABLE users ADD COLUMN IF NOT EXISTS webhook_events TEXT;`,
+		`ALTER TABLE users ADD COLUMN IF NOT EXISTS webhook_game_threshold INTEGER DEFAULT 0;`,
+		`ALTER TABLE users ADD COLUMN IF NOT EXISTS frozen BOOLEAN DEFAULT FALSE;`,
+		`ALTER TABLE users ADD COLUMN IF NOT EXISTS locale TEXT;`,
+		`ALTER TABLE users ADD COLUMN IF NOT EXISTS hide_balance BOOLEAN DEFAULT FALSE;`,
 	}
 	for _, query := range migrationQueries {
 		if _, err := p.db.Exec(query); err != nil {
@@ -244,6 +262,40 @@ func (p *PostgresDatabase) CreateTables() error {
 		log.Printf("Warning: error creating loans table: %v", err)
 	}
 
+	// Criar tabelas do outbox de webhooks
+	if err := p.CreateWebhookTables(); err != nil {
+		log.Printf("Warning: error creating webhook tables: %v", err)
+	}
+
+	// Criar tabela de auditoria administrativa
+	if err := p.CreateAdminTables(); err != nil {
+		log.Printf("Warning: error creating admin audit table: %v", err)
+	}
+
+	// Criar tabelas da loteria
+	if err := p.CreateLotteryTables(); err != nil {
+		log.Printf("Warning: error creating lottery tables: %v", err)
+	}
+
+	// Criar tabelas do prêmio acumulado do caça-níquel
+	if err := p.CreateSlotsJackpotTables(); err != nil {
+		log.Printf("Warning: error creating slots jackpot tables: %v", err)
+	}
+
+	// Criar tabelas de limites de jogo e resultados das partidas
+	if err := p.CreateLimitsTables(); err != nil {
+		log.Printf("Warning: error creating gambling limits tables: %v", err)
+	}
+
+	// Criar tabela de configurações por servidor
+	createGuildSettingsSQL := `CREATE TABLE IF NOT EXISTS guild_settings (
+		guild_id TEXT PRIMARY KEY,
+		locale TEXT
+	);`
+	if _, err := p.db.Exec(createGuildSettingsSQL); err != nil {
+		log.Printf("Warning: error creating guild_settings table: %v", err)
+	}
+
 	log.Println("Table creation completed")
 	return nil
 }
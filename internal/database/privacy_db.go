@@ -0,0 +1,22 @@
+package database
+
+import "database/sql"
+
+// IsBalanceHidden informa se o usuário escondeu o saldo dos outros usuários
+// (!balance @usuário e ranking)
+func IsBalanceHidden(userID string) bool {
+	var hidden sql.NullBool
+	query := prepareQuery("SELECT hide_balance FROM users WHERE id = ?")
+	if err := DB.QueryRow(query, userID).Scan(&hidden); err != nil {
+		return false
+	}
+	return hidden.Bool
+}
+
+// SetBalanceHidden esconde ou mostra o saldo do usuário para os outros
+func SetBalanceHidden(userID string, hidden bool) error {
+	GetBalance(userID) // garante que o usuário existe
+	query := prepareQuery("UPDATE users SET hide_balance = ? WHERE id = ?")
+	_, err := DB.Exec(query, hidden, userID)
+	return err
+}
@@ -0,0 +1,166 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"estudocoin/pkg/config"
+	"time"
+)
+
+// SlotsJackpotWin é um prêmio acumulado pago no caça-níquel
+type SlotsJackpotWin struct {
+	UserID string
+	Amount int
+	WonAt  time.Time
+}
+
+// CreateSlotsJackpotTables cria as tabelas do prêmio acumulado do caça-níquel
+func (p *PostgresDatabase) CreateSlotsJackpotTables() error {
+	createPoolSQL := `CREATE TABLE IF NOT EXISTS slots_jackpot (
+		id INTEGER PRIMARY KEY,
+		pool INTEGER DEFAULT 0
+	);`
+	if _, err := p.db.Exec(createPoolSQL); err != nil {
+		return err
+	}
+	createWinsSQL := `CREATE TABLE IF NOT EXISTS slots_jackpot_wins (
+		user_id TEXT NOT NULL,
+		amount INTEGER NOT NULL,
+		won_at TIMESTAMP
+	);`
+	_, err := p.db.Exec(createWinsSQL)
+	return err
+}
+
+// CreateSlotsJackpotTables cria as tabelas do prêmio acumulado para SQLite
+func (s *SQLiteDatabase) CreateSlotsJackpotTables() error {
+	createPoolSQL := `CREATE TABLE IF NOT EXISTS slots_jackpot (
+		"id" INTEGER NOT NULL PRIMARY KEY,
+		"pool" INTEGER DEFAULT 0
+	);`
+	if _, err := s.db.Exec(createPoolSQL); err != nil {
+		return err
+	}
+	createWinsSQL := `CREATE TABLE IF NOT EXISTS slots_jackpot_wins (
+		"user_id" TEXT NOT NULL,
+		"amount" INTEGER NOT NULL,
+		"won_at" DATETIME
+	);`
+	_, err := s.db.Exec(createWinsSQL)
+	return err
+}
+
+// GetSlotsJackpot retorna o valor atual do prêmio acumulado
+func GetSlotsJackpot() int {
+	var pool int
+	err := DB.QueryRow(prepareQuery("SELECT pool FROM slots_jackpot WHERE id = ?"), 1).Scan(&pool)
+	if err != nil {
+		return 0
+	}
+	return pool
+}
+
+// FeedSlotsJackpot move para o prêmio acumulado a parte de uma aposta que o
+// bot já recebeu. Sem perfil do bot as moedas só entram no pote.
+func FeedSlotsJackpot(amount int) error {
+	if amount <= 0 {
+		return nil
+	}
+	ctx := context.Background()
+	tx, err := DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := addToPoolTx(ctx, tx, amount); err != nil {
+		return err
+	}
+	if BotUserID != "" {
+		if err := addCoinsTx(ctx, tx, BotUserID, -amount); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// WinSlotsJackpot paga o prêmio acumulado inteiro ao usuário, registra no
+// histórico e recomeça o pote com seed moedas pagas pelo bot. Retorna o valor pago.
+func WinSlotsJackpot(userID string, seed int) (int, error) {
+	ctx := context.Background()
+	tx, err := DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// A linha fica travada até o commit: dois ganhadores ao mesmo tempo não
+	// recebem o mesmo pote (no SQLite a transação já serializa a escrita)
+	query := "SELECT pool FROM slots_jackpot WHERE id = ?"
+	if config.DBType == "postgres" {
+		query += " FOR UPDATE"
+	}
+	var pool int
+	err = tx.QueryRowContext(ctx, prepareQuery(query), 1).Scan(&pool)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, err
+	}
+
+	if _, err := tx.ExecContext(ctx, prepareQuery("UPDATE slots_jackpot SET pool = 0 WHERE id = ?"), 1); err != nil {
+		return 0, err
+	}
+	if seed > 0 {
+		if err := addToPoolTx(ctx, tx, seed); err != nil {
+			return 0, err
+		}
+		if BotUserID != "" {
+			if err := addCoinsTx(ctx, tx, BotUserID, -seed); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if pool > 0 {
+		if err := addCoinsTx(ctx, tx, userID, pool); err != nil {
+			return 0, err
+		}
+		insert := prepareQuery("INSERT INTO slots_jackpot_wins (user_id, amount, won_at) VALUES (?, ?, ?)")
+		if _, err := tx.ExecContext(ctx, insert, userID, pool, time.Now()); err != nil {
+			return 0, err
+		}
+	}
+	return pool, tx.Commit()
+}
+
+// GetSlotsJackpotWins retorna os últimos prêmios acumulados pagos, do mais recente
+func GetSlotsJackpotWins(limit int) ([]SlotsJackpotWin, error) {
+	query := prepareQuery("SELECT user_id, amount, won_at FROM slots_jackpot_wins ORDER BY won_at DESC LIMIT ?")
+	rows, err := DB.Query(query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var wins []SlotsJackpotWin
+	for rows.Next() {
+		var w SlotsJackpotWin
+		if err := rows.Scan(&w.UserID, &w.Amount, &w.WonAt); err != nil {
+			return nil, err
+		}
+		wins = append(wins, w)
+	}
+	return wins, rows.Err()
+}
+
+// addToPoolTx soma ao prêmio acumulado, criando a linha do pote se preciso
+func addToPoolTx(ctx context.Context, tx *sql.Tx, amount int) error {
+	result, err := tx.ExecContext(ctx, prepareQuery("UPDATE slots_jackpot SET pool = pool + ? WHERE id = ?"), amount, 1)
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err == nil && n > 0 {
+		return nil
+	}
+	_, err = tx.ExecContext(ctx, prepareQuery("INSERT INTO slots_jackpot (id, pool) VALUES (?, ?)"), 1, amount)
+	return err
+}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
@@ -71,6 +72,11 @@ func (s *SQLiteDatabase) Begin() (*sql.Tx, error) {
 	return s.db.Begin()
 }
 
+// BeginTx inicia uma transação vinculada ao contexto
+func (s *SQLiteDatabase) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	return s.db.BeginTx(ctx, opts)
+}
+
 // Placeholder retorna ? para SQLite (não usa índice)
 func (s *SQLiteDatabase) Placeholder(index int) string {
 	return "?"
@@ -124,8 +130,14 @@ func (s *SQLiteDatabase) CreateTables() error {
 		"balance" INTEGER DEFAULT 0,
 		"last_daily" DATETIME,
 		"webhook_url" TEXT,
+		"webhook_secret" TEXT,
+		"webhook_events" TEXT,
+		"webhook_game_threshold" INTEGER DEFAULT 0,
 		"daily_streak" INTEGER DEFAULT 0,
-		"max_daily_streak" INTEGER DEFAULT 0
+		"max_daily_streak" INTEGER DEFAULT 0,
+		"frozen" INTEGER DEFAULT 0,
+		"locale" TEXT,
+		"hide_balance" INTEGER DEFAULT 0
 	);`
 	if _, err := s.db.Exec(createTableSQL); err != nil {
 		return err
@@ -135,6 +147,12 @@ func (s *SQLiteDatabase) CreateTables() error {
 	_, _ = s.db.Exec(`ALTER TABLE users ADD COLUMN webhook_url TEXT;`)
 	_, _ = s.db.Exec(`ALTER TABLE users ADD COLUMN daily_streak INTEGER DEFAULT 0;`)
 	_, _ = s.db.Exec(`ALTER TABLE users ADD COLUMN max_daily_streak INTEGER DEFAULT 0;`)
+	_, _ = s.db.Exec(`ALTER TABLE users ADD COLUMN webhook_secret TEXT;`)
+	_, _ = s.db.Exec(`ALTER TABLE users ADD COLUMN webhook_events TEXT;`)
+	_, _ = s.db.Exec(`ALTER TABLE users ADD COLUMN webhook_game_threshold INTEGER DEFAULT 0;`)
+	_, _ = s.db.Exec(`ALTER TABLE users ADD COLUMN frozen INTEGER DEFAULT 0;`)
+	_, _ = s.db.Exec(`ALTER TABLE users ADD COLUMN locale TEXT;`)
+	_, _ = s.db.Exec(`ALTER TABLE users ADD COLUMN hide_balance INTEGER DEFAULT 0;`)
 
 	createApiTableSQL := `CREATE TABLE IF NOT EXISTS api_keys (
 		"key" TEXT NOT NULL PRIMARY KEY,
@@ -188,5 +206,39 @@ func (s *SQLiteDatabase) CreateTables() error {
 		return err
 	}
 
+	// Criar tabelas do outbox de webhooks
+	if err := s.CreateWebhookTables(); err != nil {
+		return err
+	}
+
+	// Criar tabela de auditoria administrativa
+	if err := s.CreateAdminTables(); err != nil {
+		return err
+	}
+
+	// Criar tabelas da loteria
+	if err := s.CreateLotteryTables(); err != nil {
+		return err
+	}
+
+	// Criar tabelas do prêmio acumulado do caça-níquel
+	if err := s.CreateSlotsJackpotTables(); err != nil {
+		return err
+	}
+
+	// Criar tabelas de limites de jogo e resultados das partidas
+	if err := s.CreateLimitsTables(); err != nil {
+		return err
+	}
+
+	// Criar tabela de configurações por servidor
+	createGuildSettingsSQL := `CREATE TABLE IF NOT EXISTS guild_settings (
+		"guild_id" TEXT NOT NULL PRIMARY KEY,
+		"locale" TEXT
+	);`
+	if _, err := s.db.Exec(createGuildSettingsSQL); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,118 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"estudocoin/pkg/config"
+)
+
+// Erros retornados pelas operações de compra e venda
+var (
+	ErrInsufficientBalance  = errors.New("insufficient balance")
+	ErrInsufficientHoldings = errors.New("insufficient holdings")
+)
+
+// assetTable descreve a tabela de investimentos de um mercado
+type assetTable struct {
+	table string
+	key   string
+	qty   string
+	dust  float64 // abaixo disso a posição é considerada zerada
+}
+
+var (
+	stockAssets  = assetTable{table: "stock_investments", key: "ticker", qty: "shares", dust: 0.000001}
+	cryptoAssets = assetTable{table: "crypto_investments", key: "symbol", qty: "coins", dust: 0.00000001}
+)
+
+// BuyStock debita o custo e credita as ações numa única transação
+func BuyStock(ctx context.Context, userID, ticker string, cost int, shares float64) error {
+	return buyAsset(ctx, stockAssets, userID, ticker, cost, shares)
+}
+
+// SellStock remove as ações e credita o valor da venda numa única transação
+func SellStock(ctx context.Context, userID, ticker string, shares float64, payout int) error {
+	return sellAsset(ctx, stockAssets, userID, ticker, shares, payout)
+}
+
+// BuyCrypto debita o custo e credita as coins numa única transação
+func BuyCrypto(ctx context.Context, userID, symbol string, cost int, coins float64) error {
+	return buyAsset(ctx, cryptoAssets, userID, symbol, cost, coins)
+}
+
+// SellCrypto remove as coins e credita o valor da venda numa única transação
+func SellCrypto(ctx context.Context, userID, symbol string, coins float64, payout int) error {
+	return sellAsset(ctx, cryptoAssets, userID, symbol, coins, payout)
+}
+
+func buyAsset(ctx context.Context, a assetTable, userID, key string, cost int, qty float64) error {
+	tx, err := DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Debita somente se houver saldo (evita corrida entre a verificação e o débito)
+	res, err := tx.ExecContext(ctx, prepareQuery("UPDATE users SET balance = balance - ? WHERE id = ? AND balance >= ?"), cost, userID, cost)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return ErrInsufficientBalance
+	}
+
+	if config.DBType == "postgres" {
+		query := fmt.Sprintf(`INSERT INTO %[1]s (user_id, %[2]s, %[3]s) VALUES ($1, $2, $3)
+				  ON CONFLICT(user_id, %[2]s) DO UPDATE SET %[3]s = %[1]s.%[3]s + $3`, a.table, a.key, a.qty)
+		_, err = tx.ExecContext(ctx, query, userID, key, qty)
+	} else {
+		query := fmt.Sprintf("INSERT INTO %[1]s (user_id, %[2]s, %[3]s) VALUES (?, ?, ?) ON CONFLICT(user_id, %[2]s) DO UPDATE SET %[3]s = %[3]s + ?", a.table, a.key, a.qty)
+		_, err = tx.ExecContext(ctx, query, userID, key, qty, qty)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func sellAsset(ctx context.Context, a assetTable, userID, key string, qty float64, payout int) error {
+	tx, err := DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var owned float64
+	query := prepareQuery(fmt.Sprintf("SELECT %s FROM %s WHERE user_id = ? AND %s = ?", a.qty, a.table, a.key))
+	err = tx.QueryRowContext(ctx, query, userID, key).Scan(&owned)
+	if err == sql.ErrNoRows || (err == nil && owned < qty) {
+		return ErrInsufficientHoldings
+	}
+	if err != nil {
+		return err
+	}
+
+	newAmount := owned - qty
+	if newAmount <= a.dust { // Float precision safety, effectively 0
+		query = prepareQuery(fmt.Sprintf("DELETE FROM %s WHERE user_id = ? AND %s = ?", a.table, a.key))
+		_, err = tx.ExecContext(ctx, query, userID, key)
+	} else {
+		query = prepareQuery(fmt.Sprintf("UPDATE %s SET %s = ? WHERE user_id = ? AND %s = ?", a.table, a.qty, a.key))
+		_, err = tx.ExecContext(ctx, query, newAmount, userID, key)
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, prepareQuery("UPDATE users SET balance = balance + ? WHERE id = ?"), payout, userID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,202 @@
+package database
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+)
+
+// Status possíveis de uma entrega de webhook
+const (
+	WebhookStatusPending   = "pending"
+	WebhookStatusDelivered = "delivered"
+	WebhookStatusFailed    = "failed"
+)
+
+// WebhookDelivery representa uma entrega de webhook no outbox
+type WebhookDelivery struct {
+	ID             string
+	UserID         string
+	Event          string
+	URL            string
+	Payload        string
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode int
+	LastError      string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// CreateWebhookTables cria as tabelas do outbox de webhooks
+func (p *PostgresDatabase) CreateWebhookTables() error {
+	createDeliveriesSQL := `CREATE TABLE IF NOT EXISTS webhook_deliveries (
+		id TEXT PRIMARY KEY,
+		user_id TEXT NOT NULL,
+		event TEXT NOT NULL,
+		url TEXT NOT NULL,
+		payload TEXT NOT NULL,
+		status TEXT DEFAULT 'pending',
+		attempts INTEGER DEFAULT 0,
+		next_attempt_at TIMESTAMP,
+		last_status_code INTEGER DEFAULT 0,
+		last_error TEXT,
+		created_at TIMESTAMP,
+		updated_at TIMESTAMP
+	);`
+	if _, err := p.db.Exec(createDeliveriesSQL); err != nil {
+		return err
+	}
+	_, err := p.db.Exec(`CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries (status, next_attempt_at);`)
+	return err
+}
+
+// CreateWebhookTables cria as tabelas do outbox de webhooks para SQLite
+func (s *SQLiteDatabase) CreateWebhookTables() error {
+	createDeliveriesSQL := `CREATE TABLE IF NOT EXISTS webhook_deliveries (
+		"id" TEXT NOT NULL PRIMARY KEY,
+		"user_id" TEXT NOT NULL,
+		"event" TEXT NOT NULL,
+		"url" TEXT NOT NULL,
+		"payload" TEXT NOT NULL,
+		"status" TEXT DEFAULT 'pending',
+		"attempts" INTEGER DEFAULT 0,
+		"next_attempt_at" DATETIME,
+		"last_status_code" INTEGER DEFAULT 0,
+		"last_error" TEXT,
+		"created_at" DATETIME,
+		"updated_at" DATETIME
+	);`
+	if _, err := s.db.Exec(createDeliveriesSQL); err != nil {
+		return err
+	}
+	_, err := s.db.Exec(`CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries (status, next_attempt_at);`)
+	return err
+}
+
+// GetWebhookConfig retorna a URL e o segredo de assinatura do webhook de um usuário
+func GetWebhookConfig(userID string) (string, string, error) {
+	var url, secret sql.NullString
+	query := prepareQuery("SELECT webhook_url, webhook_secret FROM users WHERE id = ?")
+	err := DB.QueryRow(query, userID).Scan(&url, &secret)
+	if err != nil {
+		return "", "", err
+	}
+	return url.String, secret.String, nil
+}
+
+// SetWebhookSecret define o segredo usado para assinar os webhooks de um usuário
+func SetWebhookSecret(userID, secret string) error {
+	query := prepareQuery("UPDATE users SET webhook_secret = ? WHERE id = ?")
+	_, err := DB.Exec(query, secret, userID)
+	return err
+}
+
+// EnqueueWebhookDelivery grava uma entrega no outbox
+func EnqueueWebhookDelivery(d *WebhookDelivery) error {
+	query := prepareQuery(`INSERT INTO webhook_deliveries
+		(id, user_id, event, url, payload, status, attempts, next_attempt_at, last_status_code, last_error, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	_, err := DB.Exec(query, d.ID, d.UserID, d.Event, d.URL, d.Payload, d.Status, d.Attempts,
+		d.NextAttemptAt, d.LastStatusCode, d.LastError, d.CreatedAt, d.UpdatedAt)
+	return err
+}
+
+// UpdateWebhookDelivery atualiza o estado de uma entrega após uma tentativa
+func UpdateWebhookDelivery(d *WebhookDelivery) error {
+	query := prepareQuery(`UPDATE webhook_deliveries SET url = ?, status = ?, attempts = ?, next_attempt_at = ?,
+		last_status_code = ?, last_error = ?, updated_at = ? WHERE id = ?`)
+	_, err := DB.Exec(query, d.URL, d.Status, d.Attempts, d.NextAttemptAt, d.LastStatusCode, d.LastError, d.UpdatedAt, d.ID)
+	return err
+}
+
+// GetDueWebhookDeliveries retorna as entregas pendentes cuja próxima tentativa já venceu
+func GetDueWebhookDeliveries(now time.Time, limit int) ([]*WebhookDelivery, error) {
+	query := prepareQuery(`SELECT id, user_id, event, url, payload, status, attempts, next_attempt_at,
+		last_status_code, last_error, created_at, updated_at
+		FROM webhook_deliveries WHERE status = ? AND next_attempt_at <= ? ORDER BY next_attempt_at LIMIT ?`)
+	rows, err := DB.Query(query, WebhookStatusPending, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanWebhookDeliveries(rows)
+}
+
+// GetRecentWebhookDeliveries retorna as últimas entregas de um usuário
+func GetRecentWebhookDeliveries(userID string, limit int) ([]*WebhookDelivery, error) {
+	query := prepareQuery(`SELECT id, user_id, event, url, payload, status, attempts, next_attempt_at,
+		last_status_code, last_error, created_at, updated_at
+		FROM webhook_deliveries WHERE user_id = ? ORDER BY created_at DESC LIMIT ?`)
+	rows, err := DB.Query(query, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanWebhookDeliveries(rows)
+}
+
+// CancelPendingWebhookDeliveries marca como falhas as entregas pendentes de um usuário
+func CancelPendingWebhookDeliveries(userID, reason string) error {
+	query := prepareQuery("UPDATE webhook_deliveries SET status = ?, last_error = ?, updated_at = ? WHERE user_id = ? AND status = ?")
+	_, err := DB.Exec(query, WebhookStatusFailed, reason, time.Now().UTC(), userID, WebhookStatusPending)
+	return err
+}
+
+func scanWebhookDeliveries(rows *sql.Rows) ([]*WebhookDelivery, error) {
+	var deliveries []*WebhookDelivery
+	for rows.Next() {
+		d := &WebhookDelivery{}
+		var lastError sql.NullString
+		var nextAttempt, createdAt, updatedAt sql.NullTime
+		if err := rows.Scan(&d.ID, &d.UserID, &d.Event, &d.URL, &d.Payload, &d.Status, &d.Attempts,
+			&nextAttempt, &d.LastStatusCode, &lastError, &createdAt, &updatedAt); err != nil {
+			return nil, err
+		}
+		d.LastError = lastError.String
+		d.NextAttemptAt = nextAttempt.Time
+		d.CreatedAt = createdAt.Time
+		d.UpdatedAt = updatedAt.Time
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, rows.Err()
+}
+
+// GetWebhookSubscriptions retorna os eventos assinados por um usuário.
+// custom é false quando o usuário nunca alterou a seleção (usar os eventos padrão).
+func GetWebhookSubscriptions(userID string) (events []string, custom bool, gameThreshold int, err error) {
+	var raw sql.NullString
+	var threshold sql.NullInt64
+	query := prepareQuery("SELECT webhook_events, webhook_game_threshold FROM users WHERE id = ?")
+	err = DB.QueryRow(query, userID).Scan(&raw, &threshold)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, 0, nil
+		}
+		return nil, false, 0, err
+	}
+	if raw.Valid {
+		custom = true
+		for _, e := range strings.Split(raw.String, ",") {
+			if e != "" {
+				events = append(events, e)
+			}
+		}
+	}
+	return events, custom, int(threshold.Int64), nil
+}
+
+// SetWebhookSubscriptions salva a lista de eventos assinados por um usuário
+func SetWebhookSubscriptions(userID string, events []string) error {
+	query := prepareQuery("UPDATE users SET webhook_events = ? WHERE id = ?")
+	_, err := DB.Exec(query, strings.Join(events, ","), userID)
+	return err
+}
+
+// SetWebhookGameThreshold define o valor mínimo de ganho/perda para eventos de jogos
+func SetWebhookGameThreshold(userID string, threshold int) error {
+	query := prepareQuery("UPDATE users SET webhook_game_threshold = ? WHERE id = ?")
+	_, err := DB.Exec(query, threshold, userID)
+	return err
+}
@@ -2,6 +2,7 @@ package events
 
 import (
 	"estudocoin/internal/database"
+	"estudocoin/internal/webhook"
 	"estudocoin/pkg/config"
 	"log"
 	"sync"
@@ -108,10 +109,11 @@ func payAndDelete(userID string, sess VoiceSession) (minutes int, remaining int)
 	remaining = totalSecs % 60
 
 	if minutes > 0 {
-		reward := minutes * config.Economy.VoiceCoinsPerMinute
+		reward := minutes * config.Economy().VoiceCoinsPerMinute
 		go func(uid string, rew int, mins int) {
 			database.AddCoins(uid, rew)
 			log.Printf("[VOICE REWARD] User %s earned %d coins for %d minutes", uid, rew, mins)
+			webhook.Dispatch(uid, webhook.EventVoiceReward, webhook.VoiceRewardData{Minutes: mins, Reward: rew})
 		}(userID, reward, minutes)
 	}
 
@@ -188,7 +190,7 @@ func CloseAllVoiceSessions() {
 			minutes := totalSecs / 60
 
 			if minutes > 0 {
-				reward := minutes * config.Economy.VoiceCoinsPerMinute
+				reward := minutes * config.Economy().VoiceCoinsPerMinute
 				database.AddCoins(userID, reward)
 				log.Printf("[VOICE SHUTDOWN] Paid user %s: %d coins for %d minutes", userID, reward, minutes)
 			} else {
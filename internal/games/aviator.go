@@ -2,23 +2,17 @@ package games
 
 import (
 	"estudocoin/internal/database"
+	"estudocoin/internal/i18n"
 	"estudocoin/pkg/config"
 	"estudocoin/pkg/utils"
 	"fmt"
 	"log"
 	"math/rand"
-	"sync"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
 )
 
-// Active games map: UserID -> Control Channel
-var (
-	activeGames = make(map[string]chan bool)
-	mutex       sync.Mutex
-)
-
 // Constants
 const (
 	MinBet          = 100
@@ -28,47 +22,90 @@ const (
 
 type MessageUpdater func(embed *discordgo.MessageEmbed, finished bool)
 
+// aviatorGame is the session of one Aviator flight
+type aviatorGame struct {
+	*session
+	control chan bool // the cash-out button
+}
+
+func newAviatorGame(userID, channelID string) *aviatorGame {
+	return &aviatorGame{session: newSession("aviator", channelID, userID), control: make(chan bool, 1)}
+}
+
+// cashOut asks the flight to cash out at the current multiplier
+func (g *aviatorGame) cashOut() {
+	select {
+	case g.control <- true:
+	default:
+	}
+}
+
+// expire cashes out a flight that outlived its time limit
+func (g *aviatorGame) expire() {
+	g.cashOut()
+}
+
+// aviatorGameOf returns the running solo flight of a user, or nil
+func aviatorGameOf(userID string) *aviatorGame {
+	g, _ := Sessions.find(userID, func(gs GameSession) bool {
+		_, ok := gs.(*aviatorGame)
+		return ok
+	}).(*aviatorGame)
+	return g
+}
+
 // --- INTERACTION (SLASH) START ---
 
 func StartAviatorInteraction(s *discordgo.Session, i *discordgo.InteractionCreate, bet int) {
 	userID := i.Member.User.ID
+	loc := i18n.ForInteraction(i)
 
 	if !validatePreQueue(userID, bet) {
-		respondPrivate(s, i, utils.ErrorEmbed(fmt.Sprintf("Cannot queue game (Min bet: %d, Check balance/active games).", MinBet)))
+		respondPrivate(s, i, loc.ErrorEmbed("Cannot queue game (Min bet: %d, Check balance/active games).", MinBet))
 		return
 	}
 
 	// Define the Job
+	game := newAviatorGame(userID, i.ChannelID)
 	job := GameJob{
-		UserID: userID,
+		Session: game,
 		OnQueue: func(pos int) {
 			s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 				Type: discordgo.InteractionResponseChannelMessageWithSource,
 				Data: &discordgo.InteractionResponseData{
-					Embeds: []*discordgo.MessageEmbed{utils.InfoEmbed("⏳ Queued", fmt.Sprintf("You are position **#%d** in the queue.", pos))},
+					Embeds: []*discordgo.MessageEmbed{utils.InfoEmbed(loc.T("⏳ Queued"), loc.T("You are position **#%d** in the queue.", pos))},
 					Flags:  discordgo.MessageFlagsEphemeral,
 				},
 			})
 		},
-		Run: func(finishChan chan struct{}) {
-			// This runs when it's the user's turn
-			defer close(finishChan) // Signal manager when done
+		Run: func() {
+			// This runs when there is a free slot
+			defer game.finish()
 
 			// Re-validate balance (user might have spent coins while waiting)
 			if database.GetBalance(userID) < bet {
 				s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 					Type: discordgo.InteractionResponseChannelMessageWithSource,
 					Data: &discordgo.InteractionResponseData{
-						Content: "You ran out of money while waiting in queue!",
+						Content: loc.T("You ran out of money while waiting in queue!"),
 						Flags:   discordgo.MessageFlagsEphemeral,
 					},
 				})
 				return
 			}
 
-			// Setup Game State
-			controlChan := setupGame(userID, bet)
-			embed, btn := getInitialState(bet, userID)
+			// Take the stake
+			if err := collectStake(userID, bet); err != nil {
+				s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+					Type: discordgo.InteractionResponseChannelMessageWithSource,
+					Data: &discordgo.InteractionResponseData{
+						Content: "❌ " + loc.Err(err),
+						Flags:   discordgo.MessageFlagsEphemeral,
+					},
+				})
+				return
+			}
+			embed, btn := getInitialState(loc, bet, userID)
 
 			// Try to Edit original response (if token valid) or Send New
 			// Interaction tokens last 15 mins. Queue might take longer? Unlikely for small bots.
@@ -76,7 +113,7 @@ func StartAviatorInteraction(s *discordgo.Session, i *discordgo.InteractionCreat
 			
 			// Let's try sending a NEW message to the channel
 			msg, err := s.ChannelMessageSendComplex(i.ChannelID, &discordgo.MessageSend{
-				Content: fmt.Sprintf("<@%s> Your Aviator game is starting!", userID),
+				Content: loc.T("<@%s> Your Aviator game is starting!", userID),
 				Embeds: []*discordgo.MessageEmbed{embed},
 				Components: []discordgo.MessageComponent{
 					discordgo.ActionsRow{Components: []discordgo.MessageComponent{btn}},
@@ -84,7 +121,6 @@ func StartAviatorInteraction(s *discordgo.Session, i *discordgo.InteractionCreat
 			})
 
 			if err != nil {
-				cleanup(userID)
 				return
 			}
 
@@ -96,7 +132,7 @@ func StartAviatorInteraction(s *discordgo.Session, i *discordgo.InteractionCreat
 						discordgo.ActionsRow{Components: []discordgo.MessageComponent{btn}},
 					}
 				} else {
-					btn.Label = "GAME OVER"
+					btn.Label = loc.T("GAME OVER")
 					btn.Style = discordgo.SecondaryButton
 					btn.Disabled = true
 					comps = []discordgo.MessageComponent{
@@ -113,41 +149,48 @@ func StartAviatorInteraction(s *discordgo.Session, i *discordgo.InteractionCreat
 				})
 			}
 
-			runGameLoop(userID, bet, controlChan, updater)
+			runGameLoop(loc, userID, bet, game.control, updater)
 		},
 	}
 
-	Enqueue(job)
+	if err := Sessions.Enqueue(job); err != nil {
+		respondPrivate(s, i, loc.ErrorEmbed(err.Error()))
+	}
 }
 
 // --- TEXT COMMAND START ---
 
 func StartAviatorText(s *discordgo.Session, m *discordgo.MessageCreate, bet int) {
 	userID := m.Author.ID
+	loc := i18n.ForMessage(m)
 
 	if !validatePreQueue(userID, bet) {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed(fmt.Sprintf("Cannot queue game (Min bet: %d, Check balance/active games).", MinBet)))
+		s.ChannelMessageSendEmbed(m.ChannelID, loc.ErrorEmbed("Cannot queue game (Min bet: %d, Check balance/active games).", MinBet))
 		return
 	}
 
+	game := newAviatorGame(userID, m.ChannelID)
 	job := GameJob{
-		UserID: userID,
+		Session: game,
 		OnQueue: func(pos int) {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.InfoEmbed("⏳ Queued", fmt.Sprintf("You are position **#%d** in the queue.", pos)))
+			s.ChannelMessageSendEmbed(m.ChannelID, utils.InfoEmbed(loc.T("⏳ Queued"), loc.T("You are position **#%d** in the queue.", pos)))
 		},
-		Run: func(finishChan chan struct{}) {
-			defer close(finishChan)
+		Run: func() {
+			defer game.finish()
 
 			if database.GetBalance(userID) < bet {
-				s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed(fmt.Sprintf("<@%s> You ran out of money while waiting.", userID)))
+				s.ChannelMessageSendEmbed(m.ChannelID, loc.ErrorEmbed("<@%s> You ran out of money while waiting.", userID))
 				return
 			}
 
-			controlChan := setupGame(userID, bet)
-			embed, btn := getInitialState(bet, userID)
+			if err := collectStake(userID, bet); err != nil {
+				s.ChannelMessageSendEmbed(m.ChannelID, loc.ErrorEmbed(loc.Err(err)))
+				return
+			}
+			embed, btn := getInitialState(loc, bet, userID)
 
 			msg, err := s.ChannelMessageSendComplex(m.ChannelID, &discordgo.MessageSend{
-				Content: fmt.Sprintf("<@%s> Your Aviator game is starting!", userID),
+				Content: loc.T("<@%s> Your Aviator game is starting!", userID),
 				Embeds: []*discordgo.MessageEmbed{embed},
 				Components: []discordgo.MessageComponent{
 					discordgo.ActionsRow{Components: []discordgo.MessageComponent{btn}},
@@ -155,7 +198,6 @@ func StartAviatorText(s *discordgo.Session, m *discordgo.MessageCreate, bet int)
 			})
 
 			if err != nil {
-				cleanup(userID)
 				return
 			}
 
@@ -166,7 +208,7 @@ func StartAviatorText(s *discordgo.Session, m *discordgo.MessageCreate, bet int)
 						discordgo.ActionsRow{Components: []discordgo.MessageComponent{btn}},
 					}
 				} else {
-					btn.Label = "GAME OVER"
+					btn.Label = loc.T("GAME OVER")
 					btn.Style = discordgo.SecondaryButton
 					btn.Disabled = true
 					comps = []discordgo.MessageComponent{
@@ -183,11 +225,13 @@ func StartAviatorText(s *discordgo.Session, m *discordgo.MessageCreate, bet int)
 				})
 			}
 
-			runGameLoop(userID, bet, controlChan, updater)
+			runGameLoop(loc, userID, bet, game.control, updater)
 		},
 	}
 
-	Enqueue(job)
+	if err := Sessions.Enqueue(job); err != nil {
+		s.ChannelMessageSendEmbed(m.ChannelID, loc.ErrorEmbed(err.Error()))
+	}
 }
 
 // --- HELPERS ---
@@ -199,41 +243,22 @@ func validatePreQueue(userID string, bet int) bool {
 	return true
 }
 
-func setupGame(userID string, bet int) chan bool {
-	mutex.Lock()
-	controlChan := make(chan bool, 1)
-	activeGames[userID] = controlChan
-	mutex.Unlock()
-	database.CollectLostBet(userID, bet)
-	return controlChan
-}
-
-func getInitialState(bet int, userID string) (*discordgo.MessageEmbed, discordgo.Button) {
+func getInitialState(loc i18n.Locale, bet int, userID string) (*discordgo.MessageEmbed, discordgo.Button) {
 	embed := utils.NewEmbed()
-	embed.Title = "✈️ Aviator Starting..."
-	embed.Description = fmt.Sprintf("Bet: **%d**\nPreparing for takeoff...", bet)
+	embed.Title = loc.T("✈️ Aviator Starting...")
+	embed.Description = loc.T("Bet: **%d**\nPreparing for takeoff...", bet)
 	embed.Color = utils.ColorBlue
 
 	btn := discordgo.Button{
-		Label:    "🛑 CASH OUT",
+		Label:    loc.T("🛑 CASH OUT"),
 		Style:    discordgo.SuccessButton,
 		CustomID: "aviator_stop_" + userID,
 	}
 	return embed, btn
 }
 
-func runGameLoop(userID string, bet int, controlChan chan bool, update MessageUpdater) {
-	defer cleanup(userID)
-
-	var crashPoint float64
-	if rand.Float64() < 0.40 {
-		crashPoint = 1.0 + (rand.Float64() * 0.5) 
-	} else {
-		r := rand.Float64()
-		crashPoint = 0.96 / (1.0 - r)
-	}
-	if crashPoint < 1.0 { crashPoint = 1.0 }
-	if crashPoint > 100.0 { crashPoint = 100.0 }
+func runGameLoop(loc i18n.Locale, userID string, bet int, controlChan chan bool, update MessageUpdater) {
+	crashPoint := rollCrashPoint()
 
 	startTime := time.Now()
 	ticker := time.NewTicker(1000 * time.Millisecond)
@@ -249,7 +274,8 @@ func runGameLoop(userID string, bet int, controlChan chan bool, update MessageUp
 			multiplier := 1.0 + (elapsed * 0.1)
 			
 			if multiplier >= crashPoint {
-				update(utils.ErrorEmbed(fmt.Sprintf("💥 CRASHED at x%.2f", crashPoint)), true)
+				reportResult(userID, "aviator", bet, 0, fmt.Sprintf("crashed at x%.2f", crashPoint))
+				update(loc.ErrorEmbed("💥 CRASHED at x%.2f", crashPoint), true)
 				return
 			}
 
@@ -258,8 +284,9 @@ func runGameLoop(userID string, bet int, controlChan chan bool, update MessageUp
 			if err != nil {
 				log.Printf("[AVIATOR ERROR] Failed to add coins for user %s: %v", userID, err)
 			}
-			log.Printf("[AVIATOR WIN] User %s won %d %s (bet: %d, multiplier: %.2f)", userID, winAmount, config.Bot.CurrencySymbol, bet, multiplier)
-			update(utils.SuccessEmbed("✅ CASHED OUT!", fmt.Sprintf("You jumped at **x%.2f**\nProfit: **+%d %s**", multiplier, winAmount, config.Bot.CurrencySymbol)), true)
+			log.Printf("[AVIATOR WIN] User %s won %d %s (bet: %d, multiplier: %.2f)", userID, winAmount, config.Bot().CurrencySymbol, bet, multiplier)
+			reportResult(userID, "aviator", bet, winAmount, fmt.Sprintf("cashed out at x%.2f, crashed at x%.2f", multiplier, crashPoint))
+			update(utils.SuccessEmbed(loc.T("✅ CASHED OUT!"), loc.T("You jumped at **x%.2f**\nProfit: **+%d %s**", multiplier, winAmount, config.Bot().CurrencySymbol)), true)
 			return
 
 		case <-ticker.C:
@@ -267,51 +294,54 @@ func runGameLoop(userID string, bet int, controlChan chan bool, update MessageUp
 			multiplier := 1.0 + (elapsed * 0.1)
 
 			if multiplier >= crashPoint {
-				update(utils.ErrorEmbed(fmt.Sprintf("💥 CRASHED at x%.2f", crashPoint)), true)
+				reportResult(userID, "aviator", bet, 0, fmt.Sprintf("crashed at x%.2f", crashPoint))
+				update(loc.ErrorEmbed("💥 CRASHED at x%.2f", crashPoint), true)
 				return
 			}
 			
 			embed := utils.NewEmbed()
-			embed.Title = "✈️ Aviator Flying..."
-			embed.Description = fmt.Sprintf("Multiplier: **x%.2f**\nPotential Win: **%d**", multiplier, int(float64(bet)*multiplier))
+			embed.Title = loc.T("✈️ Aviator Flying...")
+			embed.Description = loc.T("Multiplier: **x%.2f**\nPotential Win: **%d**", multiplier, int(float64(bet)*multiplier))
 			embed.Color = utils.ColorBlue
 			
 			dots := int(elapsed)
 			if dots > 15 { dots = 15 }
 			graph := "🛫" + string(repeatRune('.', dots)) + "✈️"
-			embed.Fields = []*discordgo.MessageEmbedField{{Name: "Altitude", Value: graph}}
+			embed.Fields = []*discordgo.MessageEmbedField{{Name: loc.T("Altitude"), Value: graph}}
 			
 			update(embed, false)
 		}
 	}
 }
 
+// rollCrashPoint draws the multiplier at which a flight crashes
+func rollCrashPoint() float64 {
+	var crashPoint float64
+	if rand.Float64() < 0.40 {
+		crashPoint = 1.0 + (rand.Float64() * 0.5) 
+	} else {
+		r := rand.Float64()
+		crashPoint = 0.96 / (1.0 - r)
+	}
+	if crashPoint < 1.0 { crashPoint = 1.0 }
+	if crashPoint > 100.0 { crashPoint = 100.0 }
+	return crashPoint
+}
+
 func HandleButton(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	userID := i.Member.User.ID
-	mutex.Lock()
-	ch, exists := activeGames[userID]
-	mutex.Unlock()
-
-	if !exists {
+	loc := i18n.ForInteraction(i)
+	game := aviatorGameOf(userID)
+	if game == nil {
 		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
-			Data: &discordgo.InteractionResponseData{Content: "⚠️ Inactive game.", Flags: discordgo.MessageFlagsEphemeral},
+			Data: &discordgo.InteractionResponseData{Content: loc.T("⚠️ Inactive game."), Flags: discordgo.MessageFlagsEphemeral},
 		})
 		return
 	}
 
 	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: discordgo.InteractionResponseDeferredMessageUpdate})
-
-	select {
-	case ch <- true:
-	default:
-	}
-}
-
-func cleanup(userID string) {
-	mutex.Lock()
-	delete(activeGames, userID)
-	mutex.Unlock()
+	game.cashOut()
 }
 
 func respondPrivate(s *discordgo.Session, i *discordgo.InteractionCreate, embed *discordgo.MessageEmbed) {
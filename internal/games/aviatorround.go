@@ -0,0 +1,337 @@
+package games
+
+import (
+	"estudocoin/internal/database"
+	"estudocoin/internal/i18n"
+	"estudocoin/pkg/config"
+	"estudocoin/pkg/utils"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Multiplayer Aviator: everyone who bets during the boarding window rides the
+// same flight and crashes at the same point. Each player cashes out with the
+// shared button or automatically at the target chosen when betting.
+
+const (
+	// BoardingWindow is how long a round takes bets before takeoff
+	BoardingWindow = 20 * time.Second
+	// MaxAutoCashout is the highest auto cash-out target accepted
+	MaxAutoCashout = 100.0
+
+	aviatorRoundButton = "aviator_round_cashout_"
+)
+
+type roundPhase int
+
+const (
+	phaseBoarding roundPhase = iota
+	phaseFlying
+	phaseCrashed
+)
+
+// passenger is one bet in a round
+type passenger struct {
+	UserID   string
+	Username string
+	Bet      int
+	Auto     float64 // auto cash-out target, 0 = manual
+	CashedAt float64 // multiplier of the cash-out, 0 while flying or after a crash
+	Payout   int
+}
+
+// aviatorRound is the shared flight of one channel
+type aviatorRound struct {
+	*session
+	loc        i18n.Locale
+	messageID  string
+	phase      roundPhase
+	takeoff    time.Time
+	crashPoint float64
+	passengers []*passenger
+	mu         sync.Mutex
+}
+
+var (
+	aviatorRounds   = make(map[string]*aviatorRound) // channelID -> round
+	aviatorRoundsMu sync.Mutex
+)
+
+// multiplier is the current multiplier of the flight. Caller holds r.mu.
+func (r *aviatorRound) multiplier() float64 {
+	if r.phase == phaseBoarding {
+		return 1.0
+	}
+	return 1.0 + time.Since(r.takeoff).Seconds()*Increment
+}
+
+func (r *aviatorRound) passenger(userID string) *passenger {
+	for _, p := range r.passengers {
+		if p.UserID == userID {
+			return p
+		}
+	}
+	return nil
+}
+
+// cashOut pays a passenger at the given multiplier. Caller holds r.mu.
+func (r *aviatorRound) cashOut(p *passenger, multiplier float64) {
+	p.CashedAt = multiplier
+	p.Payout = int(float64(p.Bet) * multiplier)
+	if err := database.AddCoins(p.UserID, p.Payout); err != nil {
+		log.Printf("[AVIATOR ERROR] Failed to add coins for user %s: %v", p.UserID, err)
+	}
+	log.Printf("[AVIATOR WIN] User %s won %d %s in the round of %s (bet: %d, multiplier: %.2f)", p.UserID, p.Payout, config.Bot().CurrencySymbol, r.channelID, p.Bet, multiplier)
+	reportResult(p.UserID, "aviator", p.Bet, p.Payout, fmt.Sprintf("cashed out at x%.2f, crashed at x%.2f", multiplier, r.crashPoint))
+}
+
+// JoinAviatorRound places a bet on the round of the channel, opening a new
+// round when there is none. auto is the auto cash-out target (0 = manual).
+func JoinAviatorRound(s *discordgo.Session, channelID string, user *discordgo.User, bet int, auto float64) error {
+	if bet < MinBet {
+		return i18n.Errorf("Minimum bet is %d %s", MinBet, config.Bot().CurrencySymbol)
+	}
+	if auto != 0 && (auto <= 1.0 || auto > MaxAutoCashout) {
+		return i18n.Errorf("The auto cash-out must be between x1.01 and x%.0f.", MaxAutoCashout)
+	}
+	if database.GetBalance(user.ID) < bet {
+		return i18n.Errorf("Insufficient balance! You have %d %s", database.GetBalance(user.ID), config.Bot().CurrencySymbol)
+	}
+	aviatorRoundsMu.Lock()
+	round, exists := aviatorRounds[channelID]
+	if !exists {
+		round = &aviatorRound{
+			session: newSession("aviator", channelID, user.ID),
+			loc:     i18n.ForChannel(s, channelID),
+		}
+		// Held until the first bet is placed, so the round starts with it
+		round.mu.Lock()
+		if err := Sessions.Start(round, func() { runAviatorRound(s, round) }); err != nil {
+			round.mu.Unlock()
+			aviatorRoundsMu.Unlock()
+			return err
+		}
+		aviatorRounds[channelID] = round
+		aviatorRoundsMu.Unlock()
+	} else {
+		aviatorRoundsMu.Unlock()
+		round.mu.Lock()
+	}
+
+	if round.phase != phaseBoarding {
+		round.mu.Unlock()
+		return i18n.Errorf("This flight already took off. Bet again when it lands.")
+	}
+	if round.passenger(user.ID) != nil {
+		round.mu.Unlock()
+		return i18n.Errorf("You are already on this flight.")
+	}
+	if exists {
+		if err := Sessions.Join(round, user.ID); err != nil {
+			round.mu.Unlock()
+			return err
+		}
+	}
+	if err := collectStake(user.ID, bet); err != nil {
+		// An empty round is dropped by runAviatorRound once it gets the lock
+		Sessions.Leave(round, user.ID)
+		round.mu.Unlock()
+		return err
+	}
+	round.passengers = append(round.passengers, &passenger{UserID: user.ID, Username: user.Username, Bet: bet, Auto: auto})
+	messageID := round.messageID
+	embed := round.embed()
+	round.mu.Unlock()
+
+	// The first bet posts the round message from runAviatorRound
+	if messageID != "" {
+		embeds := []*discordgo.MessageEmbed{embed}
+		s.ChannelMessageEditComplex(&discordgo.MessageEdit{ID: messageID, Channel: channelID, Embeds: &embeds})
+	}
+	return nil
+}
+
+// runAviatorRound waits for the boarding window, flies the plane and settles
+// every bet
+func runAviatorRound(s *discordgo.Session, r *aviatorRound) {
+	defer func() {
+		aviatorRoundsMu.Lock()
+		delete(aviatorRounds, r.channelID)
+		aviatorRoundsMu.Unlock()
+		r.finish()
+	}()
+
+	r.mu.Lock()
+	if len(r.passengers) == 0 {
+		r.phase = phaseCrashed
+		r.mu.Unlock()
+		return
+	}
+	r.takeoff = time.Now().Add(BoardingWindow)
+	embed := r.embed()
+	r.mu.Unlock()
+
+	msg, err := s.ChannelMessageSendComplex(r.channelID, &discordgo.MessageSend{
+		Embeds:     []*discordgo.MessageEmbed{embed},
+		Components: r.components(false),
+	})
+	if err != nil {
+		log.Printf("[AVIATOR ERROR] Could not post the round of %s, refunding: %v", r.channelID, err)
+		r.mu.Lock()
+		r.phase = phaseCrashed
+		for _, p := range r.passengers {
+			refundStake(p.UserID, p.Bet)
+		}
+		r.mu.Unlock()
+		return
+	}
+	r.mu.Lock()
+	r.messageID = msg.ID
+	r.mu.Unlock()
+
+	time.Sleep(time.Until(r.takeoff))
+
+	r.mu.Lock()
+	r.phase = phaseFlying
+	r.takeoff = time.Now()
+	r.crashPoint = rollCrashPoint()
+	r.mu.Unlock()
+
+	ticker := time.NewTicker(MultiplierSpeed)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		r.mu.Lock()
+		multiplier := r.multiplier()
+
+		// Auto cash-outs reached before the crash are paid at their target,
+		// even when the plane crashed since the last tick
+		for _, p := range r.passengers {
+			if p.CashedAt == 0 && p.Auto > 0 && p.Auto < r.crashPoint && p.Auto <= multiplier {
+				r.cashOut(p, p.Auto)
+			}
+		}
+
+		crashed := multiplier >= r.crashPoint
+		if crashed {
+			r.phase = phaseCrashed
+			for _, p := range r.passengers {
+				if p.CashedAt == 0 {
+					reportResult(p.UserID, "aviator", p.Bet, 0, fmt.Sprintf("crashed at x%.2f", r.crashPoint))
+				}
+			}
+		}
+		embed := r.embed()
+		r.mu.Unlock()
+
+		embeds := []*discordgo.MessageEmbed{embed}
+		components := r.components(crashed)
+		s.ChannelMessageEditComplex(&discordgo.MessageEdit{
+			ID:         msg.ID,
+			Channel:    r.channelID,
+			Embeds:     &embeds,
+			Components: &components,
+		})
+		if crashed {
+			return
+		}
+	}
+}
+
+// embed renders the round for the channel. Caller holds r.mu.
+func (r *aviatorRound) embed() *discordgo.MessageEmbed {
+	loc := r.loc
+	embed := utils.NewEmbed()
+
+	switch r.phase {
+	case phaseBoarding:
+		embed.Title = loc.T("✈️ Aviator - Boarding")
+		embed.Description = loc.T("Takeoff <t:%d:R>. Join with `/bet crash` or `!bet crash <amount> [auto]`.", r.takeoff.Unix())
+		embed.Color = utils.ColorGold
+	case phaseFlying:
+		embed.Title = loc.T("✈️ Aviator - Flying")
+		embed.Description = loc.T("Multiplier: **x%.2f**\nCash out before the crash!", r.multiplier())
+		embed.Color = utils.ColorBlue
+	case phaseCrashed:
+		embed.Title = loc.T("💥 CRASHED at x%.2f", r.crashPoint)
+		embed.Description = loc.T("The flight is over.")
+		embed.Color = utils.ColorRed
+	}
+
+	var lines []string
+	for _, p := range r.passengers {
+		var status string
+		switch {
+		case p.CashedAt > 0:
+			status = loc.T("✅ x%.2f (+%d %s)", p.CashedAt, p.Payout, config.Bot().CurrencySymbol)
+		case r.phase == phaseCrashed:
+			status = loc.T("💥 lost")
+		case p.Auto > 0:
+			status = loc.T("✈️ auto x%.2f", p.Auto)
+		default:
+			status = "✈️"
+		}
+		lines = append(lines, fmt.Sprintf("<@%s> - %d %s - %s", p.UserID, p.Bet, config.Bot().CurrencySymbol, status))
+	}
+	if len(lines) > 0 {
+		embed.Fields = []*discordgo.MessageEmbedField{{Name: loc.T("Passengers (%d)", len(lines)), Value: strings.Join(lines, "\n")}}
+	}
+	return embed
+}
+
+func (r *aviatorRound) components(finished bool) []discordgo.MessageComponent {
+	btn := discordgo.Button{
+		Label:    r.loc.T("🛑 CASH OUT"),
+		Style:    discordgo.SuccessButton,
+		CustomID: aviatorRoundButton + r.channelID,
+	}
+	if finished {
+		btn.Label = r.loc.T("GAME OVER")
+		btn.Style = discordgo.SecondaryButton
+		btn.Disabled = true
+	}
+	return []discordgo.MessageComponent{discordgo.ActionsRow{Components: []discordgo.MessageComponent{btn}}}
+}
+
+// HandleAviatorRoundButton cashes out the player who clicked the round button
+func HandleAviatorRoundButton(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	loc := i18n.ForInteraction(i)
+	userID := i.Member.User.ID
+	channelID := strings.TrimPrefix(i.MessageComponentData().CustomID, aviatorRoundButton)
+
+	aviatorRoundsMu.Lock()
+	round, exists := aviatorRounds[channelID]
+	aviatorRoundsMu.Unlock()
+	if !exists {
+		respondPrivate(s, i, loc.ErrorEmbed("⚠️ Inactive game."))
+		return
+	}
+
+	round.mu.Lock()
+	p := round.passenger(userID)
+	var errMsg string
+	switch {
+	case p == nil:
+		errMsg = "You have no bet on this flight."
+	case p.CashedAt > 0:
+		errMsg = "You already cashed out."
+	case round.phase == phaseBoarding:
+		errMsg = "The plane hasn't taken off yet."
+	case round.phase == phaseCrashed || round.multiplier() >= round.crashPoint:
+		errMsg = "Too late, the plane crashed!"
+	default:
+		round.cashOut(p, round.multiplier())
+	}
+	round.mu.Unlock()
+
+	if errMsg != "" {
+		respondPrivate(s, i, loc.ErrorEmbed(errMsg))
+		return
+	}
+	respondPrivate(s, i, utils.SuccessEmbed(loc.T("✅ CASHED OUT!"), loc.T("You jumped at **x%.2f**\nProfit: **+%d %s**", p.CashedAt, p.Payout, config.Bot().CurrencySymbol)))
+}
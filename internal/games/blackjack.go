@@ -2,8 +2,8 @@ package games
 
 import (
 	"estudocoin/internal/database"
+	"estudocoin/internal/i18n"
 	"estudocoin/pkg/config"
-	"estudocoin/pkg/utils"
 	"fmt"
 	"math/rand"
 	"strings"
@@ -30,14 +30,17 @@ type BlackjackGame struct {
 	Bet         int
 	PlayerHand  Hand
 	DealerHand  Hand
-	Deck        []Card
+	Shoe        *Shoe // shared by every hand of the channel (see shoeFor)
 	Status      string // "playing", "player_bust", "dealer_bust", "player_win", "dealer_win", "push", "blackjack"
 	MessageID   string
 	ChannelID   string
 	Insurance   bool
 	InsuranceBet int
 	DoubledDown bool
+	Locale      i18n.Locale // language of the player, used for every message of the game
+	s           *discordgo.Session // edits the game message when the hand times out
 	mu          sync.Mutex
+	*session
 }
 
 var (
@@ -51,7 +54,7 @@ var (
 	values = []string{"A", "2", "3", "4", "5", "6", "7", "8", "9", "10", "J", "Q", "K"}
 )
 
-// CreateDeck creates a shuffled deck of cards
+// createDeck creates a shuffled deck of cards (shoes combine several)
 func createDeck() []Card {
 	deck := []Card{}
 	
@@ -109,11 +112,9 @@ func parseInt(s string) int {
 	}
 }
 
-// Deal a card from the deck
+// Deal a card from the shoe
 func (g *BlackjackGame) dealCard() Card {
-	card := g.Deck[0]
-	g.Deck = g.Deck[1:]
-	return card
+	return g.Shoe.draw()
 }
 
 // Calculate hand score considering aces
@@ -156,39 +157,53 @@ func isBlackjack(hand Hand) bool {
 // Start Blackjack Game
 func StartBlackjackGame(s *discordgo.Session, i *discordgo.InteractionCreate, bet int) {
 	userID := i.Member.User.ID
+	loc := i18n.ForInteraction(i)
 	
 	// Check if user already has an active game
 	blackjackMu.Lock()
 	if _, exists := activeBlackjackGames[userID]; exists {
 		blackjackMu.Unlock()
-		respondEmbed(s, i, utils.ErrorEmbed("You already have an active Blackjack game!"))
+		respondEmbed(s, i, loc.ErrorEmbed("You already have an active Blackjack game!"))
 		return
 	}
 	blackjackMu.Unlock()
 	
 	// Validate bet
 	if bet < 10 {
-		respondEmbed(s, i, utils.ErrorEmbed(fmt.Sprintf("Minimum bet is 10 %s", config.Bot.CurrencySymbol)))
+		respondEmbed(s, i, loc.ErrorEmbed("Minimum bet is 10 %s", config.Bot().CurrencySymbol))
 		return
 	}
 	
 	balance := database.GetBalance(userID)
 	if balance < bet {
-		respondEmbed(s, i, utils.ErrorEmbed(fmt.Sprintf("Insufficient balance! You have %d %s", balance, config.Bot.CurrencySymbol)))
+		respondEmbed(s, i, loc.ErrorEmbed("Insufficient balance! You have %d %s", balance, config.Bot().CurrencySymbol))
 		return
 	}
 	
-	// Deduct bet (goes to bot)
-	database.CollectLostBet(userID, bet)
-	
 	// Initialize game
 	game := &BlackjackGame{
 		UserID:    userID,
 		Bet:       bet,
-		Deck:      createDeck(),
 		Status:    "playing",
+		Locale:    loc,
 		ChannelID: i.ChannelID,
+		s:         s,
+		session:   newSession("blackjack", i.ChannelID, userID),
+	}
+
+	// Reserve a game slot before taking the bet
+	if err := Sessions.Start(game, nil); err != nil {
+		respondEmbed(s, i, loc.ErrorEmbed(err.Error()))
+		return
+	}
+
+	// Deduct bet (goes to bot)
+	if err := collectStake(userID, bet); err != nil {
+		game.finish()
+		respondEmbed(s, i, loc.ErrorEmbed(loc.Err(err)))
+		return
 	}
+	game.Shoe, _ = shoeFor(game.ChannelID)
 	
 	// Deal initial cards
 	game.PlayerHand.Cards = append(game.PlayerHand.Cards, game.dealCard())
@@ -239,39 +254,47 @@ func StartBlackjackGame(s *discordgo.Session, i *discordgo.InteractionCreate, be
 		blackjackMu.Lock()
 		delete(activeBlackjackGames, userID)
 		blackjackMu.Unlock()
-		database.AddCoins(userID, bet) // Refund
+		refundStake(userID, bet)
+		game.finish()
+		return
+	}
+	if msg, err := s.InteractionResponse(i.Interaction); err == nil {
+		game.mu.Lock()
+		game.MessageID = msg.ID
+		game.mu.Unlock()
 	}
 }
 
 // Create game embed
 func (g *BlackjackGame) createGameEmbed(showDealer bool) *discordgo.MessageEmbed {
+	loc := g.Locale
 	dealerScore := "?"
 	if showDealer {
 		dealerScore = fmt.Sprintf("%d", g.DealerHand.Score)
 	}
 	
 	embed := &discordgo.MessageEmbed{
-		Title: "🃏 Blackjack",
+		Title: loc.T("🃏 Blackjack"),
 		Color: 0x2F3136,
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name:   "💰 Bet",
-				Value:  fmt.Sprintf("%d %s", g.Bet, config.Bot.CurrencySymbol),
+				Name:   loc.T("💰 Bet"),
+				Value:  fmt.Sprintf("%d %s", g.Bet, config.Bot().CurrencySymbol),
 				Inline: true,
 			},
 			{
-				Name:   "🎰 Dealer's Hand",
-				Value:  fmt.Sprintf("%s\nScore: %s", formatHand(g.DealerHand, !showDealer), dealerScore),
+				Name:   loc.T("🎰 Dealer's Hand"),
+				Value:  loc.T("%s\nScore: %s", formatHand(g.DealerHand, !showDealer), dealerScore),
 				Inline: false,
 			},
 			{
-				Name:   "🎴 Your Hand",
-				Value:  fmt.Sprintf("%s\nScore: %d", formatHand(g.PlayerHand, false), g.PlayerHand.Score),
+				Name:   loc.T("🎴 Your Hand"),
+				Value:  loc.T("%s\nScore: %d", formatHand(g.PlayerHand, false), g.PlayerHand.Score),
 				Inline: false,
 			},
 		},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: "Choose your action",
+			Text: loc.T("Choose your action"),
 		},
 	}
 	
@@ -280,15 +303,16 @@ func (g *BlackjackGame) createGameEmbed(showDealer bool) *discordgo.MessageEmbed
 
 // Create action buttons
 func (g *BlackjackGame) createActionButtons() []discordgo.MessageComponent {
+	loc := g.Locale
 	buttons := []discordgo.MessageComponent{
 		discordgo.Button{
-			Label:    "Hit",
+			Label:    loc.T("Hit"),
 			Style:    discordgo.SuccessButton,
 			CustomID: fmt.Sprintf("bj_hit_%s", g.UserID),
 			Emoji:    &discordgo.ComponentEmoji{Name: "🎯"},
 		},
 		discordgo.Button{
-			Label:    "Stand",
+			Label:    loc.T("Stand"),
 			Style:    discordgo.PrimaryButton,
 			CustomID: fmt.Sprintf("bj_stand_%s", g.UserID),
 			Emoji:    &discordgo.ComponentEmoji{Name: "✋"},
@@ -300,7 +324,7 @@ func (g *BlackjackGame) createActionButtons() []discordgo.MessageComponent {
 		balance := database.GetBalance(g.UserID)
 		if balance >= g.Bet {
 			buttons = append(buttons, discordgo.Button{
-				Label:    "Double Down",
+				Label:    loc.T("Double Down"),
 				Style:    discordgo.SecondaryButton,
 				CustomID: fmt.Sprintf("bj_double_%s", g.UserID),
 				Emoji:    &discordgo.ComponentEmoji{Name: "💎"},
@@ -314,7 +338,7 @@ func (g *BlackjackGame) createActionButtons() []discordgo.MessageComponent {
 		balance := database.GetBalance(g.UserID)
 		if balance >= insuranceAmount {
 			buttons = append(buttons, discordgo.Button{
-				Label:    "Insurance",
+				Label:    loc.T("Insurance"),
 				Style:    discordgo.SecondaryButton,
 				CustomID: fmt.Sprintf("bj_insurance_%s", g.UserID),
 				Emoji:    &discordgo.ComponentEmoji{Name: "🛡️"},
@@ -331,12 +355,13 @@ func (g *BlackjackGame) createActionButtons() []discordgo.MessageComponent {
 
 // Handle Hit action
 func HandleBlackjackHit(s *discordgo.Session, i *discordgo.InteractionCreate, userID string) {
+	loc := i18n.ForInteraction(i)
 	// Verify the user clicking is the one who started the game
 	if i.Member.User.ID != userID {
 		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
-				Content: "❌ This is not your game!",
+				Content: loc.T("❌ This is not your game!"),
 				Flags:   discordgo.MessageFlagsEphemeral,
 			},
 		})
@@ -348,12 +373,16 @@ func HandleBlackjackHit(s *discordgo.Session, i *discordgo.InteractionCreate, us
 	blackjackMu.Unlock()
 	
 	if !exists {
-		respondEmbed(s, i, utils.ErrorEmbed("No active game found!"))
+		respondEmbed(s, i, loc.ErrorEmbed("No active game found!"))
 		return
 	}
 	
 	game.mu.Lock()
 	defer game.mu.Unlock()
+	if game.finished() {
+		respondEmbed(s, i, loc.ErrorEmbed("No active game found!"))
+		return
+	}
 	
 	// Deal card to player
 	card := game.dealCard()
@@ -382,12 +411,13 @@ func HandleBlackjackHit(s *discordgo.Session, i *discordgo.InteractionCreate, us
 
 // Handle Stand action
 func HandleBlackjackStand(s *discordgo.Session, i *discordgo.InteractionCreate, userID string) {
+	loc := i18n.ForInteraction(i)
 	// Verify the user clicking is the one who started the game
 	if i.Member.User.ID != userID {
 		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
-				Content: "❌ This is not your game!",
+				Content: loc.T("❌ This is not your game!"),
 				Flags:   discordgo.MessageFlagsEphemeral,
 			},
 		})
@@ -399,12 +429,16 @@ func HandleBlackjackStand(s *discordgo.Session, i *discordgo.InteractionCreate,
 	blackjackMu.Unlock()
 	
 	if !exists {
-		respondEmbed(s, i, utils.ErrorEmbed("No active game found!"))
+		respondEmbed(s, i, loc.ErrorEmbed("No active game found!"))
 		return
 	}
 	
 	game.mu.Lock()
 	defer game.mu.Unlock()
+	if game.finished() {
+		respondEmbed(s, i, loc.ErrorEmbed("No active game found!"))
+		return
+	}
 	
 	// Dealer plays
 	game.playDealer()
@@ -413,12 +447,13 @@ func HandleBlackjackStand(s *discordgo.Session, i *discordgo.InteractionCreate,
 
 // Handle Double Down action
 func HandleBlackjackDouble(s *discordgo.Session, i *discordgo.InteractionCreate, userID string) {
+	loc := i18n.ForInteraction(i)
 	// Verify the user clicking is the one who started the game
 	if i.Member.User.ID != userID {
 		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
-				Content: "❌ This is not your game!",
+				Content: loc.T("❌ This is not your game!"),
 				Flags:   discordgo.MessageFlagsEphemeral,
 			},
 		})
@@ -430,21 +465,28 @@ func HandleBlackjackDouble(s *discordgo.Session, i *discordgo.InteractionCreate,
 	blackjackMu.Unlock()
 	
 	if !exists {
-		respondEmbed(s, i, utils.ErrorEmbed("No active game found!"))
+		respondEmbed(s, i, loc.ErrorEmbed("No active game found!"))
 		return
 	}
 	
 	game.mu.Lock()
 	defer game.mu.Unlock()
+	if game.finished() {
+		respondEmbed(s, i, loc.ErrorEmbed("No active game found!"))
+		return
+	}
 	
 	// Deduct additional bet (goes to bot)
 	balance := database.GetBalance(userID)
 	if balance < game.Bet {
-		respondEmbed(s, i, utils.ErrorEmbed("Insufficient balance to double down!"))
+		respondEmbed(s, i, loc.ErrorEmbed("Insufficient balance to double down!"))
 		return
 	}
 	
-	database.CollectLostBet(userID, game.Bet)
+	if err := collectStake(userID, game.Bet); err != nil {
+		respondEmbed(s, i, loc.ErrorEmbed(loc.Err(err)))
+		return
+	}
 	game.Bet *= 2
 	game.DoubledDown = true
 	
@@ -467,12 +509,13 @@ func HandleBlackjackDouble(s *discordgo.Session, i *discordgo.InteractionCreate,
 
 // Handle Insurance action
 func HandleBlackjackInsurance(s *discordgo.Session, i *discordgo.InteractionCreate, userID string) {
+	loc := i18n.ForInteraction(i)
 	// Verify the user clicking is the one who started the game
 	if i.Member.User.ID != userID {
 		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
-				Content: "❌ This is not your game!",
+				Content: loc.T("❌ This is not your game!"),
 				Flags:   discordgo.MessageFlagsEphemeral,
 			},
 		})
@@ -484,28 +527,35 @@ func HandleBlackjackInsurance(s *discordgo.Session, i *discordgo.InteractionCrea
 	blackjackMu.Unlock()
 	
 	if !exists {
-		respondEmbed(s, i, utils.ErrorEmbed("No active game found!"))
+		respondEmbed(s, i, loc.ErrorEmbed("No active game found!"))
 		return
 	}
 	
 	game.mu.Lock()
 	defer game.mu.Unlock()
+	if game.finished() {
+		respondEmbed(s, i, loc.ErrorEmbed("No active game found!"))
+		return
+	}
 	
 	insuranceAmount := game.Bet / 2
 	balance := database.GetBalance(userID)
 	
 	if balance < insuranceAmount {
-		respondEmbed(s, i, utils.ErrorEmbed("Insufficient balance for insurance!"))
+		respondEmbed(s, i, loc.ErrorEmbed("Insufficient balance for insurance!"))
 		return
 	}
 	
-	database.CollectLostBet(userID, insuranceAmount)
+	if err := collectStake(userID, insuranceAmount); err != nil {
+		respondEmbed(s, i, loc.ErrorEmbed(loc.Err(err)))
+		return
+	}
 	game.Insurance = true
 	game.InsuranceBet = insuranceAmount
 	
 	// Update display
 	embed := game.createGameEmbed(false)
-	embed.Footer.Text = fmt.Sprintf("Insurance purchased: %d %s", insuranceAmount, config.Bot.CurrencySymbol)
+	embed.Footer.Text = loc.T("Insurance purchased: %d %s", insuranceAmount, config.Bot().CurrencySymbol)
 	components := game.createActionButtons()
 	
 	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
@@ -517,9 +567,11 @@ func HandleBlackjackInsurance(s *discordgo.Session, i *discordgo.InteractionCrea
 	})
 }
 
-// Dealer plays according to rules (hits on 16 or less, stands on 17+)
+// Dealer plays according to rules (hits on 16 or less, stands on 17+ or
+// hits soft 17, see config.BlackjackConfig)
 func (g *BlackjackGame) playDealer() {
-	for g.DealerHand.Score < 17 {
+	hitSoft17 := blackjackRules().DealerHitsSoft17
+	for dealerShouldHit(g.DealerHand, hitSoft17) {
 		card := g.dealCard()
 		g.DealerHand.Cards = append(g.DealerHand.Cards, card)
 		calculateScore(&g.DealerHand)
@@ -539,37 +591,51 @@ func (g *BlackjackGame) playDealer() {
 
 // End game and distribute winnings
 func (g *BlackjackGame) endGame(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	embed := g.settle()
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: []discordgo.MessageComponent{}, // Remove buttons
+		},
+	})
+	g.close()
+}
+
+// settle pays the hand out according to g.Status and builds the final embed
+func (g *BlackjackGame) settle() *discordgo.MessageEmbed {
+	loc := g.Locale
 	var resultText string
 	var resultColor int
 	winnings := 0
 	
 	switch g.Status {
 	case "blackjack":
-		winnings = int(float64(g.Bet) * 2.5) // Blackjack pays 3:2
-		resultText = "🎉 **BLACKJACK!**"
+		winnings = g.Bet + int(float64(g.Bet)*blackjackRules().BlackjackPayout) // 3:2 by default
+		resultText = loc.T("🎉 **BLACKJACK!**")
 		resultColor = 0xFFD700
 		
 	case "player_win":
 		winnings = g.Bet * 2
-		resultText = "✅ **YOU WIN!**"
+		resultText = loc.T("✅ **YOU WIN!**")
 		resultColor = 0x00FF00
 		
 	case "dealer_bust":
 		winnings = g.Bet * 2
-		resultText = "💥 **DEALER BUST - YOU WIN!**"
+		resultText = loc.T("💥 **DEALER BUST - YOU WIN!**")
 		resultColor = 0x00FF00
 		
 	case "dealer_win":
-		resultText = "❌ **DEALER WINS**"
+		resultText = loc.T("❌ **DEALER WINS**")
 		resultColor = 0xFF0000
 		
 	case "player_bust":
-		resultText = "💥 **BUST - YOU LOSE**"
+		resultText = loc.T("💥 **BUST - YOU LOSE**")
 		resultColor = 0xFF0000
 		
 	case "push":
 		winnings = g.Bet
-		resultText = "🤝 **PUSH - TIE**"
+		resultText = loc.T("🤝 **PUSH - TIE**")
 		resultColor = 0xFFA500
 	}
 	
@@ -579,9 +645,9 @@ func (g *BlackjackGame) endGame(s *discordgo.Session, i *discordgo.InteractionCr
 		if isBlackjack(g.DealerHand) {
 			insurancePayout := g.InsuranceBet * 3 // Insurance pays 2:1
 			winnings += insurancePayout
-			insuranceText = fmt.Sprintf("\n🛡️ Insurance paid: +%d %s", insurancePayout, config.Bot.CurrencySymbol)
+			insuranceText = loc.T("\n🛡️ Insurance paid: +%d %s", insurancePayout, config.Bot().CurrencySymbol)
 		} else {
-			insuranceText = fmt.Sprintf("\n🛡️ Insurance lost: -%d %s", g.InsuranceBet, config.Bot.CurrencySymbol)
+			insuranceText = loc.T("\n🛡️ Insurance lost: -%d %s", g.InsuranceBet, config.Bot().CurrencySymbol)
 		}
 	}
 	
@@ -589,94 +655,132 @@ func (g *BlackjackGame) endGame(s *discordgo.Session, i *discordgo.InteractionCr
 	if winnings > 0 {
 		database.AddCoins(g.UserID, winnings)
 	}
+
+	stake := g.Bet
+	if g.Insurance {
+		stake += g.InsuranceBet
+	}
+	reportResult(g.UserID, "blackjack", stake, winnings, handDetail(g.PlayerHand)+" vs dealer "+handDetail(g.DealerHand))
 	
 	profit := winnings - g.Bet
 	profitText := ""
 	if profit > 0 {
-		profitText = fmt.Sprintf("\n💰 Profit: **+%d %s**", profit, config.Bot.CurrencySymbol)
+		profitText = loc.T("\n💰 Profit: **+%d %s**", profit, config.Bot().CurrencySymbol)
 	} else if profit < 0 {
-		profitText = fmt.Sprintf("\n💸 Loss: **%d %s**", profit, config.Bot.CurrencySymbol)
+		profitText = loc.T("\n💸 Loss: **%d %s**", profit, config.Bot().CurrencySymbol)
 	}
 	
 	newBalance := database.GetBalance(g.UserID)
 	
-	embed := &discordgo.MessageEmbed{
-		Title:       "🃏 Blackjack - Game Over",
+	return &discordgo.MessageEmbed{
+		Title:       loc.T("🃏 Blackjack - Game Over"),
 		Description: resultText + insuranceText + profitText,
 		Color:       resultColor,
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name:   "🎰 Dealer's Hand",
-				Value:  fmt.Sprintf("%s\nScore: %d", formatHand(g.DealerHand, false), g.DealerHand.Score),
+				Name:   loc.T("🎰 Dealer's Hand"),
+				Value:  loc.T("%s\nScore: %d", formatHand(g.DealerHand, false), g.DealerHand.Score),
 				Inline: false,
 			},
 			{
-				Name:   "🎴 Your Hand",
-				Value:  fmt.Sprintf("%s\nScore: %d", formatHand(g.PlayerHand, false), g.PlayerHand.Score),
+				Name:   loc.T("🎴 Your Hand"),
+				Value:  loc.T("%s\nScore: %d", formatHand(g.PlayerHand, false), g.PlayerHand.Score),
 				Inline: false,
 			},
 			{
-				Name:   "💵 Balance",
-				Value:  fmt.Sprintf("%d %s", newBalance, config.Bot.CurrencySymbol),
+				Name:   loc.T("💵 Balance"),
+				Value:  fmt.Sprintf("%d %s", newBalance, config.Bot().CurrencySymbol),
 				Inline: true,
 			},
 		},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: "Game ended",
+			Text: loc.T("Game ended"),
 		},
 		Timestamp: time.Now().Format(time.RFC3339),
 	}
-	
-	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-		Type: discordgo.InteractionResponseUpdateMessage,
-		Data: &discordgo.InteractionResponseData{
-			Embeds:     []*discordgo.MessageEmbed{embed},
-			Components: []discordgo.MessageComponent{}, // Remove buttons
-		},
-	})
-	
-	// Remove game from active games
+}
+
+// close removes the game from the active games and frees its slot
+func (g *BlackjackGame) close() {
 	blackjackMu.Lock()
 	delete(activeBlackjackGames, g.UserID)
 	blackjackMu.Unlock()
+	g.finish()
+}
+
+// expire stands on a hand left without a decision past the time limit: the
+// dealer plays it out and the game message shows the result
+func (g *BlackjackGame) expire() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.finished() {
+		return
+	}
+
+	g.playDealer()
+	embed := g.settle()
+	if g.s != nil && g.MessageID != "" {
+		embeds := []*discordgo.MessageEmbed{embed}
+		g.s.ChannelMessageEditComplex(&discordgo.MessageEdit{
+			ID:         g.MessageID,
+			Channel:    g.ChannelID,
+			Embeds:     &embeds,
+			Components: &[]discordgo.MessageComponent{},
+		})
+	}
+	g.close()
 }
 
 // StartBlackjackText starts a blackjack game from a text command
 func StartBlackjackText(s *discordgo.Session, m *discordgo.MessageCreate, bet int) {
 	userID := m.Author.ID
+	loc := i18n.ForMessage(m)
 	
 	// Check if user already has an active game
 	blackjackMu.Lock()
 	if _, exists := activeBlackjackGames[userID]; exists {
 		blackjackMu.Unlock()
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("You already have an active Blackjack game!"))
+		s.ChannelMessageSendEmbed(m.ChannelID, loc.ErrorEmbed("You already have an active Blackjack game!"))
 		return
 	}
 	blackjackMu.Unlock()
 	
 	// Validate bet
 	if bet < 10 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed(fmt.Sprintf("Minimum bet is 10 %s", config.Bot.CurrencySymbol)))
+		s.ChannelMessageSendEmbed(m.ChannelID, loc.ErrorEmbed("Minimum bet is 10 %s", config.Bot().CurrencySymbol))
 		return
 	}
 	
 	balance := database.GetBalance(userID)
 	if balance < bet {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed(fmt.Sprintf("Insufficient balance! You have %d %s", balance, config.Bot.CurrencySymbol)))
+		s.ChannelMessageSendEmbed(m.ChannelID, loc.ErrorEmbed("Insufficient balance! You have %d %s", balance, config.Bot().CurrencySymbol))
 		return
 	}
 	
-	// Deduct bet (goes to bot)
-	database.CollectLostBet(userID, bet)
-	
 	// Initialize game
 	game := &BlackjackGame{
 		UserID:    userID,
 		Bet:       bet,
-		Deck:      createDeck(),
 		Status:    "playing",
+		Locale:    loc,
 		ChannelID: m.ChannelID,
+		s:         s,
+		session:   newSession("blackjack", m.ChannelID, userID),
 	}
+
+	// Reserve a game slot before taking the bet
+	if err := Sessions.Start(game, nil); err != nil {
+		s.ChannelMessageSendEmbed(m.ChannelID, loc.ErrorEmbed(err.Error()))
+		return
+	}
+
+	// Deduct bet (goes to bot)
+	if err := collectStake(userID, bet); err != nil {
+		game.finish()
+		s.ChannelMessageSendEmbed(m.ChannelID, loc.ErrorEmbed(loc.Err(err)))
+		return
+	}
+	game.Shoe, _ = shoeFor(game.ChannelID)
 	
 	// Deal initial cards
 	game.PlayerHand.Cards = append(game.PlayerHand.Cards, game.dealCard())
@@ -712,7 +816,7 @@ func StartBlackjackText(s *discordgo.Session, m *discordgo.MessageCreate, bet in
 	
 	// Send initial game state
 	embed := game.createGameEmbed(false)
-	embed.Footer.Text = fmt.Sprintf("Use: !bj hit | !bj stand | !bj double | !bj insurance (User: %s)", m.Author.Username)
+	embed.Footer.Text = loc.T("Use: !bj hit | !bj stand | !bj double | !bj insurance (User: %s)", m.Author.Username)
 	components := game.createActionButtons()
 	
 	msg, err := s.ChannelMessageSendComplex(m.ChannelID, &discordgo.MessageSend{
@@ -725,109 +829,21 @@ func StartBlackjackText(s *discordgo.Session, m *discordgo.MessageCreate, bet in
 		blackjackMu.Lock()
 		delete(activeBlackjackGames, userID)
 		blackjackMu.Unlock()
-		database.AddCoins(userID, bet) // Refund
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Failed to start game."))
+		refundStake(userID, bet)
+		game.finish()
+		s.ChannelMessageSendEmbed(m.ChannelID, loc.ErrorEmbed("Failed to start game."))
 		return
 	}
 	
+	game.mu.Lock()
 	game.MessageID = msg.ID
+	game.mu.Unlock()
 }
 
 // endGameText ends the game for text commands
 func (g *BlackjackGame) endGameText(s *discordgo.Session, m *discordgo.MessageCreate) {
-	var resultText string
-	var resultColor int
-	winnings := 0
-	
-	switch g.Status {
-	case "blackjack":
-		winnings = int(float64(g.Bet) * 2.5) // Blackjack pays 3:2
-		resultText = "🎉 **BLACKJACK!**"
-		resultColor = 0xFFD700
-		
-	case "player_win":
-		winnings = g.Bet * 2
-		resultText = "✅ **YOU WIN!**"
-		resultColor = 0x00FF00
-		
-	case "dealer_bust":
-		winnings = g.Bet * 2
-		resultText = "💥 **DEALER BUST - YOU WIN!**"
-		resultColor = 0x00FF00
-		
-	case "dealer_win":
-		resultText = "❌ **DEALER WINS**"
-		resultColor = 0xFF0000
-		
-	case "player_bust":
-		resultText = "💥 **BUST - YOU LOSE**"
-		resultColor = 0xFF0000
-		
-	case "push":
-		winnings = g.Bet
-		resultText = "🤝 **PUSH - TIE**"
-		resultColor = 0xFFA500
-	}
-	
-	// Handle insurance payout
-	insuranceText := ""
-	if g.Insurance {
-		if isBlackjack(g.DealerHand) {
-			insurancePayout := g.InsuranceBet * 3 // Insurance pays 2:1
-			winnings += insurancePayout
-			insuranceText = fmt.Sprintf("\n🛡️ Insurance paid: +%d %s", insurancePayout, config.Bot.CurrencySymbol)
-		} else {
-			insuranceText = fmt.Sprintf("\n🛡️ Insurance lost: -%d %s", g.InsuranceBet, config.Bot.CurrencySymbol)
-		}
-	}
-	
-	// Add winnings
-	if winnings > 0 {
-		database.AddCoins(g.UserID, winnings)
-	}
-	
-	profit := winnings - g.Bet
-	profitText := ""
-	if profit > 0 {
-		profitText = fmt.Sprintf("\n💰 Profit: **+%d %s**", profit, config.Bot.CurrencySymbol)
-	} else if profit < 0 {
-		profitText = fmt.Sprintf("\n💸 Loss: **%d %s**", profit, config.Bot.CurrencySymbol)
-	}
-	
-	newBalance := database.GetBalance(g.UserID)
-	
-	embed := &discordgo.MessageEmbed{
-		Title:       "🃏 Blackjack - Game Over",
-		Description: resultText + insuranceText + profitText,
-		Color:       resultColor,
-		Fields: []*discordgo.MessageEmbedField{
-			{
-				Name:   "🎰 Dealer's Hand",
-				Value:  fmt.Sprintf("%s\nScore: %d", formatHand(g.DealerHand, false), g.DealerHand.Score),
-				Inline: false,
-			},
-			{
-				Name:   "🎴 Your Hand",
-				Value:  fmt.Sprintf("%s\nScore: %d", formatHand(g.PlayerHand, false), g.PlayerHand.Score),
-				Inline: false,
-			},
-			{
-				Name:   "💵 Balance",
-				Value:  fmt.Sprintf("%d %s", newBalance, config.Bot.CurrencySymbol),
-				Inline: true,
-			},
-		},
-		Footer: &discordgo.MessageEmbedFooter{
-			Text: "Game ended",
-		},
-	}
-	
-	s.ChannelMessageSendEmbed(m.ChannelID, embed)
-	
-	// Remove game from active games
-	blackjackMu.Lock()
-	delete(activeBlackjackGames, g.UserID)
-	blackjackMu.Unlock()
+	s.ChannelMessageSendEmbed(m.ChannelID, g.settle())
+	g.close()
 }
 
 func respondEmbed(s *discordgo.Session, i *discordgo.InteractionCreate, embed *discordgo.MessageEmbed) {
@@ -0,0 +1,133 @@
+package games
+
+import (
+	"estudocoin/pkg/config"
+	"fmt"
+	"math/rand"
+	"sync"
+)
+
+// Default table rules, used when economy.json leaves them at 0
+const (
+	defaultDecks           = 6
+	defaultPenetration     = 0.75
+	defaultBlackjackPayout = 1.5
+	defaultMaxSeats        = 5
+	defaultMaxSplits       = 3
+	defaultBettingSeconds  = 20
+	defaultTurnSeconds     = 60
+)
+
+// blackjackRules returns config.Economy().Blackjack with the defaults filled in
+func blackjackRules() config.BlackjackConfig {
+	r := config.Economy().Blackjack
+	if r.Decks <= 0 {
+		r.Decks = defaultDecks
+	}
+	if r.Penetration <= 0 || r.Penetration >= 1 {
+		r.Penetration = defaultPenetration
+	}
+	if r.BlackjackPayout <= 0 {
+		r.BlackjackPayout = defaultBlackjackPayout
+	}
+	if r.MaxSeats <= 0 {
+		r.MaxSeats = defaultMaxSeats
+	}
+	if r.MaxSplits <= 0 {
+		r.MaxSplits = defaultMaxSplits
+	}
+	if r.BettingSeconds <= 0 {
+		r.BettingSeconds = defaultBettingSeconds
+	}
+	if r.TurnSeconds <= 0 {
+		r.TurnSeconds = defaultTurnSeconds
+	}
+	return r
+}
+
+// payoutRatio renders the blackjack payout the way tables print it ("3:2")
+func payoutRatio(payout float64) string {
+	switch payout {
+	case 1.5:
+		return "3:2"
+	case 1.2:
+		return "6:5"
+	case 2:
+		return "2:1"
+	case 1:
+		return "1:1"
+	}
+	return fmt.Sprintf("%g:1", payout)
+}
+
+// dealerShouldHit reports whether the dealer draws another card. A soft
+// hand still counts an ace as 11.
+func dealerShouldHit(hand Hand, hitSoft17 bool) bool {
+	return hand.Score < 17 || (hitSoft17 && hand.Score == 17 && hand.Aces > 0)
+}
+
+// Shoe is the multi-deck shoe of a channel. Cards are dealt from it hand
+// after hand until the cut card comes out; it is then replaced before the
+// next hand starts.
+type Shoe struct {
+	cards []Card
+	next  int
+	cut   int
+	mu    sync.Mutex
+}
+
+func newShoe(decks int, penetration float64) *Shoe {
+	shoe := &Shoe{}
+	for d := 0; d < decks; d++ {
+		shoe.cards = append(shoe.cards, createDeck()...)
+	}
+	rand.Shuffle(len(shoe.cards), func(i, j int) {
+		shoe.cards[i], shoe.cards[j] = shoe.cards[j], shoe.cards[i]
+	})
+	shoe.cut = int(float64(len(shoe.cards)) * penetration)
+	return shoe
+}
+
+// draw deals the next card. A shoe that runs out in the middle of a hand
+// (many splits at a full table) is refilled on the spot.
+func (sh *Shoe) draw() Card {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	if sh.next >= len(sh.cards) {
+		rules := blackjackRules()
+		fresh := newShoe(rules.Decks, rules.Penetration)
+		sh.cards, sh.next, sh.cut = fresh.cards, 0, fresh.cut
+	}
+	card := sh.cards[sh.next]
+	sh.next++
+	return card
+}
+
+// cutCardOut reports whether the dealing passed the cut card
+func (sh *Shoe) cutCardOut() bool {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return sh.next >= sh.cut
+}
+
+var (
+	shoes   = make(map[string]*Shoe) // channelID -> shoe
+	shoesMu sync.Mutex
+)
+
+// shoeFor returns the shoe of a channel for a new hand, and whether it was
+// just shuffled (first hand, or the cut card came out)
+func shoeFor(channelID string) (*Shoe, bool) {
+	shoesMu.Lock()
+	defer shoesMu.Unlock()
+
+	shoe, exists := shoes[channelID]
+	if exists && !shoe.cutCardOut() {
+		return shoe, false
+	}
+	rules := blackjackRules()
+	shoe = newShoe(rules.Decks, rules.Penetration)
+	shoes[channelID] = shoe
+	return shoe, true
+}
@@ -0,0 +1,598 @@
+package games
+
+import (
+	"estudocoin/internal/database"
+	"estudocoin/internal/i18n"
+	"estudocoin/pkg/config"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Blackjack table: up to MaxSeats players share the dealer and the shoe of a
+// channel. Players sit down during the betting window, then play their hands
+// in turn with the buttons of the table message.
+
+const (
+	MinTableBet = 10
+
+	tableButton = "bjt_"
+)
+
+// seatHand is one hand of a seat; splitting adds more
+type seatHand struct {
+	Hand
+	Bet         int
+	FromSplit   bool
+	SplitAces   bool
+	Doubled     bool
+	Surrendered bool
+	Done        bool // stood, busted, reached 21 or can't take more cards
+	Payout      int
+}
+
+type seat struct {
+	UserID   string
+	Username string
+	Bet      int
+	Hands    []*seatHand
+	Splits   int
+}
+
+type tablePhase int
+
+const (
+	tableBetting tablePhase = iota
+	tablePlaying
+	tableDone
+)
+
+// blackjackTable is one hand played at the table of a channel
+type blackjackTable struct {
+	*session
+	s         *discordgo.Session
+	loc       i18n.Locale
+	rules     config.BlackjackConfig
+	shoe      *Shoe
+	shuffled  bool
+	messageID string
+	phase     tablePhase
+	dealAt    time.Time
+	seats     []*seat
+	dealer    Hand
+	turn      int // current seat
+	hand      int // current hand of the seat
+	timer     *time.Timer
+	turnSeq   int // bumped on every turn, so stale timers do nothing
+	mu        sync.Mutex
+}
+
+var (
+	blackjackTables   = make(map[string]*blackjackTable) // channelID -> table
+	blackjackTablesMu sync.Mutex
+)
+
+// JoinBlackjackTable sits a player at the table of the channel, opening one
+// when there is none
+func JoinBlackjackTable(s *discordgo.Session, channelID string, user *discordgo.User, bet int) error {
+	if bet < MinTableBet {
+		return i18n.Errorf("Minimum bet is %d %s", MinTableBet, config.Bot().CurrencySymbol)
+	}
+	if balance := database.GetBalance(user.ID); balance < bet {
+		return i18n.Errorf("Insufficient balance! You have %d %s", balance, config.Bot().CurrencySymbol)
+	}
+	blackjackTablesMu.Lock()
+	table, exists := blackjackTables[channelID]
+	if !exists {
+		table = &blackjackTable{
+			session: newSession("blackjack", channelID, user.ID),
+			s:       s,
+			loc:     i18n.ForChannel(s, channelID),
+			rules:   blackjackRules(),
+		}
+		// Held until the first player sits, so the table opens with them
+		table.mu.Lock()
+		if err := Sessions.Start(table, table.open); err != nil {
+			table.mu.Unlock()
+			blackjackTablesMu.Unlock()
+			return err
+		}
+		blackjackTables[channelID] = table
+		blackjackTablesMu.Unlock()
+	} else {
+		blackjackTablesMu.Unlock()
+		table.mu.Lock()
+	}
+	defer table.mu.Unlock()
+
+	if table.phase != tableBetting {
+		return i18n.Errorf("A hand is being played at this table. Sit down when it ends.")
+	}
+	for _, st := range table.seats {
+		if st.UserID == user.ID {
+			return i18n.Errorf("You already have a seat at this table.")
+		}
+	}
+	if len(table.seats) >= table.rules.MaxSeats {
+		return i18n.Errorf("The table is full.")
+	}
+	if exists {
+		if err := Sessions.Join(table, user.ID); err != nil {
+			return err
+		}
+	}
+	if err := collectStake(user.ID, bet); err != nil {
+		// An empty table is closed by open once it gets the lock
+		Sessions.Leave(table, user.ID)
+		return err
+	}
+	table.seats = append(table.seats, &seat{UserID: user.ID, Username: user.Username, Bet: bet})
+
+	// The first player's seat is posted by open
+	if table.messageID != "" {
+		table.update(nil)
+	}
+	return nil
+}
+
+// open posts the table, waits for the betting window and deals
+func (t *blackjackTable) open() {
+	t.mu.Lock()
+	if len(t.seats) == 0 {
+		t.mu.Unlock()
+		t.close()
+		return
+	}
+	t.dealAt = time.Now().Add(time.Duration(t.rules.BettingSeconds) * time.Second)
+	embed := t.embed()
+	t.mu.Unlock()
+
+	msg, err := t.s.ChannelMessageSendEmbed(t.channelID, embed)
+	if err != nil {
+		log.Printf("[BLACKJACK ERROR] Could not post the table of %s, refunding: %v", t.channelID, err)
+		t.mu.Lock()
+		t.phase = tableDone
+		for _, st := range t.seats {
+			refundStake(st.UserID, st.Bet)
+		}
+		t.mu.Unlock()
+		t.close()
+		return
+	}
+	t.mu.Lock()
+	t.messageID = msg.ID
+	t.mu.Unlock()
+
+	time.Sleep(time.Until(t.dealAt))
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.deal()
+	t.update(nil)
+}
+
+// deal gives two cards to every seat and the dealer. Caller holds t.mu.
+func (t *blackjackTable) deal() {
+	t.phase = tablePlaying
+	t.shoe, t.shuffled = shoeFor(t.channelID)
+
+	for _, st := range t.seats {
+		st.Hands = []*seatHand{{Bet: st.Bet}}
+	}
+	for round := 0; round < 2; round++ {
+		for _, st := range t.seats {
+			st.Hands[0].Cards = append(st.Hands[0].Cards, t.shoe.draw())
+		}
+		t.dealer.Cards = append(t.dealer.Cards, t.shoe.draw())
+	}
+	calculateScore(&t.dealer)
+	for _, st := range t.seats {
+		calculateScore(&st.Hands[0].Hand)
+		st.Hands[0].Done = st.Hands[0].Score == 21
+	}
+
+	// The dealer peeks under an ace or a ten: a dealer blackjack ends the hand
+	if t.dealer.Cards[1].Score >= 10 && isBlackjack(t.dealer) {
+		t.settle()
+		return
+	}
+	t.advance()
+}
+
+// current returns the seat and hand whose turn it is. Caller holds t.mu.
+func (t *blackjackTable) current() (*seat, *seatHand) {
+	st := t.seats[t.turn]
+	return st, st.Hands[t.hand]
+}
+
+// advance moves the turn to the next hand still in play, or lets the dealer
+// play when every hand is done. Caller holds t.mu.
+func (t *blackjackTable) advance() {
+	for ; t.turn < len(t.seats); t.turn, t.hand = t.turn+1, 0 {
+		for ; t.hand < len(t.seats[t.turn].Hands); t.hand++ {
+			if !t.seats[t.turn].Hands[t.hand].Done {
+				t.armTimer()
+				return
+			}
+		}
+	}
+	t.playDealer()
+	t.settle()
+}
+
+// armTimer stands the current hand if its player doesn't act in time.
+// Caller holds t.mu.
+func (t *blackjackTable) armTimer() {
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+	t.turnSeq++
+	seq := t.turnSeq
+	t.timer = time.AfterFunc(time.Duration(t.rules.TurnSeconds)*time.Second, func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if t.phase != tablePlaying || t.turnSeq != seq {
+			return
+		}
+		_, h := t.current()
+		h.Done = true
+		t.advance()
+		t.update(nil)
+	})
+}
+
+func (t *blackjackTable) canHit(h *seatHand) bool {
+	return !h.SplitAces || t.rules.HitSplitAces
+}
+
+func (t *blackjackTable) canDouble(st *seat, h *seatHand) bool {
+	return len(h.Cards) == 2 && t.canHit(h) && database.GetBalance(st.UserID) >= h.Bet
+}
+
+func (t *blackjackTable) canSplit(st *seat, h *seatHand) bool {
+	if len(h.Cards) != 2 || h.Cards[0].Score != h.Cards[1].Score || st.Splits >= t.rules.MaxSplits {
+		return false
+	}
+	if h.SplitAces && !t.rules.ResplitAces {
+		return false
+	}
+	return database.GetBalance(st.UserID) >= h.Bet
+}
+
+func (t *blackjackTable) canSurrender(st *seat, h *seatHand) bool {
+	return t.rules.Surrender && len(st.Hands) == 1 && len(h.Cards) == 2
+}
+
+// isNatural reports whether a hand is a blackjack; 21 after a split is not
+func isNatural(h *seatHand) bool {
+	return !h.FromSplit && isBlackjack(h.Hand)
+}
+
+// draw deals a card to a hand and closes it at 21 or more. Caller holds t.mu.
+func (t *blackjackTable) draw(h *seatHand) {
+	h.Cards = append(h.Cards, t.shoe.draw())
+	calculateScore(&h.Hand)
+	if h.Score >= 21 {
+		h.Done = true
+	}
+}
+
+// split turns the current pair into two hands with one more card each,
+// taking the second stake (the error says why it could not).
+// Split aces get a single card unless the rules allow hitting them.
+// Caller holds t.mu.
+func (t *blackjackTable) split(st *seat, h *seatHand) error {
+	if err := collectStake(st.UserID, h.Bet); err != nil {
+		return err
+	}
+	st.Splits++
+
+	aces := h.Cards[0].Value == "A"
+	second := &seatHand{Hand: Hand{Cards: []Card{h.Cards[1]}}, Bet: h.Bet, FromSplit: true, SplitAces: aces}
+	h.Cards = h.Cards[:1]
+	h.FromSplit, h.SplitAces = true, aces
+
+	st.Hands = append(st.Hands[:t.hand+1], append([]*seatHand{second}, st.Hands[t.hand+1:]...)...)
+
+	for _, sh := range []*seatHand{h, second} {
+		t.draw(sh)
+		if aces && !t.rules.HitSplitAces && !t.canSplit(st, sh) {
+			sh.Done = true
+		}
+	}
+	return nil
+}
+
+// playDealer draws the dealer's cards, unless every hand is already lost.
+// Caller holds t.mu.
+func (t *blackjackTable) playDealer() {
+	live := false
+	for _, st := range t.seats {
+		for _, h := range st.Hands {
+			if !h.Surrendered && h.Score <= 21 && !isNatural(h) {
+				live = true
+			}
+		}
+	}
+	for live && dealerShouldHit(t.dealer, t.rules.DealerHitsSoft17) {
+		t.dealer.Cards = append(t.dealer.Cards, t.shoe.draw())
+		calculateScore(&t.dealer)
+	}
+}
+
+// settle pays every hand and closes the table. Caller holds t.mu.
+func (t *blackjackTable) settle() {
+	t.phase = tableDone
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+	dealerBJ := isBlackjack(t.dealer)
+
+	for _, st := range t.seats {
+		stake, payout := 0, 0
+		var hands []string
+		for _, h := range st.Hands {
+			stake += h.Bet
+			hands = append(hands, handDetail(h.Hand))
+			switch {
+			case h.Surrendered:
+				h.Payout = h.Bet / 2
+			case h.Score > 21:
+				h.Payout = 0
+			case isNatural(h) && dealerBJ:
+				h.Payout = h.Bet
+			case isNatural(h):
+				h.Payout = h.Bet + int(float64(h.Bet)*t.rules.BlackjackPayout)
+			case dealerBJ:
+				h.Payout = 0
+			case t.dealer.Score > 21 || h.Score > t.dealer.Score:
+				h.Payout = h.Bet * 2
+			case h.Score == t.dealer.Score:
+				h.Payout = h.Bet
+			}
+			payout += h.Payout
+		}
+		if payout > 0 {
+			database.AddCoins(st.UserID, payout)
+		}
+		reportResult(st.UserID, "blackjack", stake, payout, strings.Join(hands, " / ")+" vs dealer "+handDetail(t.dealer))
+	}
+
+	go t.close()
+}
+
+// close frees the table of the channel
+func (t *blackjackTable) close() {
+	blackjackTablesMu.Lock()
+	if blackjackTables[t.channelID] == t {
+		delete(blackjackTables, t.channelID)
+	}
+	blackjackTablesMu.Unlock()
+	t.finish()
+}
+
+// HandleBlackjackTableButton plays the action of a table button
+// (bjt_<action>_<channelID>) for the player whose turn it is
+func HandleBlackjackTableButton(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	loc := i18n.ForInteraction(i)
+	parts := strings.SplitN(strings.TrimPrefix(i.MessageComponentData().CustomID, tableButton), "_", 2)
+	if len(parts) != 2 {
+		return
+	}
+	action, channelID := parts[0], parts[1]
+
+	blackjackTablesMu.Lock()
+	table, exists := blackjackTables[channelID]
+	blackjackTablesMu.Unlock()
+	if !exists {
+		respondEmbed(s, i, loc.ErrorEmbed("No active game found!"))
+		return
+	}
+
+	table.mu.Lock()
+	defer table.mu.Unlock()
+
+	if table.phase != tablePlaying {
+		respondEmbed(s, i, loc.ErrorEmbed("No active game found!"))
+		return
+	}
+	st, h := table.current()
+	if st.UserID != i.Member.User.ID {
+		respondEmbed(s, i, loc.ErrorEmbed("It's not your turn."))
+		return
+	}
+
+	switch action {
+	case "hit":
+		if !table.canHit(h) {
+			return
+		}
+		table.draw(h)
+	case "stand":
+		h.Done = true
+	case "double":
+		if !table.canDouble(st, h) {
+			respondEmbed(s, i, loc.ErrorEmbed("Insufficient balance to double down!"))
+			return
+		}
+		if err := collectStake(st.UserID, h.Bet); err != nil {
+			respondEmbed(s, i, loc.ErrorEmbed(loc.Err(err)))
+			return
+		}
+		h.Bet *= 2
+		h.Doubled = true
+		table.draw(h)
+		h.Done = true
+	case "split":
+		if !table.canSplit(st, h) {
+			respondEmbed(s, i, loc.ErrorEmbed("You can't split this hand."))
+			return
+		}
+		if err := table.split(st, h); err != nil {
+			respondEmbed(s, i, loc.ErrorEmbed(loc.Err(err)))
+			return
+		}
+	case "surrender":
+		if !table.canSurrender(st, h) {
+			return
+		}
+		h.Surrendered = true
+		h.Done = true
+	default:
+		return
+	}
+
+	table.advance()
+	table.update(i)
+}
+
+// update shows the table, answering the click when there is one.
+// Caller holds t.mu.
+func (t *blackjackTable) update(i *discordgo.InteractionCreate) {
+	embeds := []*discordgo.MessageEmbed{t.embed()}
+	components := t.components()
+
+	if i != nil {
+		t.s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{Embeds: embeds, Components: components},
+		})
+		return
+	}
+	t.s.ChannelMessageEditComplex(&discordgo.MessageEdit{
+		ID:         t.messageID,
+		Channel:    t.channelID,
+		Embeds:     &embeds,
+		Components: &components,
+	})
+}
+
+// embed renders the table. Caller holds t.mu.
+func (t *blackjackTable) embed() *discordgo.MessageEmbed {
+	loc := t.loc
+	soft17 := loc.T("Dealer stands on soft 17")
+	if t.rules.DealerHitsSoft17 {
+		soft17 = loc.T("Dealer hits soft 17")
+	}
+	embed := &discordgo.MessageEmbed{
+		Title: loc.T("🃏 Blackjack Table"),
+		Color: 0x2F3136,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: loc.T("%d decks | Blackjack pays %s | %s", t.rules.Decks, payoutRatio(t.rules.BlackjackPayout), soft17),
+		},
+	}
+
+	switch t.phase {
+	case tableBetting:
+		embed.Description = loc.T("Dealing <t:%d:R>. Sit down with `/bet table` or `!bet table <amount>` (%d/%d seats).", t.dealAt.Unix(), len(t.seats), t.rules.MaxSeats)
+		embed.Color = 0xFFD700
+	case tablePlaying:
+		st, _ := t.current()
+		embed.Description = loc.T("<@%s>, it's your turn!", st.UserID)
+		if len(st.Hands) > 1 {
+			embed.Description += " " + loc.T("(hand %d of %d)", t.hand+1, len(st.Hands))
+		}
+		if t.shuffled {
+			embed.Description += "\n" + loc.T("🔀 A new shoe was shuffled.")
+		}
+	case tableDone:
+		embed.Description = loc.T("Hand over. Use `/bet table` to play again.")
+		embed.Color = 0x00FF00
+	}
+
+	if t.phase != tableBetting {
+		hidden := t.phase == tablePlaying
+		score := fmt.Sprintf("%d", t.dealer.Score)
+		if hidden {
+			score = "?"
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  loc.T("🎰 Dealer's Hand"),
+			Value: loc.T("%s\nScore: %s", formatHand(t.dealer, hidden), score),
+		})
+	}
+
+	for n, st := range t.seats {
+		var lines []string
+		if len(st.Hands) == 0 {
+			lines = append(lines, loc.T("Bet: %d %s", st.Bet, config.Bot().CurrencySymbol))
+		}
+		for h, hand := range st.Hands {
+			marker := "▫️"
+			if t.phase == tablePlaying && n == t.turn && h == t.hand {
+				marker = "▶️"
+			}
+			lines = append(lines, fmt.Sprintf("%s %s (%d) - %d %s%s", marker, formatHand(hand.Hand, false), hand.Score, hand.Bet, config.Bot().CurrencySymbol, t.handStatus(hand)))
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  fmt.Sprintf("💺 %s", st.Username),
+			Value: strings.Join(lines, "\n"),
+		})
+	}
+	return embed
+}
+
+// handStatus is the note shown next to a hand. Caller holds t.mu.
+func (t *blackjackTable) handStatus(h *seatHand) string {
+	loc := t.loc
+	var notes []string
+	switch {
+	case h.Surrendered:
+		notes = append(notes, loc.T("surrendered"))
+	case h.Score > 21:
+		notes = append(notes, loc.T("bust"))
+	case isNatural(h):
+		notes = append(notes, loc.T("blackjack!"))
+	}
+	if h.Doubled {
+		notes = append(notes, loc.T("doubled"))
+	}
+	if t.phase == tableDone {
+		if profit := h.Payout - h.Bet; profit >= 0 {
+			notes = append(notes, fmt.Sprintf("+%d", profit))
+		} else {
+			notes = append(notes, fmt.Sprintf("%d", profit))
+		}
+	}
+	if len(notes) == 0 {
+		return ""
+	}
+	return " | " + strings.Join(notes, ", ")
+}
+
+// components are the action buttons of the current hand. Caller holds t.mu.
+func (t *blackjackTable) components() []discordgo.MessageComponent {
+	if t.phase != tablePlaying {
+		return []discordgo.MessageComponent{}
+	}
+	loc := t.loc
+	st, h := t.current()
+	button := func(action, label, emoji string, style discordgo.ButtonStyle) discordgo.Button {
+		return discordgo.Button{
+			Label:    label,
+			Style:    style,
+			CustomID: tableButton + action + "_" + t.channelID,
+			Emoji:    &discordgo.ComponentEmoji{Name: emoji},
+		}
+	}
+
+	var buttons []discordgo.MessageComponent
+	if t.canHit(h) {
+		buttons = append(buttons, button("hit", loc.T("Hit"), "🎯", discordgo.SuccessButton))
+	}
+	buttons = append(buttons, button("stand", loc.T("Stand"), "✋", discordgo.PrimaryButton))
+	if t.canDouble(st, h) {
+		buttons = append(buttons, button("double", loc.T("Double Down"), "💎", discordgo.SecondaryButton))
+	}
+	if t.canSplit(st, h) {
+		buttons = append(buttons, button("split", loc.T("Split"), "✂️", discordgo.SecondaryButton))
+	}
+	if t.canSurrender(st, h) {
+		buttons = append(buttons, button("surrender", loc.T("Surrender"), "🏳️", discordgo.DangerButton))
+	}
+	return []discordgo.MessageComponent{discordgo.ActionsRow{Components: buttons}}
+}
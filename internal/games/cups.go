@@ -2,6 +2,7 @@ package games
 
 import (
 	"estudocoin/internal/database"
+	"estudocoin/internal/i18n"
 	"estudocoin/pkg/config"
 	"estudocoin/pkg/utils"
 	"fmt"
@@ -16,6 +17,11 @@ import (
 
 const MinCupBet = 50
 
+// cupGame is the session of one Cup Game
+type cupGame struct {
+	*session
+}
+
 // Map to send interactions (button clicks) to the running game loop
 var (
 	activeCupGames = make(map[string]chan *discordgo.InteractionCreate)
@@ -25,7 +31,7 @@ var (
 // --- ENTRY POINTS ---
 
 func StartCupGameInteraction(s *discordgo.Session, i *discordgo.InteractionCreate, bet int) {
-	startCupGame(s, i.Member.User.ID, bet, i.ChannelID, func(msg *discordgo.MessageSend) {
+	startCupGame(s, i18n.ForInteraction(i), i.Member.User.ID, bet, i.ChannelID, func(msg *discordgo.MessageSend) {
 		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
@@ -38,43 +44,47 @@ func StartCupGameInteraction(s *discordgo.Session, i *discordgo.InteractionCreat
 }
 
 func StartCupGameText(s *discordgo.Session, m *discordgo.MessageCreate, bet int) {
-	startCupGame(s, m.Author.ID, bet, m.ChannelID, func(msg *discordgo.MessageSend) {
+	startCupGame(s, i18n.ForMessage(m), m.Author.ID, bet, m.ChannelID, func(msg *discordgo.MessageSend) {
 		s.ChannelMessageSendComplex(m.ChannelID, msg)
 	})
 }
 
 // --- CORE LOGIC ---
 
-func startCupGame(s *discordgo.Session, userID string, bet int, channelID string, initialResponder func(*discordgo.MessageSend)) {
+func startCupGame(s *discordgo.Session, loc i18n.Locale, userID string, bet int, channelID string, initialResponder func(*discordgo.MessageSend)) {
 	// Validation
 	if bet < MinCupBet {
 		// Use a simpler direct response for errors pre-queue
-		s.ChannelMessageSend(channelID, fmt.Sprintf("❌ Minimum bet is %d %s", MinCupBet, config.Bot.CurrencySymbol))
+		s.ChannelMessageSend(channelID, loc.T("❌ Minimum bet is %d %s", MinCupBet, config.Bot().CurrencySymbol))
 		return
 	}
 	if database.GetBalance(userID) < bet {
-		s.ChannelMessageSend(channelID, "❌ Insufficient funds.")
+		s.ChannelMessageSend(channelID, loc.T("❌ Insufficient funds."))
 		return
 	}
 
 	// Queue Job
+	game := &cupGame{session: newSession("cups", channelID, userID)}
 	job := GameJob{
-		UserID: userID,
+		Session: game,
 		OnQueue: func(pos int) {
-			s.ChannelMessageSend(channelID, fmt.Sprintf("⏳ <@%s> Queued for Cup Game (Pos: #%d)", userID, pos))
+			s.ChannelMessageSend(channelID, loc.T("⏳ <@%s> Queued for Cup Game (Pos: #%d)", userID, pos))
 		},
-		Run: func(finishChan chan struct{}) {
-			defer close(finishChan)
+		Run: func() {
+			defer game.finish()
 			defer cleanupCup(userID)
 
 			// Re-check funds
 			if database.GetBalance(userID) < bet {
-				s.ChannelMessageSend(channelID, fmt.Sprintf("❌ <@%s> You ran out of funds while waiting.", userID))
+				s.ChannelMessageSend(channelID, loc.T("❌ <@%s> You ran out of funds while waiting.", userID))
 				return
 			}
 
 			// Deduct initial bet (goes to bot)
-			database.CollectLostBet(userID, bet)
+			if err := collectStake(userID, bet); err != nil {
+				s.ChannelMessageSend(channelID, fmt.Sprintf("❌ <@%s> %s", userID, loc.Err(err)))
+				return
+			}
 
 			// Setup Input Channel
 			gameChan := make(chan *discordgo.InteractionCreate) // Unbuffered block
@@ -93,8 +103,8 @@ func startCupGame(s *discordgo.Session, userID string, bet int, channelID string
 
 				// Prepare UI
 				embed := utils.NewEmbed()
-				embed.Title = fmt.Sprintf("🥤 Cup Game - Round %d", round)
-				embed.Description = fmt.Sprintf("Current Pot: **%d %s**\n\n**Guess where the coin is!**", currentPot, config.Bot.CurrencySymbol)
+				embed.Title = loc.T("🥤 Cup Game - Round %d", round)
+				embed.Description = loc.T("Current Pot: **%d %s**\n\n**Guess where the coin is!**", currentPot, config.Bot().CurrencySymbol)
 			embed.Color = utils.ColorGold
 				
 				// Buttons 1-6
@@ -106,7 +116,7 @@ rows := []discordgo.MessageComponent{
 
 				// Send or Edit
 				msgSend := &discordgo.MessageSend{
-					Content:    fmt.Sprintf("<@%s> It's your turn!", userID),
+					Content:    loc.T("<@%s> It's your turn!", userID),
 					Embeds:     []*discordgo.MessageEmbed{embed},
 					Components: rows,
 				}
@@ -150,7 +160,8 @@ rows := []discordgo.MessageComponent{
 					})
 				case <-time.After(2 * time.Minute):
 					// Timeout
-					s.ChannelMessageEdit(channelID, gameMsgID, "⏰ Game timed out. You lost your bet.")
+					s.ChannelMessageEdit(channelID, gameMsgID, loc.T("⏰ Game timed out. You lost your bet."))
+					reportResult(userID, "cups", bet, 0, fmt.Sprintf("timed out in round %d", round))
 					return
 				}
 
@@ -164,27 +175,27 @@ rows := []discordgo.MessageComponent{
 					}
 					
 					// Ask to Continue
-					embed.Title = "✅ CORRECT!"
+					embed.Title = loc.T("✅ CORRECT!")
 					nextMultiplier := 2
 					if round == 1 {
 						nextMultiplier = 10
 					}
-					embed.Description = fmt.Sprintf("The coin was in **Cup %d**.\n\nYou have **%d %s**.\n\nDo you want to **Cash Out** or continue for **%dx**?", winningCup, currentPot, config.Bot.CurrencySymbol, nextMultiplier)
+					embed.Description = loc.T("The coin was in **Cup %d**.\n\nYou have **%d %s**.\n\nDo you want to **Cash Out** or continue for **%dx**?", winningCup, currentPot, config.Bot().CurrencySymbol, nextMultiplier)
 					embed.Color = utils.ColorGreen
 
 					actionRow := discordgo.ActionsRow{
 						Components: []discordgo.MessageComponent{
 							discordgo.Button{
-								Label: "💰 Cash Out",
+								Label: loc.T("💰 Cash Out"),
 								Style: discordgo.SuccessButton,
 								CustomID: fmt.Sprintf("cup_cashout_%s", userID),
 							},
 							discordgo.Button{
 								Label: func() string {
 								if round == 1 {
-									return "🎲 Continue (10x or Nothing)"
+									return loc.T("🎲 Continue (10x or Nothing)")
 								}
-								return "🎲 Continue (Double or Nothing)"
+								return loc.T("🎲 Continue (Double or Nothing)")
 							}(),
 								Style: discordgo.PrimaryButton,
 								CustomID: fmt.Sprintf("cup_continue_%s", userID),
@@ -211,7 +222,8 @@ rows := []discordgo.MessageComponent{
 						if strings.Contains(id, "cashout") {
 							// Cash Out
 							database.AddCoins(userID, currentPot)
-							s.ChannelMessageEdit(channelID, gameMsgID, fmt.Sprintf("🎉 **Congratulatios!**\n<@%s> walked away with **%d %s**!", userID, currentPot, config.Bot.CurrencySymbol))
+							reportResult(userID, "cups", bet, currentPot, fmt.Sprintf("cashed out after round %d", round))
+							s.ChannelMessageEdit(channelID, gameMsgID, loc.T("🎉 **Congratulations!**\n<@%s> walked away with **%d %s**!", userID, currentPot, config.Bot().CurrencySymbol))
 							return
 						}
 						// Continue -> Loop repeats with new round
@@ -220,14 +232,15 @@ rows := []discordgo.MessageComponent{
 					case <-time.After(1 * time.Minute):
 						// Auto Cashout on timeout
 						database.AddCoins(userID, currentPot)
-						s.ChannelMessageSend(channelID, fmt.Sprintf("⏰ Timeout. Auto-cashing out **%d %s**.", currentPot, config.Bot.CurrencySymbol))
+						reportResult(userID, "cups", bet, currentPot, fmt.Sprintf("auto cash-out after round %d", round))
+						s.ChannelMessageSend(channelID, loc.T("⏰ Timeout. Auto-cashing out **%d %s**.", currentPot, config.Bot().CurrencySymbol))
 						return
 					}
 
 				} else {
 					// LOSE
-					embed.Title = "❌ WRONG!"
-					embed.Description = fmt.Sprintf("You picked Cup %d, but the coin was in **Cup %d**.\n\n📉 You lost **%d %s**.", choice, winningCup, bet, config.Bot.CurrencySymbol)
+					embed.Title = loc.T("❌ WRONG!")
+					embed.Description = loc.T("You picked Cup %d, but the coin was in **Cup %d**.\n\n📉 You lost **%d %s**.", choice, winningCup, bet, config.Bot().CurrencySymbol)
 					embed.Color = utils.ColorRed
 					
 					// Disable everything
@@ -238,19 +251,23 @@ rows := []discordgo.MessageComponent{
 						Embeds: &embeds,
 						Components: &[]discordgo.MessageComponent{}, // No buttons
 					})
+					reportResult(userID, "cups", bet, 0, fmt.Sprintf("picked cup %d, coin in cup %d (round %d)", choice, winningCup, round))
 					return
 				}
 			}
 		},
 	}
 
-	Enqueue(job)
+	if err := Sessions.Enqueue(job); err != nil {
+		s.ChannelMessageSend(channelID, "❌ "+loc.T(err.Error()))
+	}
 }
 
 // --- HELPERS ---
 
 func HandleCupInteraction(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	userID := i.Member.User.ID
+	loc := i18n.ForInteraction(i)
 	cupMutex.Lock()
 	ch, exists := activeCupGames[userID]
 	cupMutex.Unlock()
@@ -258,7 +275,7 @@ func HandleCupInteraction(s *discordgo.Session, i *discordgo.InteractionCreate)
 	if !exists {
 		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
-			Data: &discordgo.InteractionResponseData{Content: "⚠️ This is not your game.", Flags: discordgo.MessageFlagsEphemeral},
+			Data: &discordgo.InteractionResponseData{Content: loc.T("⚠️ This is not your game."), Flags: discordgo.MessageFlagsEphemeral},
 		})
 		return
 	}
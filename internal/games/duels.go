@@ -0,0 +1,361 @@
+package games
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"strings"
+	"time"
+
+	"estudocoin/internal/database"
+	"estudocoin/internal/i18n"
+	"estudocoin/pkg/config"
+	"estudocoin/pkg/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Duel games. They reuse the Russian Roulette challenge flow: a pending
+// challenge waits ChallengeTimeout for an answer and both stakes are only
+// taken once it is accepted.
+const (
+	DuelCoinflip = "coinflip"
+	DuelDice     = "dice"
+
+	DefaultDiceSides = 6
+	MaxDiceSides     = 100
+)
+
+// DuelRules returns the duel config with defaults for missing values
+func DuelRules() config.DuelConfig {
+	rules := config.Economy().Duels
+	if rules.MinBet <= 0 {
+		rules.MinBet = 10
+	}
+	if rules.HouseCutPercent < 0 || rules.HouseCutPercent >= 100 {
+		rules.HouseCutPercent = 0
+	}
+	return rules
+}
+
+// DuelHelpEmbed explains how to start a duel of the given game
+func DuelHelpEmbed(loc i18n.Locale, game string) *discordgo.MessageEmbed {
+	if game == DuelDice {
+		return utils.InfoEmbed(loc.T("🎲 Dice Duel"), loc.T("Usage: `!dice @user <amount> [sides]`\n\nBoth players roll a die (%d sides by default, up to %d) and the highest roll takes the pot. Ties are re-rolled.\n\n`!dice open <amount> [sides]` lets anyone in the channel accept.", DefaultDiceSides, MaxDiceSides))
+	}
+	return utils.InfoEmbed(loc.T("🪙 Coinflip Duel"), loc.T("Usage: `!coinflip @user <amount>`\n\nThe challenger is heads, the opponent is tails. Winner takes the pot.\n\n`!coinflip open <amount>` lets anyone in the channel accept."))
+}
+
+// ChallengeDuel posts a coinflip or dice challenge in the channel. A nil
+// challenged opens it to anyone in the channel; sides 0 is the default die.
+func ChallengeDuel(s *discordgo.Session, guildID, channelID, challengerID string, challenged *discordgo.User, game string, amount, sides int) error {
+	rules := DuelRules()
+
+	if amount < rules.MinBet {
+		return i18n.Errorf("Minimum bet is %d %s", rules.MinBet, config.Bot().CurrencySymbol)
+	}
+
+	if game == DuelDice {
+		if sides == 0 {
+			sides = DefaultDiceSides
+		}
+		if sides < 2 || sides > MaxDiceSides {
+			return i18n.Errorf("The die must have between 2 and %d sides.", MaxDiceSides)
+		}
+	} else {
+		sides = 0
+	}
+
+	open := challenged == nil
+	challengedID := ""
+	key := openChallengeKey(challengerID)
+	if !open {
+		challengedID = challenged.ID
+		if challengedID == challengerID {
+			return i18n.Errorf("You cannot challenge yourself!")
+		}
+		if challenged.Bot {
+			return i18n.Errorf("Invalid user or bot.")
+		}
+		if _, err := s.GuildMember(guildID, challengedID); err != nil {
+			return i18n.Errorf("Invalid user or bot.")
+		}
+		key = challengedID
+	}
+
+	challengerBalance := database.GetBalance(challengerID)
+	if challengerBalance < amount {
+		return i18n.Errorf("Insufficient balance! You have %d %s", challengerBalance, config.Bot().CurrencySymbol)
+	}
+
+	challenge := &Challenge{
+		ChallengerID: challengerID,
+		ChallengedID: challengedID,
+		Game:         game,
+		Sides:        sides,
+		Bet:          amount,
+		ChannelID:    channelID,
+		Locale:       i18n.ForGuild(guildID),
+	}
+
+	pendingMu.Lock()
+	if _, exists := pendingChallenges[key]; exists {
+		pendingMu.Unlock()
+		if open {
+			return i18n.Errorf("You already have an open challenge!")
+		}
+		return i18n.Errorf("This user already has a pending challenge!")
+	}
+	challenge.TimeoutTimer = time.AfterFunc(ChallengeTimeout, func() {
+		expireChallenge(s, key)
+	})
+	pendingChallenges[key] = challenge
+	pendingMu.Unlock()
+
+	// The challenge is public, so it is shown in the server language
+	loc := challenge.Locale
+	description := loc.T("<@%s> challenged <@%s> to a duel!", challengerID, challengedID)
+	declineLabel := loc.T("❌ Decline")
+	if open {
+		description = loc.T("<@%s> opened a duel! Anyone in the channel can accept.", challengerID)
+		declineLabel = loc.T("❌ Cancel")
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       duelTitle(loc, challenge),
+		Description: description,
+		Color:       utils.ColorGold,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   loc.T("💰 Bet"),
+				Value:  fmt.Sprintf("%d %s", amount, config.Bot().CurrencySymbol),
+				Inline: true,
+			},
+			{
+				Name:   loc.T("⏱️ Time"),
+				Value:  loc.T("%d seconds to accept", int(ChallengeTimeout.Seconds())),
+				Inline: true,
+			},
+		},
+	}
+	if rules.HouseCutPercent > 0 {
+		embed.Footer = &discordgo.MessageEmbedFooter{Text: loc.T("House cut: %.0f%% of the pot", rules.HouseCutPercent)}
+	}
+
+	buttons := []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    loc.T("✅ Accept"),
+					Style:    discordgo.SuccessButton,
+					CustomID: "duel_accept_" + key,
+				},
+				discordgo.Button{
+					Label:    declineLabel,
+					Style:    discordgo.DangerButton,
+					CustomID: "duel_decline_" + key,
+				},
+			},
+		},
+	}
+
+	if _, err := s.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Embeds:     []*discordgo.MessageEmbed{embed},
+		Components: buttons,
+	}); err != nil {
+		log.Printf("[DUEL ERROR] Could not post the challenge of %s: %v", challengerID, err)
+	}
+	return nil
+}
+
+// duelTitle is the embed title of a duel
+func duelTitle(loc i18n.Locale, c *Challenge) string {
+	if c.Game == DuelDice {
+		return loc.T("🎲 Dice Duel (d%d)", c.Sides)
+	}
+	return loc.T("🪙 Coinflip Duel")
+}
+
+// HandleDuelInteraction routes the duel_ buttons
+func HandleDuelInteraction(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	customID := i.MessageComponentData().CustomID
+
+	if strings.HasPrefix(customID, "duel_accept_") {
+		handleDuelAccept(s, i, strings.TrimPrefix(customID, "duel_accept_"))
+	} else if strings.HasPrefix(customID, "duel_decline_") {
+		handleDuelDecline(s, i, strings.TrimPrefix(customID, "duel_decline_"))
+	}
+}
+
+func handleDuelAccept(s *discordgo.Session, i *discordgo.InteractionCreate, key string) {
+	loc := i18n.ForInteraction(i)
+	userID := i.Member.User.ID
+
+	pendingMu.Lock()
+	challenge, exists := pendingChallenges[key]
+	if !exists || challenge.Game == "" {
+		pendingMu.Unlock()
+		respondPrivate(s, i, loc.ErrorEmbed("No pending challenge found!"))
+		return
+	}
+	open := challenge.ChallengedID == ""
+	if !open && userID != challenge.ChallengedID {
+		pendingMu.Unlock()
+		respondPrivate(s, i, loc.ErrorEmbed("This challenge is not for you!"))
+		return
+	}
+	if userID == challenge.ChallengerID {
+		pendingMu.Unlock()
+		respondPrivate(s, i, loc.ErrorEmbed("You cannot accept your own challenge!"))
+		return
+	}
+	if balance := database.GetBalance(userID); open && balance < challenge.Bet {
+		// The open challenge stays up for someone who can cover it
+		pendingMu.Unlock()
+		respondPrivate(s, i, loc.ErrorEmbed("Insufficient balance! You have %d %s", balance, config.Bot().CurrencySymbol))
+		return
+	}
+	// Claimed: nobody else can accept it from here on
+	delete(pendingChallenges, key)
+	challenge.TimeoutTimer.Stop()
+	pendingMu.Unlock()
+
+	opponentID := userID
+	cancel := func(content string) {
+		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{
+				Content:    content,
+				Embeds:     []*discordgo.MessageEmbed{},
+				Components: []discordgo.MessageComponent{},
+			},
+		})
+	}
+
+	// The challenger may have been frozen while the challenge was up
+	if database.IsFrozen(challenge.ChallengerID) {
+		cancel(fmt.Sprintf("❌ <@%s> %s", challenge.ChallengerID, challenge.Locale.T(FrozenMessage)))
+		return
+	}
+
+	// Escrow both stakes before anything is rolled
+	if err := escrowStake(opponentID, challenge.Bet); err != nil {
+		cancel(fmt.Sprintf("❌ <@%s> %s", opponentID, challenge.Locale.Err(err)))
+		return
+	}
+	if err := escrowStake(challenge.ChallengerID, challenge.Bet); err != nil {
+		refundStake(opponentID, challenge.Bet)
+		cancel(fmt.Sprintf("❌ <@%s> %s", challenge.ChallengerID, challenge.Locale.Err(err)))
+		return
+	}
+
+	challenge.ChallengedID = opponentID
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{resolveDuel(challenge)},
+			Components: []discordgo.MessageComponent{},
+		},
+	})
+}
+
+// resolveDuel plays an accepted duel whose stakes are already escrowed, pays
+// the winner and returns the result embed
+func resolveDuel(c *Challenge) *discordgo.MessageEmbed {
+	loc := c.Locale
+	rules := DuelRules()
+
+	var outcome, detail string
+	winnerID, loserID := c.ChallengerID, c.ChallengedID
+	if c.Game == DuelDice {
+		var challengerRoll, opponentRoll, rerolls int
+		for {
+			challengerRoll, opponentRoll = rand.Intn(c.Sides)+1, rand.Intn(c.Sides)+1
+			if challengerRoll != opponentRoll {
+				break
+			}
+			rerolls++
+		}
+		if opponentRoll > challengerRoll {
+			winnerID, loserID = loserID, winnerID
+		}
+		outcome = loc.T("<@%s> rolled **%d**\n<@%s> rolled **%d**", c.ChallengerID, challengerRoll, c.ChallengedID, opponentRoll)
+		detail = fmt.Sprintf("d%d: <@%s> rolled %d, <@%s> rolled %d", c.Sides, c.ChallengerID, challengerRoll, c.ChallengedID, opponentRoll)
+		if rerolls > 0 {
+			outcome += "\n" + loc.T("*(%d tie(s) re-rolled)*", rerolls)
+		}
+	} else {
+		side, landed := loc.T("Heads"), "heads"
+		if rand.Intn(2) == 1 {
+			side, landed = loc.T("Tails"), "tails"
+			winnerID, loserID = loserID, winnerID
+		}
+		detail = fmt.Sprintf("<@%s> heads vs <@%s> tails, landed on %s", c.ChallengerID, c.ChallengedID, landed)
+		outcome = loc.T("<@%s> (Heads) vs <@%s> (Tails)\nThe coin lands on **%s**!", c.ChallengerID, c.ChallengedID, side)
+	}
+
+	pot := c.Bet * 2
+	cut := int(float64(pot) * rules.HouseCutPercent / 100)
+	prize := pot - cut
+	database.AddCoins(winnerID, prize)
+	database.PayBot(cut)
+	reportResult(winnerID, c.Game, c.Bet, prize, detail)
+	reportResult(loserID, c.Game, c.Bet, 0, detail)
+
+	embed := &discordgo.MessageEmbed{
+		Title:       duelTitle(loc, c),
+		Description: outcome,
+		Color:       utils.ColorGold,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   loc.T("🏆 Winner"),
+				Value:  fmt.Sprintf("<@%s>", winnerID),
+				Inline: true,
+			},
+			{
+				Name:   loc.T("💰 Prize"),
+				Value:  fmt.Sprintf("%d %s", prize, config.Bot().CurrencySymbol),
+				Inline: true,
+			},
+		},
+	}
+	if cut > 0 {
+		embed.Footer = &discordgo.MessageEmbedFooter{Text: loc.T("House cut: %d %s", cut, config.Bot().CurrencySymbol)}
+	}
+	return embed
+}
+
+func handleDuelDecline(s *discordgo.Session, i *discordgo.InteractionCreate, key string) {
+	loc := i18n.ForInteraction(i)
+	userID := i.Member.User.ID
+
+	pendingMu.Lock()
+	challenge, exists := pendingChallenges[key]
+	if !exists || challenge.Game == "" {
+		pendingMu.Unlock()
+		respondPrivate(s, i, loc.ErrorEmbed("No pending challenge found!"))
+		return
+	}
+	// The challenged user declines; the challenger can always withdraw
+	if userID != challenge.ChallengerID && userID != challenge.ChallengedID {
+		pendingMu.Unlock()
+		respondPrivate(s, i, loc.ErrorEmbed("Only <@%s> can cancel this challenge.", challenge.ChallengerID))
+		return
+	}
+	delete(pendingChallenges, key)
+	challenge.TimeoutTimer.Stop()
+	pendingMu.Unlock()
+
+	content := challenge.Locale.T("❌ <@%s> declined the challenge!", userID)
+	if userID == challenge.ChallengerID {
+		content = challenge.Locale.T("❌ <@%s> cancelled the challenge.", userID)
+	}
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    content,
+			Embeds:     []*discordgo.MessageEmbed{},
+			Components: []discordgo.MessageComponent{},
+		},
+	})
+}
@@ -2,10 +2,12 @@ package games
 
 import (
 	"estudocoin/internal/database"
+	"estudocoin/internal/i18n"
 	"estudocoin/pkg/config"
 	"estudocoin/pkg/utils"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -45,6 +47,7 @@ type BettingEvent struct {
 	Closed      bool
 	WinnerID    string
 	MessageID   string
+	Locale      i18n.Locale // language of the server, used for the posted event message
 	mu          sync.RWMutex
 }
 
@@ -58,19 +61,24 @@ func StartEventBetting(s *discordgo.Session) {
 	eventSession = s
 }
 
-// CreateEvent creates a new betting event (admin only)
-func CreateEvent(adminID, question string, options []string, durationMinutes int, channelID string) (*BettingEvent, string) {
+// CreateEvent creates a new betting event. Callers check that the creator is a moderator.
+func CreateEvent(loc i18n.Locale, adminID, question string, options []string, durationMinutes int, channelID string) (*BettingEvent, string) {
 	if len(options) < 2 {
-		return nil, "Need at least 2 options."
+		return nil, loc.T("Need at least 2 options.")
 	}
 	if len(options) > 10 {
-		return nil, "Maximum 10 options allowed."
+		return nil, loc.T("Maximum 10 options allowed.")
 	}
 	if durationMinutes < 1 || durationMinutes > 1440 {
-		return nil, "Duration must be between 1 and 1440 minutes (24 hours)."
+		return nil, loc.T("Duration must be between 1 and 1440 minutes (24 hours).")
 	}
 	if len(question) < 5 || len(question) > 200 {
-		return nil, "Question must be between 5 and 200 characters."
+		return nil, loc.T("Question must be between 5 and 200 characters.")
+	}
+
+	locale := i18n.Default()
+	if eventSession != nil {
+		locale = i18n.ForChannel(eventSession, channelID)
 	}
 
 	eventID := generateEventID()
@@ -83,6 +91,7 @@ func CreateEvent(adminID, question string, options []string, durationMinutes int
 		ChannelID: channelID,
 		EndTime:   time.Now().Add(time.Duration(durationMinutes) * time.Minute),
 		Closed:    false,
+		Locale:    locale,
 	}
 
 	// Create options
@@ -113,14 +122,18 @@ func generateEventID() string {
 }
 
 // PlaceBet allows a user to place a bet
-func PlaceBet(userID, username, eventID, optionID string, amount int) (bool, string) {
+func PlaceBet(loc i18n.Locale, userID, username, eventID, optionID string, amount int) (bool, string) {
 	if amount < MinEventBet {
-		return false, fmt.Sprintf("Minimum bet is %d %s", MinEventBet, config.Bot.CurrencySymbol)
+		return false, loc.T("Minimum bet is %d %s", MinEventBet, config.Bot().CurrencySymbol)
+	}
+
+	if database.IsFrozen(userID) {
+		return false, loc.T(FrozenMessage)
 	}
 
 	balance := database.GetBalance(userID)
 	if balance < amount {
-		return false, fmt.Sprintf("Insufficient balance! You have %d %s", balance, config.Bot.CurrencySymbol)
+		return false, loc.T("Insufficient balance! You have %d %s", balance, config.Bot().CurrencySymbol)
 	}
 
 	eventsMu.RLock()
@@ -128,42 +141,42 @@ func PlaceBet(userID, username, eventID, optionID string, amount int) (bool, str
 	eventsMu.RUnlock()
 
 	if !exists {
-		return false, "Event not found."
+		return false, loc.T("Event not found.")
 	}
 
 	event.mu.Lock()
 	defer event.mu.Unlock()
 
 	if event.Closed {
-		return false, "This event is closed."
+		return false, loc.T("This event is closed.")
 	}
 
 	if time.Now().After(event.EndTime) {
 		event.Closed = true
-		return false, "Betting time has ended."
+		return false, loc.T("Betting time has ended.")
 	}
 
 	option, exists := event.Options[optionID]
 	if !exists {
-		return false, "Invalid option."
+		return false, loc.T("Invalid option.")
 	}
 
 	// Check if user already bet on this event
 	betKey := fmt.Sprintf("%s_%s", userID, optionID)
 	if _, exists := event.UserBets[betKey]; exists {
-		return false, "You already bet on this option. Use a different option or wait for the next event."
+		return false, loc.T("You already bet on this option. Use a different option or wait for the next event.")
 	}
 
 	// Check if user bet on any option in this event
 	for key, bet := range event.UserBets {
 		if strings.HasPrefix(key, userID+"_") {
-			return false, fmt.Sprintf("You already bet on '%s'. Only one bet per event allowed.", event.Options[bet.OptionID].Name)
+			return false, loc.T("You already bet on '%s'. Only one bet per event allowed.", event.Options[bet.OptionID].Name)
 		}
 	}
 
 	// Deduct coins (goes to bot pool)
-	if err := database.CollectLostBet(userID, amount); err != nil {
-		return false, "Error processing bet."
+	if err := collectStake(userID, amount); err != nil {
+		return false, loc.Err(err)
 	}
 
 	// Record bet
@@ -178,6 +191,9 @@ func PlaceBet(userID, username, eventID, optionID string, amount int) (bool, str
 	option.TotalAmount += amount
 	event.TotalPool += amount
 
+	// Update the posted odds once the lock is released
+	go event.refreshMessage()
+
 	return true, ""
 }
 
@@ -201,48 +217,53 @@ func CloseEventAuto(eventID string) {
 	totalPool := event.TotalPool
 	event.mu.Unlock()
 
+	// Remove the bet buttons from the event message
+	event.refreshMessage()
+
 	// Notify channel
 	if eventSession != nil && totalBets > 0 {
+		loc := event.Locale
 		embed := &discordgo.MessageEmbed{
-			Title:       "🔒 Betting Closed",
-			Description: fmt.Sprintf("**%s**\n\nBetting is now closed! Waiting for admin to set the result.\n\nTotal Pool: **%d %s** | Total Bets: **%d**", event.Question, totalPool, config.Bot.CurrencySymbol, totalBets),
+			Title:       loc.T("🔒 Betting Closed"),
+			Description: loc.T("**%s**\n\nBetting is now closed! Waiting for admin to set the result.\n\nTotal Pool: **%d %s** | Total Bets: **%d**", event.Question, totalPool, config.Bot().CurrencySymbol, totalBets),
 			Color:       0xFFA500,
 			Footer: &discordgo.MessageEmbedFooter{
-				Text: fmt.Sprintf("Event ID: %s | Use !result %s <option>", event.ID, event.ID),
+				Text: loc.T("Event ID: %s | Use !result %s <option>", event.ID, event.ID),
 			},
 		}
 		eventSession.ChannelMessageSendEmbed(event.ChannelID, embed)
 	}
 }
 
-// SetResult sets the winning option and distributes prizes (admin only)
-func SetResult(adminID, eventID, optionID string) (bool, string, map[string]int) {
+// SetResult sets the winning option and distributes prizes. Only the creator
+// can set it, unless override is set (bot admins).
+func SetResult(loc i18n.Locale, adminID, eventID, optionID string, override bool) (bool, string, map[string]int) {
 	eventsMu.RLock()
 	event, exists := activeEvents[eventID]
 	eventsMu.RUnlock()
 
 	if !exists {
-		return false, "Event not found.", nil
+		return false, loc.T("Event not found."), nil
 	}
 
 	event.mu.Lock()
 	defer event.mu.Unlock()
 
-	if event.CreatorID != adminID {
-		return false, "Only the event creator can set the result.", nil
+	if event.CreatorID != adminID && !override {
+		return false, loc.T("Only the event creator or an admin can set the result."), nil
 	}
 
 	if !event.Closed && time.Now().Before(event.EndTime) {
-		return false, "Betting is still active. Close the event first or wait for time to end.", nil
+		return false, loc.T("Betting is still active. Close the event first or wait for time to end."), nil
 	}
 
 	if event.WinnerID != "" {
-		return false, "Result already set.", nil
+		return false, loc.T("Result already set."), nil
 	}
 
 	winnerOption, exists := event.Options[optionID]
 	if !exists {
-		return false, "Invalid winning option.", nil
+		return false, loc.T("Invalid winning option."), nil
 	}
 
 	event.WinnerID = optionID
@@ -252,7 +273,10 @@ func SetResult(adminID, eventID, optionID string) (bool, string, map[string]int)
 	
 	if winnerOption.TotalAmount == 0 {
 		// No one bet on winning option - house keeps everything
-		return true, "No winners! House keeps the pool.", payouts
+		for _, bet := range event.UserBets {
+			reportResult(bet.UserID, "event", bet.Amount, 0, eventBetDetail(event, bet))
+		}
+		return true, loc.T("No winners! House keeps the pool."), payouts
 	}
 
 	// Calculate pool after house edge
@@ -268,11 +292,23 @@ func SetResult(adminID, eventID, optionID string) (bool, string, map[string]int)
 			
 			database.AddCoins(bet.UserID, winnings)
 			payouts[bet.UserID] = winnings - bet.Amount // Net profit
+			reportResult(bet.UserID, "event", bet.Amount, winnings, eventBetDetail(event, bet))
+		} else {
+			reportResult(bet.UserID, "event", bet.Amount, 0, eventBetDetail(event, bet))
 		}
 	}
 
-	return true, fmt.Sprintf("Result set! Distributed %d %s to winners. House kept %d %s.", 
-		poolAfterEdge, config.Bot.CurrencySymbol, houseProfit, config.Bot.CurrencySymbol), payouts
+	return true, loc.T("Result set! Distributed %d %s to winners. House kept %d %s.", 
+		poolAfterEdge, config.Bot().CurrencySymbol, houseProfit, config.Bot().CurrencySymbol), payouts
+}
+
+// eventBetDetail describes a settled event bet for the game history
+func eventBetDetail(event *BettingEvent, bet *UserBet) string {
+	detail := fmt.Sprintf("%q: bet on %s", event.Question, event.Options[bet.OptionID].Name)
+	if winner, ok := event.Options[event.WinnerID]; ok {
+		detail += ", won by " + winner.Name
+	}
+	return detail
 }
 
 // GetOdds calculates current odds for each option
@@ -305,42 +341,42 @@ func (e *BettingEvent) GetOdds() map[string]float64 {
 	return odds
 }
 
-func (e *BettingEvent) ToEmbed() *discordgo.MessageEmbed {
+func (e *BettingEvent) ToEmbed(loc i18n.Locale) *discordgo.MessageEmbed {
 	e.mu.RLock()
 	defer e.mu.RUnlock()
 
 	timeLeft := e.EndTime.Sub(time.Now())
-	status := "🟢 Open"
+	status := loc.T("🟢 Open")
 	color := 0x00FF00
 	
 	if e.Closed || timeLeft <= 0 {
-		status = "🔒 Closed"
+		status = loc.T("🔒 Closed")
 		color = 0xFF0000
 	}
 
 	odds := e.GetOdds()
 
 	var optionsText strings.Builder
-	for _, opt := range e.Options {
+	for i, opt := range e.sortedOptions() {
 		oddsVal := odds[opt.ID]
 		oddsStr := fmt.Sprintf("%.2fx", oddsVal)
 		if oddsVal >= 99 {
 			oddsStr = "∞"
 		}
 		
-		optionsText.WriteString(fmt.Sprintf("**%s** - Odds: %s | Bets: %d (%d %s)\n", 
-			opt.Name, oddsStr, opt.TotalBets, opt.TotalAmount, config.Bot.CurrencySymbol))
+		optionsText.WriteString(loc.T("**%d. %s** - Odds: %s | Bets: %d (%d %s)\n", 
+			i+1, opt.Name, oddsStr, opt.TotalBets, opt.TotalAmount, config.Bot().CurrencySymbol))
 	}
 
-	footerText := fmt.Sprintf("Event ID: %s | Min Bet: %d %s", e.ID, MinEventBet, config.Bot.CurrencySymbol)
+	footerText := loc.T("Event ID: %s | Min Bet: %d %s", e.ID, MinEventBet, config.Bot().CurrencySymbol)
 	if !e.Closed && timeLeft > 0 {
-		footerText += fmt.Sprintf(" | Ends in %d min", int(timeLeft.Minutes()))
+		footerText += loc.T(" | Ends in %d min", int(timeLeft.Minutes()))
 	}
 
 	return &discordgo.MessageEmbed{
 		Title:       fmt.Sprintf("🎲 %s", e.Question),
-		Description: fmt.Sprintf("**Status:** %s\n**Total Pool:** %d %s\n\n%s", 
-			status, e.TotalPool, config.Bot.CurrencySymbol, optionsText.String()),
+		Description: loc.T("**Status:** %s\n**Total Pool:** %d %s\n\n%s", 
+			status, e.TotalPool, config.Bot().CurrencySymbol, optionsText.String()),
 		Color: color,
 		Footer: &discordgo.MessageEmbedFooter{
 			Text: footerText,
@@ -349,148 +385,134 @@ func (e *BettingEvent) ToEmbed() *discordgo.MessageEmbed {
 	}
 }
 
-// Command handlers
-func CmdCreateEvent(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
-	// Args: question | option1 | option2 | ... | duration_minutes
-	if len(args) < 4 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed(
-			"Usage: `!createevent <question> | <option1> | <option2> | ... | <duration_minutes>`\n" +
-			"Example: `!createevent Will Team A win? | Yes | No | Maybe | 30`"))
-		return
-	}
+// sortedOptions returns the options in creation order (caller holds the lock)
+func (e *BettingEvent) sortedOptions() []*EventOption {
+	options := make([]*EventOption, 0, len(e.Options))
+	for _, opt := range e.Options {
+		options = append(options, opt)
+	}
+	sort.Slice(options, func(i, j int) bool {
+		a, _ := strconv.Atoi(strings.TrimPrefix(options[i].ID, "opt_"))
+		b, _ := strconv.Atoi(strings.TrimPrefix(options[j].ID, "opt_"))
+		return a < b
+	})
+	return options
+}
 
-	// Parse last arg as duration
-	durationStr := args[len(args)-1]
-	duration, err := strconv.Atoi(durationStr)
-	if err != nil {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Invalid duration. Use minutes (e.g., 30)"))
-		return
-	}
+// Components returns one bet button per option while betting is open
+func (e *BettingEvent) Components() []discordgo.MessageComponent {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
 
-	// Join remaining args and split by |
-	argsStr := strings.Join(args[:len(args)-1], " ")
-	parts := strings.Split(argsStr, "|")
-	
-	if len(parts) < 3 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Need question and at least 2 options separated by |"))
-		return
+	components := []discordgo.MessageComponent{}
+	if e.Closed || time.Now().After(e.EndTime) {
+		return components
 	}
 
-	question := strings.TrimSpace(parts[0])
-	options := make([]string, 0, len(parts)-1)
-	for i := 1; i < len(parts); i++ {
-		opt := strings.TrimSpace(parts[i])
-		if opt != "" {
-			options = append(options, opt)
+	// Discord allows 5 buttons per row
+	var row []discordgo.MessageComponent
+	for i, opt := range e.sortedOptions() {
+		label := fmt.Sprintf("%d. %s", i+1, opt.Name)
+		if len([]rune(label)) > 80 {
+			label = string([]rune(label)[:77]) + "..."
+		}
+		row = append(row, discordgo.Button{
+			Label:    label,
+			Style:    discordgo.PrimaryButton,
+			CustomID: fmt.Sprintf("event_bet_%s_%s", e.ID, opt.ID),
+		})
+		if len(row) == 5 {
+			components = append(components, discordgo.ActionsRow{Components: row})
+			row = nil
 		}
 	}
-
-	event, errMsg := CreateEvent(m.Author.ID, question, options, duration, m.ChannelID)
-	if event == nil {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed(errMsg))
-		return
+	if len(row) > 0 {
+		components = append(components, discordgo.ActionsRow{Components: row})
 	}
+	return components
+}
 
-	embed := event.ToEmbed()
-	msg, _ := s.ChannelMessageSendEmbed(m.ChannelID, embed)
-	
-	if msg != nil {
-		event.mu.Lock()
-		event.MessageID = msg.ID
-		event.mu.Unlock()
+// PostEvent sends the event embed with its bet buttons and remembers the message
+func PostEvent(s *discordgo.Session, event *BettingEvent) error {
+	msg, err := s.ChannelMessageSendComplex(event.ChannelID, &discordgo.MessageSend{
+		Embeds:     []*discordgo.MessageEmbed{event.ToEmbed(event.Locale)},
+		Components: event.Components(),
+	})
+	if err != nil {
+		return err
 	}
+
+	event.mu.Lock()
+	event.MessageID = msg.ID
+	event.mu.Unlock()
+	return nil
 }
 
-func CmdPlaceBet(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
-	// Args: event_id option_number amount
-	if len(args) < 3 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed(
-			"Usage: `!betevent <event_id> <option_number> <amount>`\n" +
-			"Example: `!betevent evt_123456 1 100` (bets 100 on option 1)"))
-		return
-	}
+// refreshMessage updates the odds and buttons of the posted event message
+func (e *BettingEvent) refreshMessage() {
+	e.mu.RLock()
+	messageID := e.MessageID
+	e.mu.RUnlock()
 
-	eventID := args[0]
-	optNum, err := strconv.Atoi(args[1])
-	if err != nil || optNum < 1 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Invalid option number."))
-		return
-	}
-	
-	amount, err := strconv.Atoi(args[2])
-	if err != nil || amount < MinEventBet {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed(fmt.Sprintf("Invalid amount. Minimum is %d", MinEventBet)))
+	if eventSession == nil || messageID == "" {
 		return
 	}
 
-	// Find event
+	embeds := []*discordgo.MessageEmbed{e.ToEmbed(e.Locale)}
+	components := e.Components()
+	eventSession.ChannelMessageEditComplex(&discordgo.MessageEdit{
+		Channel:    e.ChannelID,
+		ID:         messageID,
+		Embeds:     &embeds,
+		Components: &components,
+	})
+}
+
+// GetEvent returns an active event by ID
+func GetEvent(eventID string) (*BettingEvent, bool) {
 	eventsMu.RLock()
+	defer eventsMu.RUnlock()
 	event, exists := activeEvents[eventID]
-	eventsMu.RUnlock()
+	return event, exists
+}
 
+// CloseEvent closes betting early. Only the creator can close an event,
+// unless override is set (bot admins). Returns an error message, or "" on success.
+func CloseEvent(loc i18n.Locale, userID, eventID string, override bool) string {
+	event, exists := GetEvent(eventID)
 	if !exists {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Event not found. Use `!events` to see active events."))
-		return
+		return loc.T("Event not found.")
 	}
 
-	// Get option ID from number
 	event.mu.RLock()
-	optionID := fmt.Sprintf("opt_%d", optNum-1)
-	option, exists := event.Options[optionID]
-	if !exists {
-		event.mu.RUnlock()
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Invalid option number."))
-		return
-	}
+	creatorID := event.CreatorID
 	event.mu.RUnlock()
-
-	success, msg := PlaceBet(m.Author.ID, m.Author.Username, eventID, optionID, amount)
-	if !success {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed(msg))
-		return
+	if creatorID != userID && !override {
+		return loc.T("Only the event creator or an admin can close it early.")
 	}
 
-	s.ChannelMessageSendEmbed(m.ChannelID, utils.SuccessEmbed("Bet Placed!", 
-		fmt.Sprintf("You bet **%d %s** on **%s** (Option %d)", amount, config.Bot.CurrencySymbol, option.Name, optNum)))
-
-	// Update event embed if possible
-	if event.MessageID != "" {
-		s.ChannelMessageEditEmbed(m.ChannelID, event.MessageID, event.ToEmbed())
-	}
+	// CloseEventAuto marks the event closed, notifies the channel and removes the buttons
+	CloseEventAuto(eventID)
+	return ""
 }
 
-func CmdSetResult(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
-	// Args: event_id option_number
-	if len(args) < 2 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed(
-			"Usage: `!result <event_id> <option_number>`\n" +
-			"Example: `!result evt_123456 1` (sets option 1 as winner)"))
-		return
-	}
-
-	eventID := args[0]
-	optNum, err := strconv.Atoi(args[1])
-	if err != nil || optNum < 1 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Invalid option number."))
-		return
-	}
-
+// ResolveEvent sets the winning option (1-based) and returns the result embed,
+// or an error message
+func ResolveEvent(loc i18n.Locale, userID, eventID string, optNum int, override bool) (*discordgo.MessageEmbed, string) {
 	optionID := fmt.Sprintf("opt_%d", optNum-1)
 
-	success, msg, payouts := SetResult(m.Author.ID, eventID, optionID)
+	success, msg, payouts := SetResult(loc, userID, eventID, optionID, override)
 	if !success {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed(msg))
-		return
+		return nil, msg
 	}
 
 	// Get event info for result embed
-	eventsMu.RLock()
-	event := activeEvents[eventID]
-	eventsMu.RUnlock()
+	event, _ := GetEvent(eventID)
 
-	var winnerName string
+	var question, winnerName string
 	if event != nil {
 		event.mu.RLock()
+		question = event.Question
 		if opt, exists := event.Options[optionID]; exists {
 			winnerName = opt.Name
 		}
@@ -498,121 +520,214 @@ func CmdSetResult(s *discordgo.Session, m *discordgo.MessageCreate, args []strin
 	}
 
 	// Build winners list
-	winnersText := "No winners this time."
+	winnersText := loc.T("No winners this time.")
 	if len(payouts) > 0 {
 		var sb strings.Builder
 		for userID, profit := range payouts {
-			sb.WriteString(fmt.Sprintf("<@%s>: +%d %s\n", userID, profit, config.Bot.CurrencySymbol))
+			sb.WriteString(fmt.Sprintf("<@%s>: +%d %s\n", userID, profit, config.Bot().CurrencySymbol))
 		}
 		winnersText = sb.String()
 	}
 
-	embed := &discordgo.MessageEmbed{
-		Title:       "🏆 Event Result!",
-		Description: fmt.Sprintf("**%s**\n\n**Winner:** %s", event.Question, winnerName),
+	// Clean up event after some time
+	go func() {
+		time.Sleep(1 * time.Hour)
+		eventsMu.Lock()
+		delete(activeEvents, eventID)
+		eventsMu.Unlock()
+	}()
+
+	return &discordgo.MessageEmbed{
+		Title:       loc.T("🏆 Event Result!"),
+		Description: loc.T("**%s**\n\n**Winner:** %s", question, winnerName),
 		Color:       0xFFD700,
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name:   "💰 Winners",
+				Name:   loc.T("💰 Winners"),
 				Value:  winnersText,
 				Inline: false,
 			},
 			{
-				Name:   "📊 Summary",
+				Name:   loc.T("📊 Summary"),
 				Value:  msg,
 				Inline: false,
 			},
 		},
-	}
-	s.ChannelMessageSendEmbed(m.ChannelID, embed)
-
-	// Clean up event after some time
-	go func() {
-		time.Sleep(1 * time.Hour)
-		eventsMu.Lock()
-		delete(activeEvents, eventID)
-		eventsMu.Unlock()
-	}()
+	}, ""
 }
 
-func CmdListEvents(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
+// EventsEmbed lists the active events
+func EventsEmbed(loc i18n.Locale) *discordgo.MessageEmbed {
 	eventsMu.RLock()
 	defer eventsMu.RUnlock()
 
 	if len(activeEvents) == 0 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.InfoEmbed("Active Events", "No active betting events."))
-		return
+		return utils.InfoEmbed(loc.T("Active Events"), loc.T("No active betting events."))
 	}
 
 	var sb strings.Builder
 	for _, event := range activeEvents {
 		event.mu.RLock()
-		status := "🟢 Open"
+		status := loc.T("🟢 Open")
 		if event.Closed {
-			status = "🔒 Closed"
+			status = loc.T("🔒 Closed")
 		} else if time.Now().After(event.EndTime) {
-			status = "⏰ Ended"
+			status = loc.T("⏰ Ended")
 		}
 		
 		timeLeft := event.EndTime.Sub(time.Now())
-		timeStr := fmt.Sprintf("Ends in %dm", int(timeLeft.Minutes()))
+		timeStr := loc.T("Ends in %dm", int(timeLeft.Minutes()))
 		if event.Closed {
-			timeStr = "Waiting for result"
+			timeStr = loc.T("Waiting for result")
 		}
 		
-		sb.WriteString(fmt.Sprintf("**%s** - %s\nID: `%s` | Pool: %d %s | %s\n\n", 
-			event.Question, status, event.ID, event.TotalPool, config.Bot.CurrencySymbol, timeStr))
+		sb.WriteString(loc.T("**%s** - %s\nID: `%s` | Pool: %d %s | %s\n\n", 
+			event.Question, status, event.ID, event.TotalPool, config.Bot().CurrencySymbol, timeStr))
 		event.mu.RUnlock()
 	}
 
-	s.ChannelMessageSendEmbed(m.ChannelID, utils.InfoEmbed("🎲 Active Betting Events", sb.String()))
+	return utils.InfoEmbed(loc.T("🎲 Active Betting Events"), sb.String())
 }
 
-func CmdViewEvent(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
-	if len(args) < 1 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Usage: `!event <event_id>`"))
+// Command handlers
+func CmdCreateEvent(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
+	loc := i18n.ForMessage(m)
+	// Args: question | option1 | option2 | ... | duration_minutes
+	if len(args) < 4 {
+		s.ChannelMessageSendEmbed(m.ChannelID, loc.ErrorEmbed(
+			"Usage: `!createevent <question> | <option1> | <option2> | ... | <duration_minutes>`\n" +
+			"Example: `!createevent Will Team A win? | Yes | No | Maybe | 30`"))
 		return
 	}
 
-	eventID := args[0]
-	eventsMu.RLock()
-	event, exists := activeEvents[eventID]
-	eventsMu.RUnlock()
+	// Parse last arg as duration
+	durationStr := args[len(args)-1]
+	duration, err := strconv.Atoi(durationStr)
+	if err != nil {
+		s.ChannelMessageSendEmbed(m.ChannelID, loc.ErrorEmbed("Invalid duration. Use minutes (e.g., 30)"))
+		return
+	}
 
-	if !exists {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Event not found."))
+	// Join remaining args and split by |
+	argsStr := strings.Join(args[:len(args)-1], " ")
+	parts := strings.Split(argsStr, "|")
+	
+	if len(parts) < 3 {
+		s.ChannelMessageSendEmbed(m.ChannelID, loc.ErrorEmbed("Need question and at least 2 options separated by |"))
 		return
 	}
 
-	embed := event.ToEmbed()
-	s.ChannelMessageSendEmbed(m.ChannelID, embed)
-}
+	question := strings.TrimSpace(parts[0])
+	options := make([]string, 0, len(parts)-1)
+	for i := 1; i < len(parts); i++ {
+		opt := strings.TrimSpace(parts[i])
+		if opt != "" {
+			options = append(options, opt)
+		}
+	}
 
-func CmdCloseEvent(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
-	if len(args) < 1 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Usage: `!closeevent <event_id>`"))
+	event, errMsg := CreateEvent(loc, m.Author.ID, question, options, duration, m.ChannelID)
+	if event == nil {
+		s.ChannelMessageSendEmbed(m.ChannelID, loc.ErrorEmbed(errMsg))
 		return
 	}
 
-	eventID := args[0]
+	PostEvent(s, event)
+}
+
+// PlaceEventBet bets on an event option by its number, returning the
+// confirmation embed or an error message
+func PlaceEventBet(loc i18n.Locale, userID, username, eventID string, optNum, amount int) (*discordgo.MessageEmbed, string) {
+	if amount < MinEventBet {
+		return nil, loc.T("Invalid amount. Minimum is %d", MinEventBet)
+	}
+
+	// Find event
 	eventsMu.RLock()
 	event, exists := activeEvents[eventID]
 	eventsMu.RUnlock()
 
 	if !exists {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Event not found."))
-		return
+		return nil, loc.T("Event not found. Use `!events` to see active events.")
 	}
 
-	event.mu.Lock()
-	if event.CreatorID != m.Author.ID {
-		event.mu.Unlock()
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Only the event creator can close it early."))
-		return
+	// Get option ID from number
+	event.mu.RLock()
+	optionID := fmt.Sprintf("opt_%d", optNum-1)
+	option, exists := event.Options[optionID]
+	event.mu.RUnlock()
+	if !exists {
+		return nil, loc.T("Invalid option number.")
 	}
-	event.Closed = true
-	event.mu.Unlock()
 
-	CloseEventAuto(eventID)
-	s.ChannelMessageSendEmbed(m.ChannelID, utils.SuccessEmbed("Event Closed", "Betting is now closed. Use `!result` to set the winner."))
+	success, msg := PlaceBet(loc, userID, username, eventID, optionID, amount)
+	if !success {
+		return nil, msg
+	}
+
+	return utils.SuccessEmbed(loc.T("Bet Placed!"),
+		loc.T("You bet **%d %s** on **%s** (Option %d)", amount, config.Bot().CurrencySymbol, option.Name, optNum)), ""
+}
+
+// EventOptionSnapshot is a read-only copy of an event option
+type EventOptionSnapshot struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	TotalBets   int     `json:"total_bets"`
+	TotalAmount int     `json:"total_amount"`
+	Odds        float64 `json:"odds"`
+}
+
+// EventSnapshot is a read-only copy of a betting event
+type EventSnapshot struct {
+	ID        string                `json:"id"`
+	Question  string                `json:"question"`
+	Options   []EventOptionSnapshot `json:"options"`
+	TotalPool int                   `json:"total_pool"`
+	CreatorID string                `json:"creator_id"`
+	ChannelID string                `json:"channel_id"`
+	EndTime   time.Time             `json:"end_time"`
+	Closed    bool                  `json:"closed"`
+}
+
+// ListEvents returns a snapshot of every active event, ordered by end time
+func ListEvents() []EventSnapshot {
+	eventsMu.RLock()
+	events := make([]*BettingEvent, 0, len(activeEvents))
+	for _, event := range activeEvents {
+		events = append(events, event)
+	}
+	eventsMu.RUnlock()
+
+	snapshots := make([]EventSnapshot, 0, len(events))
+	for _, event := range events {
+		odds := event.GetOdds()
+
+		event.mu.RLock()
+		snap := EventSnapshot{
+			ID:        event.ID,
+			Question:  event.Question,
+			TotalPool: event.TotalPool,
+			CreatorID: event.CreatorID,
+			ChannelID: event.ChannelID,
+			EndTime:   event.EndTime,
+			Closed:    event.Closed,
+		}
+		for _, opt := range event.Options {
+			snap.Options = append(snap.Options, EventOptionSnapshot{
+				ID:          opt.ID,
+				Name:        opt.Name,
+				TotalBets:   opt.TotalBets,
+				TotalAmount: opt.TotalAmount,
+				Odds:        odds[opt.ID],
+			})
+		}
+		event.mu.RUnlock()
+
+		sort.Slice(snap.Options, func(i, j int) bool { return snap.Options[i].ID < snap.Options[j].ID })
+		snapshots = append(snapshots, snap)
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].EndTime.Before(snapshots[j].EndTime) })
+	return snapshots
 }
@@ -0,0 +1,213 @@
+package games
+
+import (
+	"estudocoin/internal/i18n"
+	"estudocoin/internal/permissions"
+	"estudocoin/pkg/config"
+	"estudocoin/pkg/utils"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Custom IDs of the event components and modals
+const (
+	EventCreateModalID  = "event_create"
+	eventBetButtonID    = "event_bet_"      // event_bet_<eventID>_<optionID>
+	eventBetModalPrefix = "event_betmodal_" // event_betmodal_<eventID>_<optionID>
+)
+
+// EventCreateModal is the form opened by /event create
+func EventCreateModal(loc i18n.Locale) *discordgo.InteractionResponseData {
+	return &discordgo.InteractionResponseData{
+		CustomID: EventCreateModalID,
+		Title:    loc.T("Create Betting Event"),
+		Components: []discordgo.MessageComponent{
+			discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+				discordgo.TextInput{
+					CustomID:    "question",
+					Label:       loc.T("Question"),
+					Style:       discordgo.TextInputShort,
+					Placeholder: loc.T("Will Team A win?"),
+					Required:    true,
+					MinLength:   5,
+					MaxLength:   200,
+				},
+			}},
+			discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+				discordgo.TextInput{
+					CustomID:    "options",
+					Label:       loc.T("Options (one per line, 2-10)"),
+					Style:       discordgo.TextInputParagraph,
+					Placeholder: loc.T("Yes\nNo\nDraw"),
+					Required:    true,
+				},
+			}},
+			discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+				discordgo.TextInput{
+					CustomID:    "duration",
+					Label:       loc.T("Duration in minutes (1-1440)"),
+					Style:       discordgo.TextInputShort,
+					Placeholder: "30",
+					Required:    true,
+					MaxLength:   4,
+				},
+			}},
+		},
+	}
+}
+
+// HandleEventCreateModal creates the event submitted through EventCreateModal
+func HandleEventCreateModal(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	loc := i18n.ForInteraction(i)
+	// /event create already checked this; the modal could have been opened before a role change
+	if permissions.ForInteraction(i) < permissions.Moderator {
+		respondEmbed(s, i, loc.ErrorEmbed("Only moderators can create betting events."))
+		return
+	}
+
+	data := i.ModalSubmitData()
+	question := strings.TrimSpace(modalValue(data, "question"))
+
+	duration, err := strconv.Atoi(strings.TrimSpace(modalValue(data, "duration")))
+	if err != nil {
+		respondEmbed(s, i, loc.ErrorEmbed("Invalid duration. Use minutes (e.g., 30)"))
+		return
+	}
+
+	var options []string
+	for _, line := range strings.Split(modalValue(data, "options"), "\n") {
+		if opt := strings.TrimSpace(line); opt != "" {
+			options = append(options, opt)
+		}
+	}
+
+	event, errMsg := CreateEvent(loc, i.Member.User.ID, question, options, duration, i.ChannelID)
+	if event == nil {
+		respondEmbed(s, i, loc.ErrorEmbed(errMsg))
+		return
+	}
+
+	if err := PostEvent(s, event); err != nil {
+		respondEmbed(s, i, loc.ErrorEmbed("Event created, but I couldn't post it in this channel."))
+		return
+	}
+	respondEmbed(s, i, utils.SuccessEmbed(loc.T("Event Created"),
+		loc.T("Event `%s` is open for %d minutes. Use `/event result` when it ends.", event.ID, duration)))
+}
+
+// HandleEventBetButton opens the amount modal for the clicked option
+func HandleEventBetButton(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	loc := i18n.ForInteraction(i)
+	eventID, optionID, ok := parseEventOptionID(i.MessageComponentData().CustomID, eventBetButtonID)
+	if !ok {
+		return
+	}
+
+	event, exists := GetEvent(eventID)
+	if !exists {
+		respondEmbed(s, i, loc.ErrorEmbed("Event not found."))
+		return
+	}
+
+	event.mu.RLock()
+	option, exists := event.Options[optionID]
+	optionName := ""
+	if exists {
+		optionName = option.Name
+	}
+	event.mu.RUnlock()
+	if !exists {
+		respondEmbed(s, i, loc.ErrorEmbed("Invalid option."))
+		return
+	}
+
+	// Modal titles are limited to 45 characters
+	title := loc.T("Bet on %s", optionName)
+	if len([]rune(title)) > 45 {
+		title = string([]rune(title)[:42]) + "..."
+	}
+
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{
+			CustomID: eventBetModalPrefix + eventID + "_" + optionID,
+			Title:    title,
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+					discordgo.TextInput{
+						CustomID:    "amount",
+						Label:       loc.T("Amount (min %d %s)", MinEventBet, config.Bot().CurrencySymbol),
+						Style:       discordgo.TextInputShort,
+						Placeholder: "100",
+						Required:    true,
+						MaxLength:   12,
+					},
+				}},
+			},
+		},
+	})
+}
+
+// HandleEventBetModal places the bet submitted through the amount modal
+func HandleEventBetModal(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	loc := i18n.ForInteraction(i)
+	data := i.ModalSubmitData()
+	eventID, optionID, ok := parseEventOptionID(data.CustomID, eventBetModalPrefix)
+	if !ok {
+		return
+	}
+
+	amount, err := strconv.Atoi(strings.TrimSpace(modalValue(data, "amount")))
+	if err != nil || amount < MinEventBet {
+		respondEmbed(s, i, loc.ErrorEmbed("Invalid amount. Minimum is %d", MinEventBet))
+		return
+	}
+
+	user := i.Member.User
+	success, msg := PlaceBet(loc, user.ID, user.Username, eventID, optionID, amount)
+	if !success {
+		respondEmbed(s, i, loc.ErrorEmbed(msg))
+		return
+	}
+
+	optionName := optionID
+	if event, exists := GetEvent(eventID); exists {
+		event.mu.RLock()
+		if opt, ok := event.Options[optionID]; ok {
+			optionName = opt.Name
+		}
+		event.mu.RUnlock()
+	}
+
+	respondEmbed(s, i, utils.SuccessEmbed(loc.T("Bet Placed!"),
+		loc.T("You bet **%d %s** on **%s**", amount, config.Bot().CurrencySymbol, optionName)))
+}
+
+// parseEventOptionID splits <prefix><eventID>_<optionID>. Both IDs contain
+// underscores (evt_123, opt_0), so the split is on the last "_opt_".
+func parseEventOptionID(customID, prefix string) (string, string, bool) {
+	rest := strings.TrimPrefix(customID, prefix)
+	idx := strings.LastIndex(rest, "_opt_")
+	if idx <= 0 {
+		return "", "", false
+	}
+	return rest[:idx], rest[idx+1:], true
+}
+
+// modalValue returns the value of a text input in a submitted modal
+func modalValue(data discordgo.ModalSubmitInteractionData, customID string) string {
+	for _, c := range data.Components {
+		row, ok := c.(*discordgo.ActionsRow)
+		if !ok {
+			continue
+		}
+		for _, rc := range row.Components {
+			if input, ok := rc.(*discordgo.TextInput); ok && input.CustomID == customID {
+				return input.Value
+			}
+		}
+	}
+	return ""
+}
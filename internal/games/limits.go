@@ -0,0 +1,353 @@
+package games
+
+import (
+	"database/sql"
+	"errors"
+	"estudocoin/internal/database"
+	"estudocoin/internal/i18n"
+	"estudocoin/pkg/config"
+	"estudocoin/pkg/utils"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Responsible-gambling limits. Every stake taken by a game goes through
+// collectStake or escrowStake, which refuse it while the player is
+// self-excluded or when it would break one of their limits.
+
+// Limits a player can set with /limits
+const (
+	LimitDailyLoss  = "daily_loss"
+	LimitWeeklyLoss = "weekly_loss"
+	LimitMaxBet     = "max_bet"
+)
+
+// openStakes are the coins each player has in games that did not report a
+// result yet (open hands, seated tables, escrowed duels...). They count as
+// lost until the game settles, so a limit can't be dodged by spreading bets
+// across games.
+var (
+	openStakes   = make(map[string]int)
+	openStakesMu sync.Mutex
+)
+
+// stakeLocks serialize the stakes of each user, so two bets placed at once
+// can't both pass CheckStake before either of them is counted
+var (
+	stakeLocks   = make(map[string]*sync.Mutex)
+	stakeLocksMu sync.Mutex
+)
+
+// Self-exclusion bounds
+const (
+	MinSelfExclusion = 24 * time.Hour
+	MaxSelfExclusion = 30 * 24 * time.Hour
+)
+
+// CoolingOff is how long a loosened limit takes to apply
+func CoolingOff() time.Duration {
+	hours := config.Economy().Limits.CoolingOffHours
+	if hours <= 0 {
+		hours = 24
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+// limitFields returns, for one kind of limit, the value in force, the
+// pending value and when the pending value applies (nil for unknown kinds)
+func limitFields(l *database.GamblingLimits, kind string) (current, pending *int, at *time.Time) {
+	switch kind {
+	case LimitDailyLoss:
+		return &l.DailyLoss, &l.PendingDailyLoss, &l.PendingDailyLossAt
+	case LimitWeeklyLoss:
+		return &l.WeeklyLoss, &l.PendingWeeklyLoss, &l.PendingWeeklyLossAt
+	case LimitMaxBet:
+		return &l.MaxBet, &l.PendingMaxBet, &l.PendingMaxBetAt
+	}
+	return nil, nil, nil
+}
+
+// limitKinds lists every limit, in the order /limits shows them
+var limitKinds = []string{LimitDailyLoss, LimitWeeklyLoss, LimitMaxBet}
+
+// GetLimits returns the limits in force for a user, applying the pending
+// changes whose cooling-off period is over
+func GetLimits(userID string) (*database.GamblingLimits, error) {
+	l, err := database.GetGamblingLimits(userID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	changed := false
+	for _, kind := range limitKinds {
+		current, pending, at := limitFields(l, kind)
+		if !at.IsZero() && !now.Before(*at) {
+			*current, *pending, *at = *pending, 0, time.Time{}
+			changed = true
+		}
+	}
+	if changed {
+		if err := database.SaveGamblingLimits(l); err != nil {
+			return nil, err
+		}
+	}
+	return l, nil
+}
+
+// SetLimit changes one of the user's limits (0 removes it). Tighter limits
+// apply at once and drop a pending change of the same limit; looser ones
+// wait for the cooling-off period, counted for that limit alone. The
+// returned time is when the change takes effect.
+func SetLimit(userID, kind string, amount int) (time.Time, error) {
+	if amount < 0 {
+		return time.Time{}, i18n.Errorf("Invalid amount. Use a positive number.")
+	}
+	l, err := GetLimits(userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	current, pending, at := limitFields(l, kind)
+	if current == nil {
+		return time.Time{}, i18n.Errorf("Unknown limit.")
+	}
+
+	effective := time.Now().UTC()
+	if *current > 0 && (amount == 0 || amount > *current) {
+		effective = effective.Add(CoolingOff())
+		*pending, *at = amount, effective
+	} else {
+		*current, *pending, *at = amount, 0, time.Time{}
+	}
+
+	if err := database.SaveGamblingLimits(l); err != nil {
+		log.Printf("[LIMITS] Error saving limits of %s: %v", userID, err)
+		return time.Time{}, i18n.Errorf("Could not save your limits.")
+	}
+	return effective, nil
+}
+
+// SelfExclude blocks every bet of the user for d. An exclusion can be
+// extended but never shortened.
+func SelfExclude(userID string, d time.Duration) (time.Time, error) {
+	if d < MinSelfExclusion || d > MaxSelfExclusion {
+		return time.Time{}, i18n.Errorf("Self-exclusion must last between 24 hours and 30 days.")
+	}
+	l, err := GetLimits(userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	until := time.Now().UTC().Add(d)
+	if until.Before(l.ExcludedUntil) {
+		return time.Time{}, i18n.Errorf("You are already self-excluded until <t:%d:f>.", l.ExcludedUntil.Unix())
+	}
+	l.ExcludedUntil = until
+	if err := database.SaveGamblingLimits(l); err != nil {
+		log.Printf("[LIMITS] Error saving self-exclusion of %s: %v", userID, err)
+		return time.Time{}, i18n.Errorf("Could not save your limits.")
+	}
+	return until, nil
+}
+
+// CheckStake returns why the user may not wager amount right now, or nil
+func CheckStake(userID string, amount int) error {
+	if database.IsFrozen(userID) {
+		return i18n.Errorf(FrozenMessage)
+	}
+	l, err := GetLimits(userID)
+	if err != nil {
+		log.Printf("[LIMITS] Error loading limits of %s: %v", userID, err)
+		return i18n.Errorf("Could not check your gambling limits. Try again later.")
+	}
+
+	now := time.Now().UTC()
+	if now.Before(l.ExcludedUntil) {
+		return i18n.Errorf("You are self-excluded from gambling until <t:%d:f>.", l.ExcludedUntil.Unix())
+	}
+	if l.MaxBet > 0 && amount > l.MaxBet {
+		return i18n.Errorf("This bet is over your limit of %d %s per bet.", l.MaxBet, config.Bot().CurrencySymbol)
+	}
+
+	if l.DailyLoss <= 0 && l.WeeklyLoss <= 0 {
+		return nil
+	}
+	open, err := pendingStake(userID)
+	if err != nil {
+		log.Printf("[LIMITS] Error loading open stakes of %s: %v", userID, err)
+		return i18n.Errorf("Could not check your gambling limits. Try again later.")
+	}
+	if l.DailyLoss > 0 {
+		loss, err := database.GetNetLoss(userID, now.Add(-24*time.Hour))
+		if err != nil {
+			log.Printf("[LIMITS] Error loading losses of %s: %v", userID, err)
+			return i18n.Errorf("Could not check your gambling limits. Try again later.")
+		}
+		loss += open
+		if loss+amount > l.DailyLoss {
+			return i18n.Errorf("This bet could take you past your daily loss limit of %d %s (%d left).", l.DailyLoss, config.Bot().CurrencySymbol, max(l.DailyLoss-loss, 0))
+		}
+	}
+	if l.WeeklyLoss > 0 {
+		loss, err := database.GetNetLoss(userID, now.Add(-7*24*time.Hour))
+		if err != nil {
+			log.Printf("[LIMITS] Error loading losses of %s: %v", userID, err)
+			return i18n.Errorf("Could not check your gambling limits. Try again later.")
+		}
+		loss += open
+		if loss+amount > l.WeeklyLoss {
+			return i18n.Errorf("This bet could take you past your weekly loss limit of %d %s (%d left).", l.WeeklyLoss, config.Bot().CurrencySymbol, max(l.WeeklyLoss-loss, 0))
+		}
+	}
+	return nil
+}
+
+// pendingStake is what the user has riding on games that have not settled
+// yet, lottery tickets of the open draw included
+func pendingStake(userID string) (int, error) {
+	openStakesMu.Lock()
+	open := openStakes[userID]
+	openStakesMu.Unlock()
+
+	tickets, err := lotteryStake(userID)
+	return open + tickets, err
+}
+
+// holdStake counts a stake as open until its game reports a result
+func holdStake(userID string, amount int) {
+	openStakesMu.Lock()
+	openStakes[userID] += amount
+	openStakesMu.Unlock()
+}
+
+// releaseStake stops counting a stake as open, once it was settled or refunded
+func releaseStake(userID string, amount int) {
+	openStakesMu.Lock()
+	defer openStakesMu.Unlock()
+	if left := openStakes[userID] - amount; left > 0 {
+		openStakes[userID] = left
+	} else {
+		delete(openStakes, userID)
+	}
+}
+
+// lockStakes holds the stake lock of a user and returns its unlock
+func lockStakes(userID string) func() {
+	stakeLocksMu.Lock()
+	mu, ok := stakeLocks[userID]
+	if !ok {
+		mu = &sync.Mutex{}
+		stakeLocks[userID] = mu
+	}
+	stakeLocksMu.Unlock()
+	mu.Lock()
+	return mu.Unlock
+}
+
+// collectStake checks the limits and takes a stake that goes to the bot.
+// The error is always translatable.
+func collectStake(userID string, amount int) error {
+	defer lockStakes(userID)()
+	if err := CheckStake(userID, amount); err != nil {
+		return err
+	}
+	if err := stakeError(userID, database.CollectLostBet(userID, amount)); err != nil {
+		return err
+	}
+	holdStake(userID, amount)
+	return nil
+}
+
+// escrowStake checks the limits and takes a stake that is held until the
+// game pays it out to the players
+func escrowStake(userID string, amount int) error {
+	defer lockStakes(userID)()
+	if err := CheckStake(userID, amount); err != nil {
+		return err
+	}
+	if err := stakeError(userID, database.RemoveCoins(userID, amount)); err != nil {
+		return err
+	}
+	holdStake(userID, amount)
+	return nil
+}
+
+// refundStake gives back a stake whose game will never report a result
+func refundStake(userID string, amount int) {
+	if err := database.AddCoins(userID, amount); err != nil {
+		log.Printf("[LIMITS] Error refunding %d to %s: %v", amount, userID, err)
+	}
+	releaseStake(userID, amount)
+}
+
+// stakeError turns a failed debit into a message for the player
+func stakeError(userID string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return i18n.Errorf("Insufficient balance! You have %d %s", database.GetBalance(userID), config.Bot().CurrencySymbol)
+	}
+	log.Printf("[LIMITS] Error taking stake of %s: %v", userID, err)
+	return i18n.Errorf("Error placing bet.")
+}
+
+// LimitsEmbed shows a user's limits, what they lost in each window and any
+// pending change
+func LimitsEmbed(loc i18n.Locale, userID string) *discordgo.MessageEmbed {
+	l, err := GetLimits(userID)
+	if err != nil {
+		log.Printf("[LIMITS] Error loading limits of %s: %v", userID, err)
+		return loc.ErrorEmbed("Could not check your gambling limits. Try again later.")
+	}
+
+	now := time.Now().UTC()
+	daily, _ := database.GetNetLoss(userID, now.Add(-24*time.Hour))
+	weekly, _ := database.GetNetLoss(userID, now.Add(-7*24*time.Hour))
+
+	limitValue := func(limit, lost int) string {
+		if limit <= 0 {
+			return loc.T("No limit")
+		}
+		return loc.T("%d %s (lost: %d)", limit, config.Bot().CurrencySymbol, max(lost, 0))
+	}
+	maxBet := loc.T("No limit")
+	if l.MaxBet > 0 {
+		maxBet = fmt.Sprintf("%d %s", l.MaxBet, config.Bot().CurrencySymbol)
+	}
+
+	embed := utils.InfoEmbed(loc.T("🛡️ Gambling Limits"), loc.T("Limits cap what you can lose. Tighter limits apply at once; looser ones only after %d hours.", int(CoolingOff().Hours())))
+	embed.Fields = []*discordgo.MessageEmbedField{
+		{Name: loc.T("📅 Daily Loss"), Value: limitValue(l.DailyLoss, daily), Inline: true},
+		{Name: loc.T("🗓️ Weekly Loss"), Value: limitValue(l.WeeklyLoss, weekly), Inline: true},
+		{Name: loc.T("🎯 Max Bet"), Value: maxBet, Inline: true},
+	}
+	if now.Before(l.ExcludedUntil) {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  loc.T("⛔ Self-Excluded"),
+			Value: loc.T("Until <t:%d:f> (<t:%d:R>)", l.ExcludedUntil.Unix(), l.ExcludedUntil.Unix()),
+		})
+	}
+	var pending []string
+	for n, kind := range limitKinds {
+		_, value, at := limitFields(l, kind)
+		if at.IsZero() {
+			continue
+		}
+		amount := loc.T("No limit")
+		if *value > 0 {
+			amount = fmt.Sprintf("%d %s", *value, config.Bot().CurrencySymbol)
+		}
+		pending = append(pending, fmt.Sprintf("%s: %s <t:%d:R>", embed.Fields[n].Name, amount, at.Unix()))
+	}
+	if len(pending) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  loc.T("⏳ Pending Change"),
+			Value: strings.Join(pending, "\n"),
+		})
+	}
+	return embed
+}
@@ -0,0 +1,115 @@
+package games
+
+import (
+	"estudocoin/internal/database"
+	"estudocoin/pkg/config"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// useTestDB points the database package at a fresh SQLite file
+func useTestDB(t *testing.T) {
+	t.Helper()
+	previous, previousType := database.DB, config.DBType
+	config.DBType = "sqlite"
+	db, err := database.NewSQLite(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("open test database: %v", err)
+	}
+	database.DB = db
+	t.Cleanup(func() {
+		db.Close()
+		database.DB, config.DBType = previous, previousType
+	})
+}
+
+func TestSetLimitCoolingOff(t *testing.T) {
+	useTestDB(t)
+	hours := config.Economy().Limits.CoolingOffHours
+	config.Economy().Limits.CoolingOffHours = 48
+	t.Cleanup(func() { config.Economy().Limits.CoolingOffHours = hours })
+
+	now := time.Now().UTC()
+	soon := now.Add(time.Hour).Truncate(time.Second)
+	tests := []struct {
+		name    string
+		start   database.GamblingLimits
+		amount  int
+		current int           // daily loss limit in force afterwards
+		pending int           // daily loss limit waiting for the cooling-off
+		wait    time.Duration // delay until the change applies, 0 = at once
+	}{
+		{"first limit applies at once", database.GamblingLimits{}, 500, 500, 0, 0},
+		{"tighter limit applies at once", database.GamblingLimits{DailyLoss: 500}, 200, 200, 0, 0},
+		{"looser limit waits", database.GamblingLimits{DailyLoss: 200}, 500, 200, 500, 48 * time.Hour},
+		{"removing a limit waits", database.GamblingLimits{DailyLoss: 200}, 0, 200, 0, 48 * time.Hour},
+		{"same limit changes nothing", database.GamblingLimits{DailyLoss: 200}, 200, 200, 0, 0},
+		{
+			"tightening drops a pending loosening",
+			database.GamblingLimits{DailyLoss: 200, PendingDailyLoss: 800, PendingDailyLossAt: soon},
+			150, 150, 0, 0,
+		},
+		{
+			"keeping the limit drops a pending loosening",
+			database.GamblingLimits{DailyLoss: 200, PendingDailyLoss: 800, PendingDailyLossAt: soon},
+			200, 200, 0, 0,
+		},
+		{
+			"looser limit restarts its own cooling-off",
+			database.GamblingLimits{DailyLoss: 200, PendingDailyLoss: 300, PendingDailyLossAt: soon},
+			800, 200, 800, 48 * time.Hour,
+		},
+		{
+			"expired change applies before the new one",
+			database.GamblingLimits{DailyLoss: 200, PendingDailyLoss: 800, PendingDailyLossAt: now.Add(-time.Hour)},
+			600, 600, 0, 0,
+		},
+	}
+	for n, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Every user also waits on a weekly loosening, which no change
+			// of the daily limit may touch
+			start := tt.start
+			start.UserID = fmt.Sprintf("user%d", n)
+			start.WeeklyLoss, start.PendingWeeklyLoss, start.PendingWeeklyLossAt = 1000, 2000, soon
+			if err := database.SaveGamblingLimits(&start); err != nil {
+				t.Fatalf("save limits: %v", err)
+			}
+
+			before := time.Now().UTC()
+			effective, err := SetLimit(start.UserID, LimitDailyLoss, tt.amount)
+			if err != nil {
+				t.Fatalf("SetLimit: %v", err)
+			}
+			if delay := effective.Sub(before); delay < tt.wait || delay > tt.wait+time.Minute {
+				t.Errorf("change applies in %v, want %v", delay, tt.wait)
+			}
+
+			l, err := database.GetGamblingLimits(start.UserID)
+			if err != nil {
+				t.Fatalf("get limits: %v", err)
+			}
+			if l.DailyLoss != tt.current || l.PendingDailyLoss != tt.pending {
+				t.Errorf("daily loss = %d (pending %d), want %d (pending %d)", l.DailyLoss, l.PendingDailyLoss, tt.current, tt.pending)
+			}
+			if waiting := !l.PendingDailyLossAt.IsZero(); waiting != (tt.wait > 0) {
+				t.Errorf("pending daily change = %v, want %v", waiting, tt.wait > 0)
+			}
+			if l.WeeklyLoss != 1000 || l.PendingWeeklyLoss != 2000 || !l.PendingWeeklyLossAt.Equal(soon) {
+				t.Errorf("weekly loss changed to %d (pending %d at %v)", l.WeeklyLoss, l.PendingWeeklyLoss, l.PendingWeeklyLossAt)
+			}
+		})
+	}
+}
+
+func TestSetLimitInvalid(t *testing.T) {
+	useTestDB(t)
+	if _, err := SetLimit("user", LimitMaxBet, -1); err == nil {
+		t.Fatal("SetLimit accepted a negative amount")
+	}
+	if _, err := SetLimit("user", "bogus", 10); err == nil {
+		t.Fatal("SetLimit accepted an unknown limit")
+	}
+}
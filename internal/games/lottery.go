@@ -0,0 +1,355 @@
+package games
+
+import (
+	"database/sql"
+	"estudocoin/internal/database"
+	"estudocoin/internal/i18n"
+	"estudocoin/pkg/config"
+	"estudocoin/pkg/utils"
+	"fmt"
+	"log"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Community lottery: tickets are sold during a period and the jackpot is
+// drawn when it ends. Every ticket carries a number; the holders of the
+// drawn number share the jackpot, and when nobody holds it the jackpot
+// rolls over to the next draw. Rounds and tickets live in the database, so
+// a restart keeps them and a draw missed while offline happens on startup.
+
+// Default lottery settings, used when economy.json leaves them at 0
+const (
+	defaultTicketPrice       = 100
+	defaultLotteryNumbers    = 100
+	defaultLotteryDrawHours  = 24
+	defaultLotteryMaxTickets = 100
+)
+
+var (
+	lotterySession *discordgo.Session
+	lotteryTicker  *time.Ticker
+	lotteryStop    chan bool
+	// lotteryMu keeps purchases out of a running draw
+	lotteryMu sync.Mutex
+)
+
+// lotteryRules returns config.Economy().Lottery with the defaults filled in
+func lotteryRules() config.LotteryConfig {
+	r := config.Economy().Lottery
+	if r.TicketPrice <= 0 {
+		r.TicketPrice = defaultTicketPrice
+	}
+	if r.Numbers <= 1 {
+		r.Numbers = defaultLotteryNumbers
+	}
+	if r.DrawHours <= 0 {
+		r.DrawHours = defaultLotteryDrawHours
+	}
+	if r.MaxTickets <= 0 {
+		r.MaxTickets = defaultLotteryMaxTickets
+	}
+	if r.HouseCutPercent < 0 || r.HouseCutPercent >= 100 {
+		r.HouseCutPercent = 0
+	}
+	return r
+}
+
+// lotteryChannel is where the draws are posted
+func lotteryChannel() string {
+	if config.Bot().LotteryChannelID != "" {
+		return config.Bot().LotteryChannelID
+	}
+	return config.Bot().RouletteChannelID
+}
+
+// StartLottery opens the first draw if there is none and checks every
+// minute whether the current one is due
+func StartLottery(s *discordgo.Session) {
+	if !config.Economy().Lottery.Enabled {
+		log.Println("Lottery is disabled in configuration")
+		return
+	}
+	if lotteryChannel() == "" {
+		log.Println("Lottery channel ID not configured. Set 'lottery_channel_id' or 'roulette_channel_id' in config.json")
+		return
+	}
+
+	lotterySession = s
+	lotteryStop = make(chan bool)
+
+	round, err := openLotteryRound()
+	if err != nil {
+		log.Printf("[LOTTERY ERROR] Could not open a draw: %v", err)
+		return
+	}
+	log.Printf("Starting Lottery (draw #%d at %s) in channel %s", round.ID, round.DrawAt.Format(time.RFC3339), lotteryChannel())
+
+	// A draw that came due while the bot was offline happens right away
+	checkLotteryDraw()
+
+	lotteryTicker = time.NewTicker(time.Minute)
+	go func() {
+		for {
+			select {
+			case <-lotteryTicker.C:
+				checkLotteryDraw()
+			case <-lotteryStop:
+				log.Println("Lottery stopped")
+				return
+			}
+		}
+	}()
+}
+
+func StopLottery() {
+	if lotteryTicker != nil {
+		lotteryTicker.Stop()
+		close(lotteryStop)
+		lotteryTicker = nil
+	}
+}
+
+// openLotteryRound returns the draw selling tickets, opening one if needed
+func openLotteryRound() (*database.LotteryRound, error) {
+	round, err := database.GetOpenLotteryRound()
+	if err == sql.ErrNoRows {
+		rules := lotteryRules()
+		return database.CreateLotteryRound(0, time.Now().Add(time.Duration(rules.DrawHours)*time.Hour))
+	}
+	return round, err
+}
+
+func checkLotteryDraw() {
+	lotteryMu.Lock()
+	defer lotteryMu.Unlock()
+
+	round, err := openLotteryRound()
+	if err != nil {
+		log.Printf("[LOTTERY ERROR] Could not load the current draw: %v", err)
+		return
+	}
+	if time.Now().Before(round.DrawAt) {
+		return
+	}
+	drawLottery(round)
+}
+
+// drawLottery picks the winning number, pays its holders (or rolls the
+// jackpot over) and opens the next draw. Caller holds lotteryMu.
+func drawLottery(round *database.LotteryRound) {
+	rules := lotteryRules()
+	tickets, err := database.GetLotteryTickets(round.ID)
+	if err != nil {
+		log.Printf("[LOTTERY ERROR] Could not load the tickets of draw #%d: %v", round.ID, err)
+		return
+	}
+
+	winning := rand.Intn(rules.Numbers) + 1
+
+	// Tickets per player, and winning tickets per winner, in buying order
+	var players, winners []string
+	bought := make(map[string]int)
+	matched := make(map[string]int)
+	for _, t := range tickets {
+		if bought[t.UserID] == 0 {
+			players = append(players, t.UserID)
+		}
+		bought[t.UserID]++
+		if t.Number == winning {
+			if matched[t.UserID] == 0 {
+				winners = append(winners, t.UserID)
+			}
+			matched[t.UserID]++
+		}
+	}
+
+	payouts := make(map[string]int)
+	nextJackpot := round.Jackpot
+	if len(winners) > 0 {
+		stakes := make([]int, len(winners))
+		for n, id := range winners {
+			stakes[n] = matched[id]
+		}
+		for n, share := range splitShares(round.Jackpot, stakes) {
+			payouts[winners[n]] = share
+		}
+		nextJackpot = 0
+	}
+
+	// The next draw is due one period after this one was, not after now, so
+	// the schedule doesn't drift; missed periods are skipped
+	interval := time.Duration(rules.DrawHours) * time.Hour
+	nextDrawAt := round.DrawAt.Add(interval)
+	for !nextDrawAt.After(time.Now()) {
+		nextDrawAt = nextDrawAt.Add(interval)
+	}
+
+	next, err := database.FinishLotteryRound(round.ID, winning, payouts, nextJackpot, nextDrawAt)
+	if err != nil {
+		log.Printf("[LOTTERY ERROR] Could not finish draw #%d: %v", round.ID, err)
+		return
+	}
+	log.Printf("[LOTTERY] Draw #%d: number %d, %d tickets, %d winners, jackpot %d", round.ID, winning, len(tickets), len(winners), round.Jackpot)
+
+	for _, id := range players {
+		recordResult(id, "lottery", bought[id]*rules.TicketPrice, payouts[id],
+			fmt.Sprintf("draw #%d: number %d, %d ticket(s), %d matching", round.ID, winning, bought[id], matched[id]))
+	}
+	postLotteryDraw(round, next, winning, len(tickets), winners, payouts)
+}
+
+func postLotteryDraw(round, next *database.LotteryRound, winning, sold int, winners []string, payouts map[string]int) {
+	channelID := lotteryChannel()
+	if lotterySession == nil || channelID == "" {
+		return
+	}
+	loc := i18n.ForChannel(lotterySession, channelID)
+
+	embed := &discordgo.MessageEmbed{
+		Title:       loc.T("🎟️ LOTTERY - Draw #%d", round.ID),
+		Description: loc.T("The winning number is... **%d**!", winning),
+		Color:       utils.ColorGold,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: loc.T("🎫 Tickets Sold"), Value: fmt.Sprintf("%d", sold), Inline: true},
+			{Name: loc.T("💰 Jackpot"), Value: fmt.Sprintf("%d %s", round.Jackpot, config.Bot().CurrencySymbol), Inline: true},
+		},
+		Footer:    &discordgo.MessageEmbedFooter{Text: loc.T("Next draw #%d | Buy tickets with !lottery buy <tickets>", next.ID)},
+		Timestamp: next.DrawAt.Format(time.RFC3339),
+	}
+
+	if len(winners) > 0 {
+		var lines []string
+		for _, id := range winners {
+			lines = append(lines, fmt.Sprintf("<@%s> - **%d %s**", id, payouts[id], config.Bot().CurrencySymbol))
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: loc.T("🏆 Winners"), Value: strings.Join(lines, "\n")})
+	} else {
+		embed.Color = utils.ColorRed
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  loc.T("💸 No winner"),
+			Value: loc.T("Nobody had number %d. The jackpot of **%d %s** rolls over to the next draw!", winning, next.Jackpot, config.Bot().CurrencySymbol),
+		})
+	}
+
+	lotterySession.ChannelMessageSendEmbed(channelID, embed)
+}
+
+// lotteryStake is what the user paid for tickets of the open draw, which
+// counts toward the loss limits until it is drawn
+func lotteryStake(userID string) (int, error) {
+	round, err := database.GetOpenLotteryRound()
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	numbers, err := database.GetUserLotteryNumbers(round.ID, userID)
+	return len(numbers) * lotteryRules().TicketPrice, err
+}
+
+// BuyLotteryTickets sells tickets of the current draw with random numbers
+// and returns the numbers
+func BuyLotteryTickets(userID string, count int) ([]int, error) {
+	rules := lotteryRules()
+	if !rules.Enabled {
+		return nil, i18n.Errorf("The lottery is closed.")
+	}
+	if count <= 0 {
+		return nil, i18n.Errorf("Invalid amount. Use a positive number.")
+	}
+
+	lotteryMu.Lock()
+	defer lotteryMu.Unlock()
+
+	round, err := openLotteryRound()
+	if err != nil {
+		log.Printf("[LOTTERY ERROR] Could not load the current draw: %v", err)
+		return nil, i18n.Errorf("The lottery is closed.")
+	}
+	owned, err := database.GetUserLotteryNumbers(round.ID, userID)
+	if err != nil {
+		return nil, i18n.Errorf("The lottery is closed.")
+	}
+	if len(owned)+count > rules.MaxTickets {
+		return nil, i18n.Errorf("You can have at most %d tickets per draw (you have %d).", rules.MaxTickets, len(owned))
+	}
+
+	cost := count * rules.TicketPrice
+	if balance := database.GetBalance(userID); balance < cost {
+		return nil, i18n.Errorf("Insufficient balance! You have %d %s", balance, config.Bot().CurrencySymbol)
+	}
+	// The tickets are paid inside the purchase transaction, under the stake
+	// lock so another bet can't slip past the limits meanwhile
+	defer lockStakes(userID)()
+	if err := CheckStake(userID, cost); err != nil {
+		return nil, err
+	}
+
+	numbers := make([]int, count)
+	for n := range numbers {
+		numbers[n] = rand.Intn(rules.Numbers) + 1
+	}
+	houseCut := int(float64(cost) * rules.HouseCutPercent / 100)
+	if err := database.BuyLotteryTickets(userID, round.ID, numbers, cost, houseCut); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, i18n.Errorf("Insufficient balance! You have %d %s", database.GetBalance(userID), config.Bot().CurrencySymbol)
+		}
+		log.Printf("[LOTTERY ERROR] Could not sell %d tickets to %s: %v", count, userID, err)
+		return nil, i18n.Errorf("Error placing bet.")
+	}
+	sort.Ints(numbers)
+	return numbers, nil
+}
+
+// LotteryEmbed shows the current draw and the tickets of a user
+func LotteryEmbed(loc i18n.Locale, userID string) *discordgo.MessageEmbed {
+	rules := lotteryRules()
+	if !rules.Enabled {
+		return loc.ErrorEmbed("The lottery is closed.")
+	}
+	round, err := database.GetOpenLotteryRound()
+	if err != nil {
+		return loc.ErrorEmbed("The lottery is closed.")
+	}
+	tickets, _ := database.GetLotteryTickets(round.ID)
+	numbers, _ := database.GetUserLotteryNumbers(round.ID, userID)
+
+	mine := loc.T("You have no tickets for this draw.")
+	if len(numbers) > 0 {
+		mine = FormatLotteryNumbers(numbers)
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       loc.T("🎟️ Lottery - Draw #%d", round.ID),
+		Description: loc.T("Each ticket gets a number from 1 to %d. The holders of the drawn number share the jackpot; if nobody has it, the jackpot rolls over!", rules.Numbers),
+		Color:       utils.ColorGold,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: loc.T("💰 Jackpot"), Value: fmt.Sprintf("%d %s", round.Jackpot, config.Bot().CurrencySymbol), Inline: true},
+			{Name: loc.T("⏰ Draw"), Value: fmt.Sprintf("<t:%d:R>", round.DrawAt.Unix()), Inline: true},
+			{Name: loc.T("🎫 Tickets Sold"), Value: fmt.Sprintf("%d", len(tickets)), Inline: true},
+			{Name: loc.T("🎫 Ticket Price"), Value: fmt.Sprintf("%d %s", rules.TicketPrice, config.Bot().CurrencySymbol), Inline: true},
+			{Name: loc.T("Your Tickets (%d/%d)", len(numbers), rules.MaxTickets), Value: mine},
+		},
+		Footer: &discordgo.MessageEmbedFooter{Text: loc.T("Buy tickets with !lottery buy <tickets>")},
+	}
+}
+
+// FormatLotteryNumbers lists ticket numbers, cut short for the embed limit
+func FormatLotteryNumbers(numbers []int) string {
+	parts := make([]string, 0, len(numbers))
+	for _, n := range numbers {
+		parts = append(parts, fmt.Sprintf("`%d`", n))
+	}
+	list := strings.Join(parts, " ")
+	if len(list) > 1000 {
+		list = list[:strings.LastIndex(list[:1000], " ")] + " …"
+	}
+	return list
+}
@@ -1,85 +1,389 @@
 package games
 
 import (
+	"errors"
+	"estudocoin/pkg/config"
+	"log"
 	"sync"
 	"time"
 )
 
-type GameJob struct {
-	UserID    string
-	Run       func(finishChan chan struct{})
-	OnQueue   func(position int)
+// FrozenMessage is shown when a frozen account tries to place a bet
+const FrozenMessage = "Your account is frozen. Contact a moderator."
+
+// GameSession is a running game tracked by the session manager.
+// Aviator, cups, blackjack, slots and Russian roulette implement it by
+// embedding a *session.
+type GameSession interface {
+	// Game is the name of the game ("aviator", "cups", ...)
+	Game() string
+	// Players are the users taking part
+	Players() []string
+	// Channel is where the game is played
+	Channel() string
+	// Done is closed when the game ends
+	Done() <-chan struct{}
+}
+
+// session is the GameSession bookkeeping shared by every game
+type session struct {
+	game      string
+	players   []string
+	playersMu sync.Mutex
+	channelID string
+	done      chan struct{}
+	once      sync.Once
+	// persistent sessions (poker tables) have no time limit; they end when
+	// their last player leaves
+	persistent bool
+}
+
+func newSession(game, channelID string, players ...string) *session {
+	return &session{game: game, players: players, channelID: channelID, done: make(chan struct{})}
+}
+
+func (s *session) Game() string          { return s.game }
+func (s *session) Channel() string       { return s.channelID }
+func (s *session) Done() <-chan struct{} { return s.done }
+
+// Players returns a copy, since players join and leave while the game runs
+func (s *session) Players() []string {
+	s.playersMu.Lock()
+	defer s.playersMu.Unlock()
+	return append([]string(nil), s.players...)
+}
+
+// addPlayer and removePlayer are only called by SessionManager.Join and
+// Leave, which hold the manager lock
+func (s *session) addPlayer(userID string) {
+	s.playersMu.Lock()
+	defer s.playersMu.Unlock()
+	s.players = append(s.players, userID)
+}
+
+func (s *session) removePlayer(userID string) bool {
+	s.playersMu.Lock()
+	defer s.playersMu.Unlock()
+	for i, id := range s.players {
+		if id == userID {
+			s.players = append(s.players[:i], s.players[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// timeLimit is how long the session keeps its slot (0 = until it ends)
+func (s *session) timeLimit() time.Duration {
+	if s.persistent {
+		return 0
+	}
+	return maxDuration()
+}
+
+// finish ends the session and frees its slot. Safe to call more than once.
+func (s *session) finish() {
+	s.once.Do(func() { close(s.done) })
+}
+
+// finished reports whether the session has ended
+func (s *session) finished() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
 }
 
+// Default limits, used when economy.json leaves them at 0
+const (
+	defaultMaxGames      = 50
+	defaultMaxPerUser    = 1
+	defaultMaxPerChannel = 5
+	defaultMaxDuration   = 15 * time.Minute
+)
+
 var (
-	jobQueue = make(chan GameJob, 100) // Buffer up to 100 games
-	queueLen = 0
-	queueMu  sync.Mutex
-	
-	// Mapa para rastrear usuários em jogos ativos
-	activePlayers = make(map[string]bool)
-	playersMu     sync.RWMutex
+	// ErrPlayerBusy means a player already has as many games as allowed
+	ErrPlayerBusy = errors.New("You already have a game running. Finish it first.")
+	// ErrNoRoom means the bot or the channel is full and the game can't wait
+	ErrNoRoom = errors.New("Too many games are running right now. Try again in a moment.")
 )
 
-func init() {
-	go processQueue()
+// GameJob is a game that can wait in line for a free slot
+type GameJob struct {
+	Session GameSession
+	// Run plays the game in its own goroutine; the game finishes its session when it ends
+	Run func()
+	// OnQueue is called when the game has to wait, with its position in line
+	OnQueue func(position int)
+}
+
+// SessionManager runs many games at the same time, bounded per user, per
+// channel and in total (see config.Economy().Sessions)
+type SessionManager struct {
+	mu        sync.Mutex
+	running   map[GameSession]bool
+	byUser    map[string]int // running + waiting games of each user
+	byChannel map[string]int // running games of each channel
+	queue     []GameJob
+	released  chan struct{} // closed (and replaced) whenever a game frees its slot
+}
+
+// Sessions is the session manager of every game
+var Sessions = &SessionManager{
+	running:   make(map[GameSession]bool),
+	byUser:    make(map[string]int),
+	byChannel: make(map[string]int),
+	released:  make(chan struct{}),
+}
+
+func sessionLimits() (total, perUser, perChannel int) {
+	c := config.Economy().Sessions
+	total, perUser, perChannel = c.MaxGames, c.MaxPerUser, c.MaxPerChannel
+	if total <= 0 {
+		total = defaultMaxGames
+	}
+	if perUser <= 0 {
+		perUser = defaultMaxPerUser
+	}
+	if perChannel <= 0 {
+		perChannel = defaultMaxPerChannel
+	}
+	return total, perUser, perChannel
+}
+
+// Start runs a game right away. It fails with ErrPlayerBusy or ErrNoRoom
+// instead of waiting; use Enqueue for games that can wait. run may be nil
+// for games driven only by button clicks.
+func (m *SessionManager) Start(gs GameSession, run func()) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkPlayers(gs); err != nil {
+		return err
+	}
+	if !m.hasRoom(gs) {
+		return ErrNoRoom
+	}
+	m.reserve(gs)
+	m.run(GameJob{Session: gs, Run: run})
+	return nil
+}
+
+// Join adds a player to a running multiplayer game. It fails with
+// ErrPlayerBusy when the player can't take another game.
+func (m *SessionManager) Join(gs GameSession, userID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := gs.(interface{ addPlayer(string) })
+	if !ok || !m.running[gs] {
+		return ErrNoRoom
+	}
+	_, perUser, _ := sessionLimits()
+	if m.byUser[userID] >= perUser {
+		return ErrPlayerBusy
+	}
+	m.byUser[userID]++
+	s.addPlayer(userID)
+	return nil
+}
+
+// Leave removes a player from a running multiplayer game
+func (m *SessionManager) Leave(gs GameSession, userID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := gs.(interface{ removePlayer(string) bool })
+	if !ok || !m.running[gs] || !s.removePlayer(userID) {
+		return
+	}
+	if m.byUser[userID]--; m.byUser[userID] <= 0 {
+		delete(m.byUser, userID)
+	}
+	close(m.released)
+	m.released = make(chan struct{})
 }
 
-func Enqueue(job GameJob) {
-	queueMu.Lock()
-	currentLen := len(jobQueue)
-	queueMu.Unlock()
+// Enqueue runs a game as soon as the bot and the channel have room.
+// Only the player limit is checked up front.
+func (m *SessionManager) Enqueue(job GameJob) error {
+	m.mu.Lock()
+
+	if err := m.checkPlayers(job.Session); err != nil {
+		m.mu.Unlock()
+		return err
+	}
+	m.reserve(job.Session)
+	if len(m.queue) == 0 && m.hasRoom(job.Session) {
+		m.run(job)
+		m.mu.Unlock()
+		return nil
+	}
+	m.queue = append(m.queue, job)
+	position := len(m.queue)
+	m.mu.Unlock()
 
-	// Notify user of their position
-	if currentLen > 0 && job.OnQueue != nil {
-		job.OnQueue(currentLen)
+	if job.OnQueue != nil {
+		job.OnQueue(position)
 	}
+	return nil
+}
 
-	jobQueue <- job
+// checkPlayers fails if a player can't take another game. Caller holds m.mu.
+func (m *SessionManager) checkPlayers(gs GameSession) error {
+	_, perUser, _ := sessionLimits()
+	for _, id := range gs.Players() {
+		if m.byUser[id] >= perUser {
+			return ErrPlayerBusy
+		}
+	}
+	return nil
 }
 
-func processQueue() {
-	for job := range jobQueue {
-		// Marcar usuário como em jogo
-		playersMu.Lock()
-		activePlayers[job.UserID] = true
-		playersMu.Unlock()
-		
-		// Create a channel to wait for this specific game to finish
-		finishChan := make(chan struct{})
-		
-		// Run the game logic
-		go job.Run(finishChan)
+// hasRoom reports whether the bot and the channel can run one more game.
+// Caller holds m.mu.
+func (m *SessionManager) hasRoom(gs GameSession) bool {
+	total, _, perChannel := sessionLimits()
+	return len(m.running) < total && m.byChannel[gs.Channel()] < perChannel
+}
+
+func (m *SessionManager) reserve(gs GameSession) {
+	for _, id := range gs.Players() {
+		m.byUser[id]++
+	}
+}
+
+// maxDuration is how long a game may run. Games waiting for a click that
+// never comes (blackjack, slots, roulette) would otherwise block their
+// players forever, so past it the game is asked to end (see expirer).
+func maxDuration() time.Duration {
+	if minutes := config.Economy().Sessions.MaxMinutes; minutes > 0 {
+		return time.Duration(minutes) * time.Minute
+	}
+	return defaultMaxDuration
+}
+
+// expirer is implemented by games that can be wrapped up early when they
+// outlive their time limit. expire must end the session.
+type expirer interface {
+	expire()
+}
+
+// run starts a job and frees its slot when the game ends. Caller holds m.mu.
+func (m *SessionManager) run(job GameJob) {
+	gs := job.Session
+	m.running[gs] = true
+	m.byChannel[gs.Channel()]++
+
+	limit := maxDuration()
+	if s, ok := gs.(interface{ timeLimit() time.Duration }); ok {
+		limit = s.timeLimit()
+	}
 
-		// Wait here until the game signals it is done
-		<-finishChan
-		
-		// Remover usuário dos jogos ativos
-		playersMu.Lock()
-		delete(activePlayers, job.UserID)
-		playersMu.Unlock()
+	if job.Run != nil {
+		go job.Run()
+	}
+	go func() {
+		var timeout <-chan time.Time
+		if limit > 0 {
+			timer := time.NewTimer(limit)
+			defer timer.Stop()
+			timeout = timer.C
+		}
+		select {
+		case <-gs.Done():
+		case <-timeout:
+			log.Printf("[SESSIONS] %s game of %v in %s still open after %s, ending it", gs.Game(), gs.Players(), gs.Channel(), limit)
+			if e, ok := gs.(expirer); ok {
+				e.expire()
+			}
+			// The slot stays taken until the game is really over, so no
+			// other game can start on top of it
+			<-gs.Done()
+		}
+		m.release(gs)
+	}()
+}
+
+// release forgets a finished game and starts the waiting games that now fit
+func (m *SessionManager) release(gs GameSession) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.running, gs)
+	close(m.released)
+	m.released = make(chan struct{})
+	if m.byChannel[gs.Channel()]--; m.byChannel[gs.Channel()] <= 0 {
+		delete(m.byChannel, gs.Channel())
+	}
+	for _, id := range gs.Players() {
+		if m.byUser[id]--; m.byUser[id] <= 0 {
+			delete(m.byUser, id)
+		}
+	}
+
+	// Games of a full channel keep their place; later games of other channels may pass
+	waiting := m.queue[:0]
+	for _, job := range m.queue {
+		if m.hasRoom(job.Session) {
+			m.run(job)
+		} else {
+			waiting = append(waiting, job)
+		}
+	}
+	m.queue = waiting
+}
+
+// playing reports whether a user has a running game, along with a channel
+// closed when the next game frees its slot
+func (m *SessionManager) playing(userID string) (bool, <-chan struct{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for gs := range m.running {
+		for _, id := range gs.Players() {
+			if id == userID {
+				return true, m.released
+			}
+		}
+	}
+	return false, m.released
+}
+
+// find returns the running game of a user that matches, or nil
+func (m *SessionManager) find(userID string, match func(GameSession) bool) GameSession {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for gs := range m.running {
+		if !match(gs) {
+			continue
+		}
+		for _, id := range gs.Players() {
+			if id == userID {
+				return gs
+			}
+		}
 	}
+	return nil
 }
 
-// IsUserInGame verifica se um usuário está em um jogo ativo
+// IsUserInGame reports whether a user is playing a game right now
 func IsUserInGame(userID string) bool {
-	playersMu.RLock()
-	defer playersMu.RUnlock()
-	return activePlayers[userID]
+	inGame, _ := Sessions.playing(userID)
+	return inGame
 }
 
-// WaitForGameFinish espera o usuário terminar o jogo atual
+// WaitForGameFinish blocks until the user has no running game
 func WaitForGameFinish(userID string) {
 	for {
-		playersMu.RLock()
-		inGame := activePlayers[userID]
-		playersMu.RUnlock()
-		
+		inGame, released := Sessions.playing(userID)
 		if !inGame {
 			return
 		}
-		
-		// Esperar um pouco antes de verificar novamente
-		time.Sleep(500 * time.Millisecond)
+		<-released
 	}
 }
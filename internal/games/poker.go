@@ -0,0 +1,1014 @@
+package games
+
+import (
+	"estudocoin/internal/database"
+	"estudocoin/internal/i18n"
+	"estudocoin/pkg/config"
+	"fmt"
+	"log"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Texas Hold'em tables. Players buy in with coins that become chips on the
+// table and go back to their balance when they stand up. Hands are dealt
+// one after the other while at least two players are seated; hole cards are
+// shown only to their owner with the 🃏 button.
+
+const (
+	pokerButton     = "poker_"            // poker_<action>_<channelID>
+	pokerRaiseModal = "poker_raisemodal_" // poker_raisemodal_<channelID>
+
+	// nextHandDelay lets players read the result before the next hand
+	nextHandDelay = 8 * time.Second
+	// maxPokerTimeouts is how many turns in a row a player can miss before
+	// being stood up at the end of the hand
+	maxPokerTimeouts = 2
+)
+
+// Default poker rules, used when economy.json leaves them at 0
+const (
+	defaultSmallBlind       = 10
+	defaultPokerSeats       = 6
+	maxPokerSeats           = 9
+	defaultPokerTurnSeconds = 45
+)
+
+const (
+	streetPreflop = iota
+	streetFlop
+	streetTurn
+	streetRiver
+	streetShowdown
+)
+
+type pokerPlayer struct {
+	UserID   string
+	Username string
+	Stack    int
+	BuyIn    int // coins brought to the table, for the result of the session
+	Hands    int // hands dealt in, for the game history
+	Hole     []Card
+	Bet      int // chips put in during the current betting round
+	Total    int // chips put in during the hand
+	InHand   bool
+	Folded   bool
+	AllIn    bool
+	Acted    bool // acted since the last full raise
+	Leaving  bool // stands up when the hand ends
+	timeouts int
+	shown    string // hand shown at showdown
+}
+
+// canAct reports whether the player still makes decisions this hand
+func (p *pokerPlayer) canAct() bool {
+	return p.InHand && !p.Folded && !p.AllIn
+}
+
+// pot is a main or side pot and the players who can win it
+type pot struct {
+	Amount   int
+	Eligible []*pokerPlayer
+}
+
+type pokerTable struct {
+	*session
+	s          *discordgo.Session
+	loc        i18n.Locale
+	rules      config.PokerConfig
+	messageID  string
+	players    []*pokerPlayer // seat order
+	handNo     int
+	running    bool // a hand is being played
+	scheduled  bool // the next hand is about to start
+	closed     bool // the last player stood up
+	button     int
+	deck       []Card
+	board      []Card
+	street     int
+	turn       int
+	currentBet int
+	minRaise   int
+	actions    []string // what happened this hand, newest last
+	results    []string
+	timer      *time.Timer
+	turnSeq    int
+	mu         sync.Mutex
+}
+
+var (
+	pokerTables   = make(map[string]*pokerTable) // channelID -> table
+	pokerTablesMu sync.Mutex
+)
+
+// PokerRules returns config.Economy().Poker with the defaults filled in
+func PokerRules() config.PokerConfig {
+	r := config.Economy().Poker
+	if r.SmallBlind <= 0 {
+		r.SmallBlind = defaultSmallBlind
+	}
+	if r.BigBlind < r.SmallBlind {
+		r.BigBlind = r.SmallBlind * 2
+	}
+	if r.MinBuyIn <= 0 {
+		r.MinBuyIn = r.BigBlind * 20
+	}
+	if r.MaxBuyIn < r.MinBuyIn {
+		r.MaxBuyIn = r.BigBlind * 100
+		if r.MaxBuyIn < r.MinBuyIn {
+			r.MaxBuyIn = r.MinBuyIn
+		}
+	}
+	if r.MaxSeats <= 1 {
+		r.MaxSeats = defaultPokerSeats
+	}
+	if r.MaxSeats > maxPokerSeats {
+		r.MaxSeats = maxPokerSeats
+	}
+	if r.TurnSeconds <= 0 {
+		r.TurnSeconds = defaultPokerTurnSeconds
+	}
+	return r
+}
+
+// JoinPokerTable buys a player into the table of the channel, opening one
+// when there is none. Players who sit during a hand play from the next one.
+func JoinPokerTable(s *discordgo.Session, channelID string, user *discordgo.User, buyIn int) error {
+	rules := PokerRules()
+	if buyIn < rules.MinBuyIn || buyIn > rules.MaxBuyIn {
+		return i18n.Errorf("The buy-in must be between %d and %d %s.", rules.MinBuyIn, rules.MaxBuyIn, config.Bot().CurrencySymbol)
+	}
+	if balance := database.GetBalance(user.ID); balance < buyIn {
+		return i18n.Errorf("Insufficient balance! You have %d %s", balance, config.Bot().CurrencySymbol)
+	}
+
+	pokerTablesMu.Lock()
+	table, exists := pokerTables[channelID]
+	if !exists {
+		table = &pokerTable{
+			session: newSession("poker", channelID, user.ID),
+			s:       s,
+			loc:     i18n.ForChannel(s, channelID),
+			rules:   rules,
+		}
+		table.persistent = true
+		table.mu.Lock()
+		if err := Sessions.Start(table, nil); err != nil {
+			table.mu.Unlock()
+			pokerTablesMu.Unlock()
+			return err
+		}
+		pokerTables[channelID] = table
+		pokerTablesMu.Unlock()
+	} else {
+		pokerTablesMu.Unlock()
+		table.mu.Lock()
+	}
+	defer table.mu.Unlock()
+
+	if table.closed {
+		return i18n.Errorf("This table is closing, try again in a moment.")
+	}
+	if table.player(user.ID) != nil {
+		return i18n.Errorf("You are already sitting at this table.")
+	}
+	if len(table.players) >= table.rules.MaxSeats {
+		return i18n.Errorf("The table is full.")
+	}
+	if exists {
+		if err := Sessions.Join(table, user.ID); err != nil {
+			return err
+		}
+	}
+	if err := escrowStake(user.ID, buyIn); err != nil {
+		if exists {
+			Sessions.Leave(table, user.ID)
+		} else {
+			table.closed = true
+			go table.close()
+		}
+		return err
+	}
+
+	// Seats are only appended, so the indexes of a running hand stay valid
+	table.players = append(table.players, &pokerPlayer{UserID: user.ID, Username: user.Username, Stack: buyIn, BuyIn: buyIn})
+	table.log(table.loc.T("%s sat down with %d.", user.Username, buyIn))
+	if !table.running {
+		table.scheduleHand()
+	}
+	table.update(nil)
+	return nil
+}
+
+// LeavePokerTable stands a player up and returns their chips to their
+// balance. During a hand the player folds and leaves when it ends; later
+// is then true and no chips are returned yet.
+func LeavePokerTable(channelID, userID string) (stack int, later bool, err error) {
+	pokerTablesMu.Lock()
+	table, exists := pokerTables[channelID]
+	pokerTablesMu.Unlock()
+	if !exists {
+		return 0, false, i18n.Errorf("You are not sitting at a poker table in this channel.")
+	}
+
+	table.mu.Lock()
+	defer table.mu.Unlock()
+
+	p := table.player(userID)
+	if p == nil {
+		return 0, false, i18n.Errorf("You are not sitting at a poker table in this channel.")
+	}
+	if table.running {
+		p.Leaving = true
+		// All-in players keep their share of the pot
+		if p.canAct() {
+			p.Folded = true
+			table.log(table.loc.T("%s folds and leaves the table.", p.Username))
+			if table.players[table.turn] == p || table.liveCount() == 1 {
+				table.proceed()
+			}
+			table.update(nil)
+		}
+		return 0, true, nil
+	}
+
+	stack = p.Stack
+	table.standUp(p)
+	table.update(nil)
+	return stack, false, nil
+}
+
+func (t *pokerTable) player(userID string) *pokerPlayer {
+	for _, p := range t.players {
+		if p.UserID == userID {
+			return p
+		}
+	}
+	return nil
+}
+
+// log records an action of the hand. Caller holds t.mu.
+func (t *pokerTable) log(action string) {
+	t.actions = append(t.actions, action)
+	if len(t.actions) > 5 {
+		t.actions = t.actions[len(t.actions)-5:]
+	}
+}
+
+// standUp returns a player's chips and removes their seat. Only called
+// between hands. Caller holds t.mu.
+func (t *pokerTable) standUp(p *pokerPlayer) {
+	if p.Stack > 0 {
+		if err := database.AddCoins(p.UserID, p.Stack); err != nil {
+			log.Printf("[POKER ERROR] Failed to return %d chips to %s: %v", p.Stack, p.UserID, err)
+		}
+	}
+	reportResult(p.UserID, "poker", p.BuyIn, p.Stack, fmt.Sprintf("%d hands played", p.Hands))
+
+	for i, seated := range t.players {
+		if seated == p {
+			t.players = append(t.players[:i], t.players[i+1:]...)
+			if t.button >= i && t.button > 0 {
+				t.button--
+			}
+			break
+		}
+	}
+	Sessions.Leave(t, p.UserID)
+	if len(t.players) == 0 {
+		t.closed = true
+		go t.close()
+	}
+}
+
+// scheduleHand starts the next hand after a short pause when enough players
+// are seated. Caller holds t.mu.
+func (t *pokerTable) scheduleHand() {
+	if t.scheduled || len(t.players) < 2 {
+		return
+	}
+	t.scheduled = true
+	time.AfterFunc(nextHandDelay, func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		t.scheduled = false
+		if !t.running {
+			t.startHand()
+		}
+	})
+}
+
+// startHand posts the blinds, deals the hole cards and posts a new table
+// message. Caller holds t.mu.
+func (t *pokerTable) startHand() {
+	if len(t.players) < 2 {
+		return
+	}
+	n := len(t.players)
+	t.handNo++
+	t.running = true
+	t.rules = PokerRules()
+	t.board, t.actions, t.results = nil, nil, nil
+	t.street = streetPreflop
+
+	t.deck = createDeck()
+	rand.Shuffle(len(t.deck), func(i, j int) { t.deck[i], t.deck[j] = t.deck[j], t.deck[i] })
+
+	for _, p := range t.players {
+		p.Hole, p.Bet, p.Total = nil, 0, 0
+		p.InHand, p.Folded, p.AllIn, p.Acted = true, false, false, false
+		p.Hands++
+		p.shown = ""
+	}
+
+	// Heads-up the button posts the small blind
+	t.button = (t.button + 1) % n
+	sb, bb := (t.button+1)%n, (t.button+2)%n
+	if n == 2 {
+		sb, bb = t.button, (t.button+1)%n
+	}
+	t.put(t.players[sb], t.rules.SmallBlind)
+	t.put(t.players[bb], t.rules.BigBlind)
+	t.currentBet, t.minRaise = t.rules.BigBlind, t.rules.BigBlind
+	t.log(t.loc.T("%s posts the small blind (%d), %s the big blind (%d).", t.players[sb].Username, t.players[sb].Bet, t.players[bb].Username, t.players[bb].Bet))
+
+	for round := 0; round < 2; round++ {
+		for k := 1; k <= n; k++ {
+			p := t.players[(t.button+k)%n]
+			p.Hole = append(p.Hole, t.dealCard())
+		}
+	}
+
+	// Blinds can put everyone all-in, so the hand may already be over
+	t.messageID = ""
+	t.turn = bb
+	t.proceed()
+
+	msg, err := t.s.ChannelMessageSendComplex(t.channelID, &discordgo.MessageSend{
+		Embeds:     []*discordgo.MessageEmbed{t.embed()},
+		Components: t.components(),
+	})
+	if err != nil {
+		log.Printf("[POKER ERROR] Could not post hand #%d of %s: %v", t.handNo, t.channelID, err)
+		return
+	}
+	t.messageID = msg.ID
+}
+
+func (t *pokerTable) dealCard() Card {
+	card := t.deck[0]
+	t.deck = t.deck[1:]
+	return card
+}
+
+// put moves chips from a stack to the pot. Caller holds t.mu.
+func (t *pokerTable) put(p *pokerPlayer, amount int) {
+	if amount > p.Stack {
+		amount = p.Stack
+	}
+	p.Stack -= amount
+	p.Bet += amount
+	p.Total += amount
+	if p.Stack == 0 {
+		p.AllIn = true
+	}
+}
+
+func (t *pokerTable) liveCount() int {
+	live := 0
+	for _, p := range t.players {
+		if p.InHand && !p.Folded {
+			live++
+		}
+	}
+	return live
+}
+
+func (t *pokerTable) potTotal() int {
+	total := 0
+	for _, p := range t.players {
+		total += p.Total
+	}
+	return total
+}
+
+// nextToAct finds the next player after seat from who still has to act,
+// or -1 when the betting round is over. Caller holds t.mu.
+func (t *pokerTable) nextToAct(from int) int {
+	n := len(t.players)
+	for k := 1; k <= n; k++ {
+		i := (from + k) % n
+		p := t.players[i]
+		if p.canAct() && (!p.Acted || p.Bet < t.currentBet) {
+			return i
+		}
+	}
+	return -1
+}
+
+// proceed moves the hand on after an action: to the next player, the next
+// street, or the end of the hand. Caller holds t.mu.
+func (t *pokerTable) proceed() {
+	if t.liveCount() == 1 {
+		t.endHand()
+		return
+	}
+	if next := t.nextToAct(t.turn); next >= 0 {
+		t.turn = next
+		t.armTimer()
+		return
+	}
+
+	for t.street < streetRiver {
+		t.nextStreet()
+		canAct := 0
+		for _, p := range t.players {
+			if p.canAct() {
+				canAct++
+			}
+		}
+		// With one player or less left to bet, the board is just run out
+		if canAct >= 2 {
+			if next := t.nextToAct(t.button); next >= 0 {
+				t.turn = next
+				t.armTimer()
+				return
+			}
+		}
+	}
+	t.endHand()
+}
+
+// nextStreet deals the flop, turn or river. Caller holds t.mu.
+func (t *pokerTable) nextStreet() {
+	for _, p := range t.players {
+		p.Bet, p.Acted = 0, false
+	}
+	t.currentBet, t.minRaise = 0, t.rules.BigBlind
+
+	cards := 1
+	if t.street == streetPreflop {
+		cards = 3
+	}
+	for c := 0; c < cards; c++ {
+		t.board = append(t.board, t.dealCard())
+	}
+	t.street++
+}
+
+// act plays a decision of the player whose turn it is. raiseTo is the total
+// bet of the player after a raise. Caller holds t.mu.
+func (t *pokerTable) act(p *pokerPlayer, action string, raiseTo int) error {
+	toCall := t.currentBet - p.Bet
+
+	switch action {
+	case "fold":
+		p.Folded = true
+		t.log(t.loc.T("%s folds.", p.Username))
+	case "call":
+		if toCall == 0 {
+			t.log(t.loc.T("%s checks.", p.Username))
+			break
+		}
+		t.put(p, toCall)
+		t.log(t.loc.T("%s calls %d.", p.Username, p.Bet))
+	case "raise":
+		if raiseTo >= p.Bet+p.Stack {
+			return t.act(p, "allin", 0)
+		}
+		if min := t.currentBet + t.minRaise; raiseTo < min {
+			return i18n.Errorf("The minimum raise is to %d.", min)
+		}
+		t.raise(p, raiseTo)
+		t.log(t.loc.T("%s raises to %d.", p.Username, p.Bet))
+	case "allin":
+		if p.Bet+p.Stack <= t.currentBet {
+			t.put(p, p.Stack)
+		} else {
+			t.raise(p, p.Bet+p.Stack)
+		}
+		t.log(t.loc.T("%s is all-in with %d.", p.Username, p.Bet))
+	default:
+		return i18n.Errorf("Unknown action.")
+	}
+
+	p.Acted = true
+	p.timeouts = 0
+	return nil
+}
+
+// raise bets up to a new total. A raise smaller than the last one (an
+// all-in short of a full raise) does not change the minimum raise.
+// Caller holds t.mu.
+func (t *pokerTable) raise(p *pokerPlayer, to int) {
+	previous := t.currentBet
+	t.put(p, to-p.Bet)
+	if p.Bet <= previous {
+		return
+	}
+	// Only a full raise reopens the betting; the others still have to
+	// call a short all-in
+	full := p.Bet-previous >= t.minRaise
+	t.currentBet = p.Bet
+	if !full {
+		return
+	}
+	t.minRaise = p.Bet - previous
+	for _, other := range t.players {
+		if other != p {
+			other.Acted = false
+		}
+	}
+}
+
+// returnUncalled gives back the part of the biggest bet nobody matched.
+// Caller holds t.mu.
+func (t *pokerTable) returnUncalled() {
+	var top *pokerPlayer
+	second := 0
+	for _, p := range t.players {
+		switch {
+		case top == nil || p.Total > top.Total:
+			if top != nil {
+				second = top.Total
+			}
+			top = p
+		case p.Total > second:
+			second = p.Total
+		}
+	}
+	if top == nil || top.Total <= second {
+		return
+	}
+	excess := top.Total - second
+	top.Total -= excess
+	top.Bet = max(top.Bet-excess, 0)
+	top.Stack += excess
+	if top.Stack > 0 {
+		top.AllIn = false
+	}
+}
+
+// pots splits the chips of the hand into the main pot and side pots.
+// Caller holds t.mu.
+func (t *pokerTable) pots() []pot {
+	var levels []int
+	for _, p := range t.players {
+		if p.InHand && !p.Folded && p.Total > 0 && !containsInt(levels, p.Total) {
+			levels = append(levels, p.Total)
+		}
+	}
+	sort.Ints(levels)
+
+	var pots []pot
+	previous := 0
+	for i, level := range levels {
+		var current pot
+		for _, p := range t.players {
+			current.Amount += clampInt(p.Total, level) - clampInt(p.Total, previous)
+			// Chips of folded players above the last level go to the last pot
+			if i == len(levels)-1 && p.Total > level {
+				current.Amount += p.Total - level
+			}
+			if p.InHand && !p.Folded && p.Total >= level {
+				current.Eligible = append(current.Eligible, p)
+			}
+		}
+		if current.Amount > 0 {
+			pots = append(pots, current)
+		}
+		previous = level
+	}
+	return pots
+}
+
+// rake is the part of the pot kept by the bot. Hands that end before the
+// flop pay no rake. Caller holds t.mu.
+func (t *pokerTable) rake(total int) int {
+	if t.street == streetPreflop || t.rules.RakePercent <= 0 {
+		return 0
+	}
+	rake := int(float64(total) * t.rules.RakePercent / 100)
+	if t.rules.RakeCap > 0 && rake > t.rules.RakeCap {
+		rake = t.rules.RakeCap
+	}
+	return rake
+}
+
+// endHand pays the pots (at showdown when more than one player is left),
+// stands up the players who are leaving and schedules the next hand.
+// Caller holds t.mu.
+func (t *pokerTable) endHand() {
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+
+	t.returnUncalled()
+	pots := t.pots()
+	total := t.potTotal()
+	rake := t.rake(total)
+	if rake > 0 {
+		if err := database.PayBot(rake); err != nil {
+			log.Printf("[POKER ERROR] Failed to pay %d of rake: %v", rake, err)
+		}
+		// The rake comes out of the main pot first
+		left := rake
+		for i := range pots {
+			take := clampInt(pots[i].Amount, left)
+			pots[i].Amount -= take
+			left -= take
+		}
+	}
+
+	if t.liveCount() == 1 {
+		for _, p := range t.players {
+			if p.InHand && !p.Folded {
+				p.Stack += total - rake
+				t.results = append(t.results, t.loc.T("🏆 %s wins %d.", p.Username, total-rake))
+			}
+		}
+	} else {
+		t.street = streetShowdown
+		ranks := make(map[*pokerPlayer]handRank)
+		for _, p := range t.players {
+			if p.InHand && !p.Folded {
+				ranks[p] = bestHand(append(append([]Card(nil), p.Hole...), t.board...))
+				p.shown = t.loc.T(ranks[p].name())
+			}
+		}
+		for n, pt := range pots {
+			winners := potWinners(pt.Eligible, ranks)
+			if len(winners) == 0 {
+				continue
+			}
+			share, odd := pt.Amount/len(winners), pt.Amount%len(winners)
+			names := make([]string, len(winners))
+			for i, w := range t.fromButton(winners) {
+				w.Stack += share
+				if i == 0 {
+					w.Stack += odd // Odd chips go to the first winner after the button
+				}
+				names[i] = w.Username
+			}
+			label := t.loc.T("Main pot")
+			if n > 0 {
+				label = t.loc.T("Side pot %d", n)
+			}
+			t.results = append(t.results, t.loc.T("🏆 %s: %s wins %d with %s.", label, strings.Join(names, ", "), pt.Amount, winners[0].shown))
+		}
+	}
+	if rake > 0 {
+		t.results = append(t.results, t.loc.T("Rake: %d", rake))
+	}
+
+	t.running = false
+	t.update(nil)
+
+	// Players who asked to leave, timed out too often or ran out of chips
+	// stand up before the next hand
+	for _, p := range append([]*pokerPlayer(nil), t.players...) {
+		if p.Leaving || p.Stack == 0 {
+			t.standUp(p)
+		}
+	}
+	t.scheduleHand()
+}
+
+// potWinners returns the eligible players with the best hand
+func potWinners(eligible []*pokerPlayer, ranks map[*pokerPlayer]handRank) []*pokerPlayer {
+	var winners []*pokerPlayer
+	for _, p := range eligible {
+		rank, ok := ranks[p]
+		if !ok {
+			continue
+		}
+		switch {
+		case len(winners) == 0:
+			winners = []*pokerPlayer{p}
+		case rank.compare(ranks[winners[0]]) > 0:
+			winners = []*pokerPlayer{p}
+		case rank.compare(ranks[winners[0]]) == 0:
+			winners = append(winners, p)
+		}
+	}
+	return winners
+}
+
+// fromButton orders players by seat, starting after the button. Caller holds t.mu.
+func (t *pokerTable) fromButton(players []*pokerPlayer) []*pokerPlayer {
+	var ordered []*pokerPlayer
+	n := len(t.players)
+	for k := 1; k <= n; k++ {
+		seated := t.players[(t.button+k)%n]
+		for _, p := range players {
+			if p == seated {
+				ordered = append(ordered, p)
+			}
+		}
+	}
+	return ordered
+}
+
+// armTimer checks or folds for the player whose turn it is when they don't
+// act in time. Caller holds t.mu.
+func (t *pokerTable) armTimer() {
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+	t.turnSeq++
+	seq := t.turnSeq
+	t.timer = time.AfterFunc(time.Duration(t.rules.TurnSeconds)*time.Second, func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if !t.running || t.turnSeq != seq {
+			return
+		}
+		p := t.players[t.turn]
+		action := "fold"
+		if p.Bet == t.currentBet {
+			action = "call"
+		}
+		t.act(p, action, 0)
+		if p.timeouts = p.timeouts + 1; p.timeouts >= maxPokerTimeouts {
+			p.Leaving = true
+		}
+		t.log(t.loc.T("⏰ %s ran out of time.", p.Username))
+		t.proceed()
+		t.update(nil)
+	})
+}
+
+// close frees the table of the channel
+func (t *pokerTable) close() {
+	pokerTablesMu.Lock()
+	if pokerTables[t.channelID] == t {
+		delete(pokerTables, t.channelID)
+	}
+	pokerTablesMu.Unlock()
+	t.finish()
+}
+
+// HandlePokerButton handles the buttons of the table message
+func HandlePokerButton(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	loc := i18n.ForInteraction(i)
+	parts := strings.SplitN(strings.TrimPrefix(i.MessageComponentData().CustomID, pokerButton), "_", 2)
+	if len(parts) != 2 {
+		return
+	}
+	action, channelID := parts[0], parts[1]
+
+	pokerTablesMu.Lock()
+	table, exists := pokerTables[channelID]
+	pokerTablesMu.Unlock()
+	if !exists {
+		respondEmbed(s, i, loc.ErrorEmbed("No active game found!"))
+		return
+	}
+
+	table.mu.Lock()
+	defer table.mu.Unlock()
+
+	p := table.player(i.Member.User.ID)
+	if p == nil || !p.InHand || !table.running {
+		respondEmbed(s, i, loc.ErrorEmbed("You are not playing this hand."))
+		return
+	}
+
+	if action == "cards" {
+		respondEmbed(s, i, table.holeCardsEmbed(loc, p))
+		return
+	}
+	if table.players[table.turn] != p {
+		respondEmbed(s, i, loc.ErrorEmbed("It's not your turn."))
+		return
+	}
+
+	if action == "raise" {
+		min := table.currentBet + table.minRaise
+		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseModal,
+			Data: pokerRaiseModalData(loc, channelID, min, p.Bet+p.Stack),
+		})
+		return
+	}
+
+	if err := table.act(p, action, 0); err != nil {
+		respondEmbed(s, i, loc.ErrorEmbed(loc.Err(err)))
+		return
+	}
+	table.proceed()
+	table.update(i)
+}
+
+// HandlePokerRaiseModal plays the raise typed in the raise modal
+func HandlePokerRaiseModal(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	loc := i18n.ForInteraction(i)
+	channelID := strings.TrimPrefix(i.ModalSubmitData().CustomID, pokerRaiseModal)
+
+	raiseTo, err := strconv.Atoi(strings.TrimSpace(modalValue(i.ModalSubmitData(), "amount")))
+	if err != nil || raiseTo <= 0 {
+		respondEmbed(s, i, loc.ErrorEmbed("Invalid amount."))
+		return
+	}
+
+	pokerTablesMu.Lock()
+	table, exists := pokerTables[channelID]
+	pokerTablesMu.Unlock()
+	if !exists {
+		respondEmbed(s, i, loc.ErrorEmbed("No active game found!"))
+		return
+	}
+
+	table.mu.Lock()
+	defer table.mu.Unlock()
+
+	p := table.player(i.Member.User.ID)
+	if p == nil || !table.running || table.players[table.turn] != p {
+		respondEmbed(s, i, loc.ErrorEmbed("It's not your turn."))
+		return
+	}
+	if err := table.act(p, "raise", raiseTo); err != nil {
+		respondEmbed(s, i, loc.ErrorEmbed(loc.Err(err)))
+		return
+	}
+	table.proceed()
+	table.update(i)
+}
+
+func pokerRaiseModalData(loc i18n.Locale, channelID string, min, max int) *discordgo.InteractionResponseData {
+	return &discordgo.InteractionResponseData{
+		CustomID: pokerRaiseModal + channelID,
+		Title:    loc.T("Raise"),
+		Components: []discordgo.MessageComponent{
+			discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+				discordgo.TextInput{
+					CustomID:    "amount",
+					Label:       loc.T("Raise to (min %d, max %d)", min, max),
+					Style:       discordgo.TextInputShort,
+					Placeholder: strconv.Itoa(min),
+					Required:    true,
+					MaxLength:   10,
+				},
+			}},
+		},
+	}
+}
+
+// holeCardsEmbed shows a player their cards and current hand. Caller holds t.mu.
+func (t *pokerTable) holeCardsEmbed(loc i18n.Locale, p *pokerPlayer) *discordgo.MessageEmbed {
+	description := loc.T("Your cards: %s", formatHand(Hand{Cards: p.Hole}, false))
+	if len(t.board) > 0 {
+		rank := bestHand(append(append([]Card(nil), p.Hole...), t.board...))
+		description += "\n" + loc.T("Your hand: **%s**", loc.T(rank.name()))
+	}
+	return &discordgo.MessageEmbed{
+		Title:       loc.T("🃏 Your Hole Cards"),
+		Description: description,
+		Color:       0x2F3136,
+	}
+}
+
+// update shows the table, answering the click when there is one.
+// Caller holds t.mu.
+func (t *pokerTable) update(i *discordgo.InteractionCreate) {
+	embeds := []*discordgo.MessageEmbed{t.embed()}
+	components := t.components()
+
+	if i != nil {
+		t.s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{Embeds: embeds, Components: components},
+		})
+		return
+	}
+	if t.messageID == "" {
+		return
+	}
+	t.s.ChannelMessageEditComplex(&discordgo.MessageEdit{
+		ID:         t.messageID,
+		Channel:    t.channelID,
+		Embeds:     &embeds,
+		Components: &components,
+	})
+}
+
+// embed renders the table. Caller holds t.mu.
+func (t *pokerTable) embed() *discordgo.MessageEmbed {
+	loc := t.loc
+	embed := &discordgo.MessageEmbed{
+		Title: loc.T("♠️ Texas Hold'em - Hand #%d", t.handNo),
+		Color: 0x2F3136,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: loc.T("Blinds %d/%d | Rake %g%% | Use 🃏 to see your cards", t.rules.SmallBlind, t.rules.BigBlind, t.rules.RakePercent),
+		},
+	}
+
+	board := "-"
+	if len(t.board) > 0 {
+		board = formatHand(Hand{Cards: t.board}, false)
+	}
+	lines := []string{
+		loc.T("**Board:** %s", board),
+		loc.T("**Pot:** %d %s", t.potTotal(), config.Bot().CurrencySymbol),
+	}
+	if len(t.actions) > 0 {
+		lines = append(lines, "", strings.Join(t.actions, "\n"))
+	}
+	switch {
+	case t.running:
+		p := t.players[t.turn]
+		lines = append(lines, "", loc.T("<@%s>, it's your turn!", p.UserID))
+		if toCall := t.currentBet - p.Bet; toCall > 0 {
+			lines = append(lines, loc.T("To call: **%d**", clampInt(toCall, p.Stack)))
+		}
+	case len(t.results) > 0:
+		lines = append(lines, "", strings.Join(t.results, "\n"))
+	}
+	if !t.running && len(t.players) < 2 {
+		lines = append(lines, "", loc.T("Waiting for players. Sit down with `/poker join`."))
+	}
+	embed.Description = strings.Join(lines, "\n")
+
+	for i, p := range t.players {
+		name := "💺 " + p.Username
+		if t.handNo > 0 && i == t.button {
+			name += " (D)"
+		}
+		value := loc.T("Stack: %d", p.Stack)
+		if p.Bet > 0 {
+			value += " | " + loc.T("Bet: %d", p.Bet)
+		}
+		switch {
+		case !p.InHand:
+			value += " | " + loc.T("waiting")
+		case p.Folded:
+			value += " | " + loc.T("folded")
+		case p.AllIn:
+			value += " | " + loc.T("all-in")
+		}
+		if t.street == streetShowdown && p.InHand && !p.Folded {
+			value += "\n" + fmt.Sprintf("%s - %s", formatHand(Hand{Cards: p.Hole}, false), p.shown)
+		}
+		if p.Leaving {
+			value += " | " + loc.T("leaving")
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: name, Value: value, Inline: true})
+	}
+	return embed
+}
+
+// components are the action buttons of the hand. Caller holds t.mu.
+func (t *pokerTable) components() []discordgo.MessageComponent {
+	if !t.running {
+		return []discordgo.MessageComponent{}
+	}
+	loc := t.loc
+	p := t.players[t.turn]
+	button := func(action, label, emoji string, style discordgo.ButtonStyle) discordgo.Button {
+		return discordgo.Button{
+			Label:    label,
+			Style:    style,
+			CustomID: pokerButton + action + "_" + t.channelID,
+			Emoji:    &discordgo.ComponentEmoji{Name: emoji},
+		}
+	}
+
+	call := button("call", loc.T("Check"), "✔️", discordgo.PrimaryButton)
+	if toCall := t.currentBet - p.Bet; toCall > 0 {
+		call = button("call", loc.T("Call %d", clampInt(toCall, p.Stack)), "📞", discordgo.PrimaryButton)
+	}
+	buttons := []discordgo.MessageComponent{
+		button("cards", loc.T("Cards"), "🃏", discordgo.SecondaryButton),
+		button("fold", loc.T("Fold"), "🏳️", discordgo.DangerButton),
+		call,
+	}
+	if p.Bet+p.Stack > t.currentBet+t.minRaise {
+		buttons = append(buttons, button("raise", loc.T("Raise"), "⬆️", discordgo.SuccessButton))
+	}
+	if p.Bet+p.Stack > t.currentBet {
+		buttons = append(buttons, button("allin", loc.T("All-in %d", p.Bet+p.Stack), "💰", discordgo.DangerButton))
+	}
+	return []discordgo.MessageComponent{discordgo.ActionsRow{Components: buttons}}
+}
+
+func clampInt(n, limit int) int {
+	if n > limit {
+		return limit
+	}
+	return n
+}
+
+func containsInt(list []int, n int) bool {
+	for _, v := range list {
+		if v == n {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package games
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPokerPots(t *testing.T) {
+	type seat struct {
+		id     string
+		stack  int
+		total  int
+		folded bool
+	}
+	type wantPot struct {
+		amount   int
+		eligible []string
+	}
+	tests := []struct {
+		name     string
+		seats    []seat
+		returned map[string]int
+		pots     []wantPot
+	}{
+		{
+			name:     "uncalled all-in is returned",
+			seats:    []seat{{"a", 0, 100, false}, {"b", 0, 40, false}},
+			returned: map[string]int{"a": 60},
+			pots:     []wantPot{{80, []string{"a", "b"}}},
+		},
+		{
+			name:     "uncalled raise after everyone folded",
+			seats:    []seat{{"a", 50, 120, false}, {"b", 0, 20, true}, {"c", 0, 20, true}},
+			returned: map[string]int{"a": 100},
+			pots:     []wantPot{{60, []string{"a"}}},
+		},
+		{
+			name:  "short all-in makes a side pot",
+			seats: []seat{{"a", 0, 50, false}, {"b", 10, 150, false}, {"c", 0, 150, false}},
+			pots:  []wantPot{{150, []string{"a", "b", "c"}}, {200, []string{"b", "c"}}},
+		},
+		{
+			name:  "two all-ins make two side pots",
+			seats: []seat{{"a", 0, 30, false}, {"b", 0, 80, false}, {"c", 5, 200, false}, {"d", 0, 200, false}},
+			pots:  []wantPot{{120, []string{"a", "b", "c", "d"}}, {150, []string{"b", "c", "d"}}, {240, []string{"c", "d"}}},
+		},
+		{
+			name:  "folded chips stay in the pot",
+			seats: []seat{{"a", 0, 100, false}, {"b", 0, 100, false}, {"c", 70, 30, true}},
+			pots:  []wantPot{{230, []string{"a", "b"}}},
+		},
+		{
+			name:  "folded chips above the last level go to the last pot",
+			seats: []seat{{"a", 0, 40, false}, {"b", 0, 40, false}, {"c", 0, 100, true}, {"d", 0, 100, true}},
+			pots:  []wantPot{{280, []string{"a", "b"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := &pokerTable{}
+			chips := 0
+			for _, s := range tt.seats {
+				table.players = append(table.players, &pokerPlayer{
+					UserID: s.id, Stack: s.stack, Total: s.total, Bet: s.total,
+					InHand: true, Folded: s.folded, AllIn: s.stack == 0 && !s.folded,
+				})
+				chips += s.stack + s.total
+			}
+
+			table.returnUncalled()
+			pots := table.pots()
+
+			after := 0
+			for i, p := range table.players {
+				after += p.Stack
+				if got, want := p.Stack-tt.seats[i].stack, tt.returned[p.UserID]; got != want {
+					t.Errorf("%s got %d back, want %d", p.UserID, got, want)
+				}
+			}
+			for _, pot := range pots {
+				after += pot.Amount
+			}
+			if after != chips {
+				t.Errorf("chips not conserved: %d before, %d after", chips, after)
+			}
+
+			if len(pots) != len(tt.pots) {
+				t.Fatalf("got %d pots, want %d", len(pots), len(tt.pots))
+			}
+			for i, pot := range pots {
+				var eligible []string
+				for _, p := range pot.Eligible {
+					eligible = append(eligible, p.UserID)
+				}
+				if pot.Amount != tt.pots[i].amount || fmt.Sprint(eligible) != fmt.Sprint(tt.pots[i].eligible) {
+					t.Errorf("pot %d = %d %v, want %d %v", i, pot.Amount, eligible, tt.pots[i].amount, tt.pots[i].eligible)
+				}
+			}
+		})
+	}
+}
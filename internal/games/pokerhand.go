@@ -0,0 +1,168 @@
+package games
+
+import "sort"
+
+// Poker hand categories, weakest first
+const (
+	highCard = iota
+	onePair
+	twoPair
+	threeOfAKind
+	straight
+	flush
+	fullHouse
+	fourOfAKind
+	straightFlush
+)
+
+// pokerHandNames are the catalog keys of the categories
+var pokerHandNames = []string{
+	highCard:      "High Card",
+	onePair:       "Pair",
+	twoPair:       "Two Pair",
+	threeOfAKind:  "Three of a Kind",
+	straight:      "Straight",
+	flush:         "Flush",
+	fullHouse:     "Full House",
+	fourOfAKind:   "Four of a Kind",
+	straightFlush: "Straight Flush",
+}
+
+// handRank is the strength of a five card hand: its category, then the
+// ranks that break ties, most important first
+type handRank struct {
+	category int
+	ranks    []int
+}
+
+// compare returns 1, 0 or -1 when a beats, ties or loses to b
+func (a handRank) compare(b handRank) int {
+	if a.category != b.category {
+		if a.category > b.category {
+			return 1
+		}
+		return -1
+	}
+	for i := 0; i < len(a.ranks) && i < len(b.ranks); i++ {
+		if a.ranks[i] != b.ranks[i] {
+			if a.ranks[i] > b.ranks[i] {
+				return 1
+			}
+			return -1
+		}
+	}
+	return 0
+}
+
+// name is the catalog key of the hand ("Royal Flush" for the best straight flush)
+func (a handRank) name() string {
+	if a.category == straightFlush && a.ranks[0] == 14 {
+		return "Royal Flush"
+	}
+	return pokerHandNames[a.category]
+}
+
+// cardRank is the poker rank of a card, 2 to 14 (ace high)
+func cardRank(c Card) int {
+	switch c.Value {
+	case "A":
+		return 14
+	case "K":
+		return 13
+	case "Q":
+		return 12
+	case "J":
+		return 11
+	}
+	return parseInt(c.Value)
+}
+
+// bestHand evaluates every five card combination of the hole cards and the
+// board and returns the strongest
+func bestHand(cards []Card) handRank {
+	if len(cards) <= 5 {
+		return evaluateFive(cards)
+	}
+	var best handRank
+	first := true
+	five := make([]Card, 5)
+	var pick func(start, n int)
+	pick = func(start, n int) {
+		if n == 5 {
+			rank := evaluateFive(five)
+			if first || rank.compare(best) > 0 {
+				best, first = rank, false
+			}
+			return
+		}
+		for i := start; i <= len(cards)-(5-n); i++ {
+			five[n] = cards[i]
+			pick(i+1, n+1)
+		}
+	}
+	pick(0, 0)
+	return best
+}
+
+// evaluateFive ranks up to five cards (fewer before the river only matter
+// for showing a player's current hand)
+func evaluateFive(cards []Card) handRank {
+	counts := make(map[int]int)
+	suits := make(map[string]int)
+	for _, c := range cards {
+		counts[cardRank(c)]++
+		suits[c.Suit]++
+	}
+
+	// Ranks ordered by how many times they appear, then by rank
+	ranks := make([]int, 0, len(counts))
+	for r := range counts {
+		ranks = append(ranks, r)
+	}
+	sort.Slice(ranks, func(i, j int) bool {
+		if counts[ranks[i]] != counts[ranks[j]] {
+			return counts[ranks[i]] > counts[ranks[j]]
+		}
+		return ranks[i] > ranks[j]
+	})
+
+	isFlush := len(cards) == 5 && len(suits) == 1
+	high, isStraight := straightHigh(ranks, len(cards))
+
+	switch {
+	case isStraight && isFlush:
+		return handRank{straightFlush, []int{high}}
+	case counts[ranks[0]] == 4:
+		return handRank{fourOfAKind, ranks}
+	case counts[ranks[0]] == 3 && len(ranks) > 1 && counts[ranks[1]] >= 2:
+		return handRank{fullHouse, ranks}
+	case isFlush:
+		return handRank{flush, ranks}
+	case isStraight:
+		return handRank{straight, []int{high}}
+	case counts[ranks[0]] == 3:
+		return handRank{threeOfAKind, ranks}
+	case counts[ranks[0]] == 2 && len(ranks) > 1 && counts[ranks[1]] == 2:
+		return handRank{twoPair, ranks}
+	case counts[ranks[0]] == 2:
+		return handRank{onePair, ranks}
+	}
+	return handRank{highCard, ranks}
+}
+
+// straightHigh returns the top card of a five card straight. The wheel
+// (A-2-3-4-5) is a straight to the five.
+func straightHigh(ranks []int, n int) (int, bool) {
+	if n != 5 || len(ranks) != 5 {
+		return 0, false
+	}
+	sorted := append([]int(nil), ranks...)
+	sort.Sort(sort.Reverse(sort.IntSlice(sorted)))
+	if sorted[0]-sorted[4] == 4 {
+		return sorted[0], true
+	}
+	if sorted[0] == 14 && sorted[1] == 5 && sorted[4] == 2 {
+		return 5, true
+	}
+	return 0, false
+}
@@ -0,0 +1,96 @@
+package games
+
+import (
+	"strings"
+	"testing"
+)
+
+// parseCards builds cards from a compact notation like "As Kh 10d 2c",
+// the last letter being the suit
+func parseCards(t *testing.T, s string) []Card {
+	t.Helper()
+	suitOf := map[byte]string{'s': "♠️", 'h': "♥️", 'd': "♦️", 'c': "♣️"}
+	var cards []Card
+	for _, f := range strings.Fields(s) {
+		suit, ok := suitOf[f[len(f)-1]]
+		if !ok {
+			t.Fatalf("bad card %q", f)
+		}
+		cards = append(cards, Card{Suit: suit, Value: f[:len(f)-1]})
+	}
+	return cards
+}
+
+func TestBestHand(t *testing.T) {
+	tests := []struct {
+		name     string
+		cards    string
+		category int
+		ranks    []int
+		label    string
+	}{
+		{"royal flush", "As Ks Qs Js 10s 2h 3d", straightFlush, []int{14}, "Royal Flush"},
+		{"steel wheel", "As 2s 3s 4s 5s Kh Kd", straightFlush, []int{5}, "Straight Flush"},
+		{"wheel straight", "Ah 2s 3d 4c 5h 9s Jd", straight, []int{5}, "Straight"},
+		{"six high beats wheel", "Ah 2s 3d 4c 5h 6s Jd", straight, []int{6}, "Straight"},
+		{"no wraparound", "Qh Ks Ad 2c 3h 8s 9d", highCard, []int{14, 13, 12, 9, 8}, "High Card"},
+		{"quads with kicker", "9h 9s 9d 9c Kh 2s 3d", fourOfAKind, []int{9, 13}, "Four of a Kind"},
+		{"best full house of two trips", "8h 8s 8d 7c 7h 7s 2d", fullHouse, []int{8, 7}, "Full House"},
+		{"flush keeps top five", "2h 5h 9h Jh Kh 3h Qs", flush, []int{13, 11, 9, 5, 3}, "Flush"},
+		{"trips", "7h 7s 7d Ac Kh 2s 3d", threeOfAKind, []int{7, 14, 13}, "Three of a Kind"},
+		{"third pair dropped", "Ah As Kd Kc Qh Qs 2d", twoPair, []int{14, 13, 12}, "Two Pair"},
+		{"pair with kickers", "10h 10s Ad 8c 6h 4s 2d", onePair, []int{10, 14, 8, 6}, "Pair"},
+		{"fewer than five cards", "Ah As Kd", onePair, []int{14, 13}, "Pair"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := bestHand(parseCards(t, tt.cards))
+			if got.category != tt.category || !equalInts(got.ranks, tt.ranks) {
+				t.Errorf("bestHand(%s) = {%d %v}, want {%d %v}", tt.cards, got.category, got.ranks, tt.category, tt.ranks)
+			}
+			if got.name() != tt.label {
+				t.Errorf("name() = %q, want %q", got.name(), tt.label)
+			}
+		})
+	}
+}
+
+func TestHandCompare(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want int
+	}{
+		{"flush beats straight", "2h 5h 9h Jh Kh", "9s 10d Jc Qh Ks", 1},
+		{"wheel loses to six high", "Ah 2s 3d 4c 5h", "2h 3s 4d 5c 6h", -1},
+		{"wheel beats ace high", "Ah 2s 3d 4c 5h", "Ah Ks Qd Jc 9h", 1},
+		{"pair kicker decides", "10h 10s Ad 8c 6h", "10d 10c Kd 8s 6d", 1},
+		{"last kicker decides", "10h 10s Ad 8c 6h", "10d 10c Ac 8s 5d", 1},
+		{"two pair kicker decides", "Ah As Kd Kc 2h", "Ad Ac Kh Ks 3h", -1},
+		{"full house trips first", "3h 3s 3d 2c 2h", "2d 2s 2c Ah Ad", 1},
+		{"suits never break ties", "Ah Kh Qd Jc 9h", "As Ks Qh Jd 9s", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := bestHand(parseCards(t, tt.a)), bestHand(parseCards(t, tt.b))
+			if got := a.compare(b); got != tt.want {
+				t.Errorf("compare(%s, %s) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+			if got := b.compare(a); got != -tt.want {
+				t.Errorf("compare(%s, %s) = %d, want %d", tt.b, tt.a, got, -tt.want)
+			}
+		})
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,33 @@
+package games
+
+import (
+	"estudocoin/internal/audit"
+	"estudocoin/internal/database"
+	"estudocoin/internal/webhook"
+	"fmt"
+	"log"
+)
+
+// reportResult is called once per finished game with the total stake and
+// payout (0 for a loss), so outcome-based features live in one place.
+// detail describes the outcome for the game history (crash point, cards...).
+func reportResult(userID, game string, stake, payout int, detail string) {
+	releaseStake(userID, stake)
+	recordResult(userID, game, stake, payout, detail)
+}
+
+// recordResult is reportResult for a stake that was never held as open: the
+// lottery counts its tickets from the database instead
+func recordResult(userID, game string, stake, payout int, detail string) {
+	webhook.NotifyGameResult(userID, game, stake, payout)
+	audit.GameWin(userID, game, stake, payout)
+	// Loss limits and /stats are measured on the recorded results
+	if err := database.RecordGameResult(userID, game, stake, payout, detail); err != nil {
+		log.Printf("Error recording %s result of %s: %v", game, userID, err)
+	}
+}
+
+// handDetail describes a card hand for the game history
+func handDetail(h Hand) string {
+	return fmt.Sprintf("%s (%d)", formatHand(h, false), h.Score)
+}
@@ -2,6 +2,7 @@ package games
 
 import (
 	"estudocoin/internal/database"
+	"estudocoin/internal/i18n"
 	"estudocoin/pkg/config"
 	"estudocoin/pkg/utils"
 	"fmt"
@@ -43,11 +44,11 @@ const (
 )
 
 type RouletteBet struct {
-	UserID   string
-	Username string
-	BetType  BetType
-	Value    string // "red", "black", "even", "odd", "1-18", "19-36", "1st", "2nd", "3rd", or number
-	Amount   int
+	UserID   string  `json:"user_id"`
+	Username string  `json:"username"`
+	BetType  BetType `json:"bet_type"`
+	Value    string  `json:"value"` // "red", "black", "even", "odd", "1-18", "19-36", "1st", "2nd", "3rd", or number
+	Amount   int     `json:"amount"`
 }
 
 type RouletteRound struct {
@@ -69,13 +70,13 @@ var (
 
 func StartRoulette(s *discordgo.Session) {
 	// Check if roulette is enabled
-	if !config.Economy.RouletteEnabled {
+	if !config.Economy().RouletteEnabled {
 		log.Println("Roulette is disabled in configuration")
 		return
 	}
 
 	// Check if channel is configured
-	if config.Bot.RouletteChannelID == "" {
+	if config.Bot().RouletteChannelID == "" {
 		log.Println("Roulette channel ID not configured. Set 'roulette_channel_id' in config.json")
 		return
 	}
@@ -83,12 +84,12 @@ func StartRoulette(s *discordgo.Session) {
 	rouletteSession = s
 	rouletteStop = make(chan bool)
 
-	interval := config.Economy.RouletteIntervalMinutes
+	interval := config.Economy().RouletteIntervalMinutes
 	if interval <= 0 {
 		interval = 10
 	}
 
-	log.Printf("Starting Roulette with %d minute intervals in channel %s", interval, config.Bot.RouletteChannelID)
+	log.Printf("Starting Roulette with %d minute intervals in channel %s", interval, config.Bot().RouletteChannelID)
 
 	// Start first round immediately
 	startNewRound()
@@ -119,7 +120,7 @@ func StopRoulette() {
 }
 
 func startNewRound() {
-	interval := config.Economy.RouletteIntervalMinutes
+	interval := config.Economy().RouletteIntervalMinutes
 	if interval <= 0 {
 		interval = 10
 	}
@@ -231,41 +232,53 @@ func processPayouts(round *RouletteRound) map[string]int {
 			winnings := bet.Amount + (bet.Amount * multiplier)
 			database.AddCoins(bet.UserID, winnings)
 			payouts[bet.UserID] += winnings - bet.Amount // Track net profit
+			reportResult(bet.UserID, "wheel", bet.Amount, winnings, wheelBetDetail(bet, resultNum, resultColor))
+		} else {
+			reportResult(bet.UserID, "wheel", bet.Amount, 0, wheelBetDetail(bet, resultNum, resultColor))
 		}
 	}
 
 	return payouts
 }
 
-func PlaceRouletteBet(userID, username string, betType BetType, value string, amount int) (bool, string) {
+// wheelBetDetail describes a settled wheel bet for the game history
+func wheelBetDetail(bet RouletteBet, result int, color string) string {
+	return fmt.Sprintf("%s %s, landed on %d %s", bet.BetType, bet.Value, result, color)
+}
+
+func PlaceRouletteBet(loc i18n.Locale, userID, username string, betType BetType, value string, amount int) (bool, string) {
 	if currentRound == nil {
-		return false, "No active roulette round."
+		return false, loc.T("No active roulette round.")
 	}
 
 	currentRound.mu.RLock()
 	if currentRound.Spinning {
 		currentRound.mu.RUnlock()
-		return false, "Too late! The wheel is already spinning."
+		return false, loc.T("Too late! The wheel is already spinning.")
 	}
 	currentRound.mu.RUnlock()
 
 	if amount < MinRouletteBet {
-		return false, fmt.Sprintf("Minimum bet is %d %s", MinRouletteBet, config.Bot.CurrencySymbol)
+		return false, loc.T("Minimum bet is %d %s", MinRouletteBet, config.Bot().CurrencySymbol)
+	}
+
+	if database.IsFrozen(userID) {
+		return false, loc.T(FrozenMessage)
 	}
 
 	balance := database.GetBalance(userID)
 	if balance < amount {
-		return false, fmt.Sprintf("Insufficient balance! You have %d %s", balance, config.Bot.CurrencySymbol)
+		return false, loc.T("Insufficient balance! You have %d %s", balance, config.Bot().CurrencySymbol)
 	}
 
 	// Validate bet
 	if !isValidBet(betType, value) {
-		return false, "Invalid bet."
+		return false, loc.T("Invalid bet.")
 	}
 
 	// Deduct bet (goes to bot)
-	if err := database.CollectLostBet(userID, amount); err != nil {
-		return false, "Error placing bet."
+	if err := collectStake(userID, amount); err != nil {
+		return false, loc.Err(err)
 	}
 
 	// Add to round
@@ -301,7 +314,7 @@ func isValidBet(betType BetType, value string) bool {
 }
 
 func postBettingOpenEmbed(round *RouletteRound) {
-	channelID := config.Bot.RouletteChannelID
+	channelID := config.Bot().RouletteChannelID
 	if channelID == "" {
 		log.Println("No roulette channel configured, skipping betting open message")
 		return
@@ -311,38 +324,39 @@ func postBettingOpenEmbed(round *RouletteRound) {
 		log.Println("Roulette session not initialized")
 		return
 	}
+	loc := i18n.ForChannel(rouletteSession, channelID)
 
 	timeUntilSpin := round.EndTime.Sub(time.Now())
 	minutes := int(timeUntilSpin.Minutes())
 	seconds := int(timeUntilSpin.Seconds()) % 60
 
 	embed := &discordgo.MessageEmbed{
-		Title:       "🎰 ROULETTE - Betting Open!",
-		Description: fmt.Sprintf("Place your bets! The wheel spins in **%d minutes and %d seconds**.", minutes, seconds),
+		Title:       loc.T("🎰 ROULETTE - Betting Open!"),
+		Description: loc.T("Place your bets! The wheel spins in **%d minutes and %d seconds**.", minutes, seconds),
 		Color:       0x00FF00,
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name: "📋 Available Bets",
-				Value: "• `!wheel number <0-36> <amount>` - **35:1**\n" +
+				Name: loc.T("📋 Available Bets"),
+				Value: loc.T("• `!wheel number <0-36> <amount>` - **35:1**\n" +
 					"• `!wheel red <amount>` or `!wheel black <amount>` - **1:1**\n" +
 					"• `!wheel even <amount>` or `!wheel odd <amount>` - **1:1**\n" +
 					"• `!wheel low <amount>` (1-18) or `!wheel high <amount>` (19-36) - **1:1**\n" +
-					"• `!wheel dozen <1st/2nd/3rd> <amount>` - **2:1**",
+					"• `!wheel dozen <1st/2nd/3rd> <amount>` - **2:1**"),
 				Inline: false,
 			},
 			{
-				Name:   "💰 Minimum Bet",
-				Value:  fmt.Sprintf("%d %s", MinRouletteBet, config.Bot.CurrencySymbol),
+				Name:   loc.T("💰 Minimum Bet"),
+				Value:  fmt.Sprintf("%d %s", MinRouletteBet, config.Bot().CurrencySymbol),
 				Inline: true,
 			},
 			{
-				Name:   "⏰ Next Spin",
+				Name:   loc.T("⏰ Next Spin"),
 				Value:  fmt.Sprintf("<t:%d:R>", round.EndTime.Unix()),
 				Inline: true,
 			},
 		},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: "🍀 Good luck!",
+			Text: loc.T("🍀 Good luck!"),
 		},
 	}
 
@@ -355,7 +369,7 @@ func postBettingOpenEmbed(round *RouletteRound) {
 }
 
 func postResultEmbed(round *RouletteRound, payouts map[string]int) {
-	channelID := config.Bot.RouletteChannelID
+	channelID := config.Bot().RouletteChannelID
 	if channelID == "" {
 		log.Println("No roulette channel configured, skipping result message")
 		return
@@ -365,6 +379,7 @@ func postResultEmbed(round *RouletteRound, payouts map[string]int) {
 		log.Println("Roulette session not initialized")
 		return
 	}
+	loc := i18n.ForChannel(rouletteSession, channelID)
 
 	resultNum := round.Result
 	resultColor := round.Color
@@ -378,11 +393,11 @@ func postResultEmbed(round *RouletteRound, payouts map[string]int) {
 	}
 
 	// Build winners list
-	winnersList := "No winners this round."
+	winnersList := loc.T("No winners this round.")
 	if len(payouts) > 0 {
 		var sb strings.Builder
 		for userID, profit := range payouts {
-			sb.WriteString(fmt.Sprintf("<@%s>: +%d %s\n", userID, profit, config.Bot.CurrencySymbol))
+			sb.WriteString(fmt.Sprintf("<@%s>: +%d %s\n", userID, profit, config.Bot().CurrencySymbol))
 		}
 		winnersList = sb.String()
 	}
@@ -406,23 +421,23 @@ func postResultEmbed(round *RouletteRound, payouts map[string]int) {
 	}
 
 	embed := &discordgo.MessageEmbed{
-		Title:       "🎰 ROULETTE - Result!",
-		Description: fmt.Sprintf("# %s **%d**\n\nThe ball landed on **%s %d**!", emoji, resultNum, strings.ToUpper(resultColor), resultNum),
+		Title:       loc.T("🎰 ROULETTE - Result!"),
+		Description: loc.T("# %s **%d**\n\nThe ball landed on **%s %d**!", emoji, resultNum, strings.ToUpper(resultColor), resultNum),
 		Color:       color,
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name:   "🏆 Winners",
+				Name:   loc.T("🏆 Winners"),
 				Value:  winnersList,
 				Inline: false,
 			},
 			{
-				Name:   "📊 Round Stats",
-				Value:  fmt.Sprintf("Total Bets: %d\nTotal Wagered: %d %s", totalBets, totalAmount, config.Bot.CurrencySymbol),
+				Name:   loc.T("📊 Round Stats"),
+				Value:  loc.T("Total Bets: %d\nTotal Wagered: %d %s", totalBets, totalAmount, config.Bot().CurrencySymbol),
 				Inline: false,
 			},
 		},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: "Next round starting soon...",
+			Text: loc.T("Next round starting soon..."),
 		},
 		Timestamp: time.Now().Format(time.RFC3339),
 	}
@@ -444,10 +459,43 @@ func GetCurrentRoundInfo() (time.Time, bool) {
 	return currentRound.EndTime, !currentRound.Spinning
 }
 
+// RoundSnapshot is a read-only copy of the current wheel round
+type RoundSnapshot struct {
+	StartTime   time.Time     `json:"start_time"`
+	EndTime     time.Time     `json:"end_time"`
+	BettingOpen bool          `json:"betting_open"`
+	TotalBets   int           `json:"total_bets"`
+	TotalAmount int           `json:"total_amount"`
+	Bets        []RouletteBet `json:"bets"`
+}
+
+// GetRoundSnapshot returns a copy of the current round, or false if no round is running
+func GetRoundSnapshot() (*RoundSnapshot, bool) {
+	round := currentRound
+	if round == nil {
+		return nil, false
+	}
+	round.mu.RLock()
+	defer round.mu.RUnlock()
+
+	snap := &RoundSnapshot{
+		StartTime:   round.StartTime,
+		EndTime:     round.EndTime,
+		BettingOpen: !round.Spinning,
+		TotalBets:   len(round.Bets),
+		Bets:        append([]RouletteBet(nil), round.Bets...),
+	}
+	for _, bet := range round.Bets {
+		snap.TotalAmount += bet.Amount
+	}
+	return snap, true
+}
+
 func CmdRoulette(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
-	if len(args) < 2 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.InfoEmbed("Roulette",
-			"Usage:\n"+
+	loc := i18n.ForMessage(m)
+	if len(args) < 2 && !(len(args) == 1 && strings.ToLower(args[0]) == "time") {
+		s.ChannelMessageSendEmbed(m.ChannelID, utils.InfoEmbed(loc.T("Roulette"),
+			loc.T("Usage:\n"+
 				"`!wheel number <0-36> <amount>` - Bet on a specific number (35:1)\n"+
 				"`!wheel red <amount>` - Bet on red (1:1)\n"+
 				"`!wheel black <amount>` - Bet on black (1:1)\n"+
@@ -456,94 +504,109 @@ func CmdRoulette(s *discordgo.Session, m *discordgo.MessageCreate, args []string
 				"`!wheel low <amount>` - Bet on 1-18 (1:1)\n"+
 				"`!wheel high <amount>` - Bet on 19-36 (1:1)\n"+
 				"`!wheel dozen <1st/2nd/3rd> <amount>` - Bet on dozen (2:1)\n\n"+
-				"Use `!wheel time` to see when the next spin is."))
+				"Use `!wheel time` to see when the next spin is, or `/wheel bet`.")))
 		return
 	}
 
-	// Check if there's time left
-	endTime, active := GetCurrentRoundInfo()
-	if !active {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Betting is closed! The wheel is spinning."))
-		return
-	}
-
-	timeLeft := endTime.Sub(time.Now())
-	if timeLeft <= 0 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Too late! Betting is closed for this round."))
-		return
-	}
-
-	betTypeStr := strings.ToLower(args[0])
+	choice := strings.ToLower(args[0])
 
 	// Special case: time command
-	if betTypeStr == "time" {
-		minutes := int(timeLeft.Minutes())
-		seconds := int(timeLeft.Seconds()) % 60
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.InfoEmbed("Roulette",
-			fmt.Sprintf("Next spin in **%d minutes and %d seconds**.", minutes, seconds)))
+	if choice == "time" {
+		s.ChannelMessageSendEmbed(m.ChannelID, WheelTimeEmbed(loc))
 		return
 	}
 
-	var betType BetType
-	var value string
-	var amount int
-	var err error
-
-	switch betTypeStr {
-	case "number":
+	// number and dozen take a value before the amount
+	value := ""
+	amountArg := args[1]
+	if choice == "number" || choice == "dozen" {
 		if len(args) < 3 {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Usage: `!roulette number <0-36> <amount>`"))
+			usage := "Usage: `!wheel number <0-36> <amount>`"
+			if choice == "dozen" {
+				usage = "Usage: `!wheel dozen <1st/2nd/3rd> <amount>`"
+			}
+			s.ChannelMessageSendEmbed(m.ChannelID, loc.ErrorEmbed(usage))
 			return
 		}
-		betType = BetNumber
 		value = args[1]
-		amount, err = parseAmount(args[2])
+		amountArg = args[2]
+	}
+
+	amount, err := parseAmount(amountArg)
+	if err != nil || amount <= 0 {
+		s.ChannelMessageSendEmbed(m.ChannelID, loc.ErrorEmbed("Invalid amount."))
+		return
+	}
+
+	s.ChannelMessageSendEmbed(m.ChannelID, PlaceWheelBet(loc, m.Author, choice, value, amount))
+}
+
+// WheelChoices are the bets accepted by /wheel bet and !wheel
+var WheelChoices = []string{"red", "black", "even", "odd", "low", "high", "number", "dozen"}
+
+// WheelBet maps a wheel choice (red, low, dozen...) and its value to the bet stored in the round
+func WheelBet(choice, value string) (BetType, string, bool) {
+	switch choice {
 	case "red", "black":
-		betType = BetColor
-		value = betTypeStr
-		amount, err = parseAmount(args[1])
+		return BetColor, choice, true
 	case "even", "odd":
-		betType = BetEvenOdd
-		value = betTypeStr
-		amount, err = parseAmount(args[1])
+		return BetEvenOdd, choice, true
 	case "low":
-		betType = BetHalf
-		value = "1-18"
-		amount, err = parseAmount(args[1])
+		return BetHalf, "1-18", true
 	case "high":
-		betType = BetHalf
-		value = "19-36"
-		amount, err = parseAmount(args[1])
+		return BetHalf, "19-36", true
+	case "number":
+		return BetNumber, value, isValidBet(BetNumber, value)
 	case "dozen":
-		if len(args) < 3 {
-			s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Usage: `!roulette dozen <1st/2nd/3rd> <amount>`"))
-			return
-		}
-		betType = BetDozen
-		value = args[1]
-		amount, err = parseAmount(args[2])
-	default:
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Invalid bet type. Use `!roulette` for help."))
-		return
+		return BetDozen, value, isValidBet(BetDozen, value)
 	}
+	return "", "", false
+}
 
-	if err != nil || amount <= 0 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Invalid amount."))
-		return
+// PlaceWheelBet places a bet on the current round and returns the reply embed
+func PlaceWheelBet(loc i18n.Locale, user *discordgo.User, choice, value string, amount int) *discordgo.MessageEmbed {
+	// Check if there's time left
+	endTime, active := GetCurrentRoundInfo()
+	if !active {
+		return loc.ErrorEmbed("Betting is closed! The wheel is spinning.")
+	}
+	if time.Until(endTime) <= 0 {
+		return loc.ErrorEmbed("Too late! Betting is closed for this round.")
+	}
+
+	betType, value, ok := WheelBet(choice, value)
+	if !ok {
+		if choice == "number" || choice == "dozen" {
+			return loc.ErrorEmbed("Invalid bet.")
+		}
+		return loc.ErrorEmbed("Invalid bet type. Use `!wheel` for help.")
 	}
 
 	// Debug log
-	balance := database.GetBalance(m.Author.ID)
-	log.Printf("[ROULETTE] User: %s (ID: %s), Balance: %d, Bet: %d", m.Author.Username, m.Author.ID, balance, amount)
-	
-	success, msg := PlaceRouletteBet(m.Author.ID, m.Author.Username, betType, value, amount)
+	balance := database.GetBalance(user.ID)
+	log.Printf("[ROULETTE] User: %s (ID: %s), Balance: %d, Bet: %d", user.Username, user.ID, balance, amount)
+
+	success, msg := PlaceRouletteBet(loc, user.ID, user.Username, betType, value, amount)
 	if !success {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed(msg))
-		return
+		return loc.ErrorEmbed(msg)
+	}
+
+	return utils.SuccessEmbed(loc.T("Bet Placed!"),
+		loc.T("You bet **%d %s** on **%s**.", amount, config.Bot().CurrencySymbol, formatBet(loc, betType, value)))
+}
+
+// WheelTimeEmbed shows how long until the next spin
+func WheelTimeEmbed(loc i18n.Locale) *discordgo.MessageEmbed {
+	endTime, active := GetCurrentRoundInfo()
+	timeLeft := time.Until(endTime)
+	if !active || timeLeft <= 0 {
+		return loc.ErrorEmbed("Betting is closed! The wheel is spinning.")
 	}
 
-	s.ChannelMessageSendEmbed(m.ChannelID, utils.SuccessEmbed("Bet Placed!",
-		fmt.Sprintf("You bet **%d %s** on **%s**.", amount, config.Bot.CurrencySymbol, formatBet(betType, value))))
+	minutes := int(timeLeft.Minutes())
+	seconds := int(timeLeft.Seconds()) % 60
+	return utils.InfoEmbed(loc.T("Roulette"),
+		loc.T("Next spin in **%d minutes and %d seconds**.", minutes, seconds))
 }
 
 func parseAmount(s string) (int, error) {
@@ -552,21 +615,21 @@ func parseAmount(s string) (int, error) {
 	return amount, err
 }
 
-func formatBet(betType BetType, value string) string {
+func formatBet(loc i18n.Locale, betType BetType, value string) string {
 	switch betType {
 	case BetNumber:
-		return "number " + value
+		return loc.T("number %s", value)
 	case BetColor:
-		return value
+		return loc.T(value)
 	case BetEvenOdd:
-		return value
+		return loc.T(value)
 	case BetHalf:
 		if value == "1-18" {
-			return "low (1-18)"
+			return loc.T("low (1-18)")
 		}
-		return "high (19-36)"
+		return loc.T("high (19-36)")
 	case BetDozen:
-		return value + " dozen"
+		return loc.T("%s dozen", value)
 	}
 	return value
 }
@@ -2,11 +2,12 @@ package games
 
 import (
 	"estudocoin/internal/database"
+	"estudocoin/internal/i18n"
 	"estudocoin/pkg/config"
 	"estudocoin/pkg/utils"
 	"fmt"
+	"log"
 	"math/rand"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -14,20 +15,30 @@ import (
 	"github.com/bwmarrin/discordgo"
 )
 
-type RussianRouletteChallenge struct {
+// Challenge is a pending PvP challenge. Russian Roulette and the duels share
+// the accept/decline/timeout flow.
+type Challenge struct {
 	ChallengerID string
-	ChallengedID string
+	ChallengedID string // empty for open challenges, which anyone can accept
+	Game         string // "" for Russian Roulette, DuelCoinflip or DuelDice
+	Sides        int    // dice duels
 	Bet          int
 	ChannelID    string
+	Locale       i18n.Locale // language of the server, used for the public challenge and game messages
 	TimeoutTimer *time.Timer
 }
 
+// roulettePlayer is a seat at the table, in turn order
+type roulettePlayer struct {
+	ID   string
+	Name string
+	Dead bool
+}
+
 type RussianRouletteGame struct {
-	Player1ID   string
-	Player2ID   string
-	Player1Name string
-	Player2Name string
-	CurrentTurn string
+	ID          string
+	Seats       []*roulettePlayer
+	Turn        int // Index of the player holding the gun
 	Bet         int
 	ChannelID   string
 	MessageID   string
@@ -35,11 +46,19 @@ type RussianRouletteGame struct {
 	Chamber     int // Bullet position (1-6)
 	CurrentShot int // Current trigger position (1-6)
 	GameOver    bool
-	mu          sync.Mutex
+	SplitPot    bool // The first death ends the game and the survivors share the pot
+	Locale      i18n.Locale
+	// Lobby games wait for players until StartsAt
+	StartsAt time.Time
+	Started  bool
+	SideBets []*sideBet
+	full     chan struct{}
+	mu       sync.Mutex
+	*session
 }
 
 var (
-	pendingChallenges = make(map[string]*RussianRouletteChallenge)
+	pendingChallenges = make(map[string]*Challenge) // keyed by challenged user, or openChallengeKey
 	pendingMu         sync.Mutex
 
 	activeRouletteGames = make(map[string]*RussianRouletteGame)
@@ -48,59 +67,37 @@ var (
 
 const ChallengeTimeout = 30 * time.Second
 
-func CmdRussianRoulette(s *discordgo.Session, m *discordgo.MessageCreate, args []string) {
-	if len(args) < 2 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.InfoEmbed("🔫 Russian Roulette", "Usage: `!roulette @user <amount>`\n\nChallenge another user to a game of Russian Roulette. Winner takes all!"))
-		return
-	}
-
-	amount, err := strconv.Atoi(args[len(args)-1])
-	if err != nil || amount <= 0 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Invalid amount. Use a positive number."))
-		return
-	}
-
-	if amount < 50 {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed(fmt.Sprintf("Minimum bet is 50 %s", config.Bot.CurrencySymbol)))
-		return
-	}
-
-	userArg := args[0]
-	challengedID := ""
+// RussianRouletteHelpEmbed explains the Russian Roulette commands
+func RussianRouletteHelpEmbed(loc i18n.Locale) *discordgo.MessageEmbed {
+	return utils.InfoEmbed(loc.T("🔫 Russian Roulette"), loc.T("Usage: `!roulette @user <amount>`\n\nChallenge another user to a game of Russian Roulette. Winner takes all!\n\n`!roulette lobby <amount> [split]` opens a game for up to %d players. With `split`, the first death ends the game and the survivors share the pot.\n`!roulette bet @player <amount>` bets on a lobby player surviving.", MaxLobbyPlayers))
+}
 
-	if strings.HasPrefix(userArg, "<@") && strings.HasSuffix(userArg, ">") {
-		challengedID = strings.TrimPrefix(userArg, "<@")
-		challengedID = strings.TrimPrefix(challengedID, "!")
-		challengedID = strings.TrimSuffix(challengedID, ">")
-	} else {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Mention a valid user. Example: `!roulette @user 100`"))
-		return
+// ChallengeRussianRoulette posts a one-on-one challenge in the channel
+func ChallengeRussianRoulette(s *discordgo.Session, guildID, channelID, challengerID string, challenged *discordgo.User, amount int) error {
+	if amount < MinRussianRouletteBet {
+		return i18n.Errorf("Minimum bet is %d %s", MinRussianRouletteBet, config.Bot().CurrencySymbol)
 	}
 
-	challengerID := m.Author.ID
-
+	challengedID := challenged.ID
 	if challengedID == challengerID {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("You cannot challenge yourself!"))
-		return
+		return i18n.Errorf("You cannot challenge yourself!")
 	}
-
-	challengedMember, err := s.GuildMember(m.GuildID, challengedID)
-	if err != nil || challengedMember.User.Bot {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("Invalid user or bot."))
-		return
+	if challenged.Bot {
+		return i18n.Errorf("Invalid user or bot.")
+	}
+	if _, err := s.GuildMember(guildID, challengedID); err != nil {
+		return i18n.Errorf("Invalid user or bot.")
 	}
 
 	challengerBalance := database.GetBalance(challengerID)
 	if challengerBalance < amount {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed(fmt.Sprintf("Insufficient balance! You have %d %s", challengerBalance, config.Bot.CurrencySymbol)))
-		return
+		return i18n.Errorf("Insufficient balance! You have %d %s", challengerBalance, config.Bot().CurrencySymbol)
 	}
 
 	pendingMu.Lock()
 	if _, exists := pendingChallenges[challengedID]; exists {
 		pendingMu.Unlock()
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("This user already has a pending challenge!"))
-		return
+		return i18n.Errorf("This user already has a pending challenge!")
 	}
 
 	challengerInGame := isPlayerInGame(challengerID)
@@ -108,19 +105,18 @@ func CmdRussianRoulette(s *discordgo.Session, m *discordgo.MessageCreate, args [
 	pendingMu.Unlock()
 
 	if challengerInGame {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("You are already in a Russian Roulette game!"))
-		return
+		return i18n.Errorf("You are already in a Russian Roulette game!")
 	}
 	if challengedInGame {
-		s.ChannelMessageSendEmbed(m.ChannelID, utils.ErrorEmbed("This user is already in a Russian Roulette game!"))
-		return
+		return i18n.Errorf("This user is already in a Russian Roulette game!")
 	}
 
-	challenge := &RussianRouletteChallenge{
+	challenge := &Challenge{
 		ChallengerID: challengerID,
 		ChallengedID: challengedID,
 		Bet:          amount,
-		ChannelID:    m.ChannelID,
+		ChannelID:    channelID,
+		Locale:       i18n.ForGuild(guildID),
 	}
 
 	challenge.TimeoutTimer = time.AfterFunc(ChallengeTimeout, func() {
@@ -131,19 +127,21 @@ func CmdRussianRoulette(s *discordgo.Session, m *discordgo.MessageCreate, args [
 	pendingChallenges[challengedID] = challenge
 	pendingMu.Unlock()
 
+	// The challenge is public, so it is shown in the server language
+	loc := challenge.Locale
 	embed := &discordgo.MessageEmbed{
-		Title:       "🔫 Russian Roulette Challenge",
-		Description: fmt.Sprintf("<@%s> challenged <@%s> to a game of Russian Roulette!", challengerID, challengedID),
+		Title:       loc.T("🔫 Russian Roulette Challenge"),
+		Description: loc.T("<@%s> challenged <@%s> to a game of Russian Roulette!", challengerID, challengedID),
 		Color:       0x8B0000,
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name:   "💰 Bet",
-				Value:  fmt.Sprintf("%d %s", amount, config.Bot.CurrencySymbol),
+				Name:   loc.T("💰 Bet"),
+				Value:  fmt.Sprintf("%d %s", amount, config.Bot().CurrencySymbol),
 				Inline: true,
 			},
 			{
-				Name:   "⏱️ Time",
-				Value:  "30 seconds to accept",
+				Name:   loc.T("⏱️ Time"),
+				Value:  loc.T("30 seconds to accept"),
 				Inline: true,
 			},
 		},
@@ -153,12 +151,12 @@ func CmdRussianRoulette(s *discordgo.Session, m *discordgo.MessageCreate, args [
 		discordgo.ActionsRow{
 			Components: []discordgo.MessageComponent{
 				discordgo.Button{
-					Label:    "✅ Accept",
+					Label:    loc.T("✅ Accept"),
 					Style:    discordgo.SuccessButton,
 					CustomID: fmt.Sprintf("rr_accept_%s_%s", challengerID, challengedID),
 				},
 				discordgo.Button{
-					Label:    "❌ Decline",
+					Label:    loc.T("❌ Decline"),
 					Style:    discordgo.DangerButton,
 					CustomID: fmt.Sprintf("rr_decline_%s_%s", challengerID, challengedID),
 				},
@@ -166,10 +164,13 @@ func CmdRussianRoulette(s *discordgo.Session, m *discordgo.MessageCreate, args [
 		},
 	}
 
-	s.ChannelMessageSendComplex(m.ChannelID, &discordgo.MessageSend{
+	if _, err := s.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
 		Embeds:     []*discordgo.MessageEmbed{embed},
 		Components: buttons,
-	})
+	}); err != nil {
+		log.Printf("[ROULETTE ERROR] Could not post the challenge of %s: %v", challengerID, err)
+	}
+	return nil
 }
 
 func HandleRussianRouletteInteraction(s *discordgo.Session, i *discordgo.InteractionCreate) {
@@ -180,6 +181,8 @@ func HandleRussianRouletteInteraction(s *discordgo.Session, i *discordgo.Interac
 		handleAccept(s, i, userID)
 	} else if strings.HasPrefix(customID, "rr_decline_") {
 		handleDecline(s, i, userID)
+	} else if strings.HasPrefix(customID, "rr_join_") {
+		handleLobbyJoin(s, i)
 	} else if strings.HasPrefix(customID, "rr_shoot_") {
 		handleShoot(s, i)
 	}
@@ -190,11 +193,11 @@ func handleAccept(s *discordgo.Session, i *discordgo.InteractionCreate, userID s
 	challenge, exists := pendingChallenges[userID]
 	pendingMu.Unlock()
 
-	if !exists {
+	if !exists || challenge.Game != "" {
 		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
-				Content: "❌ No pending challenge found!",
+				Content: i18n.ForInteraction(i).T("❌ No pending challenge found!"),
 				Flags:   discordgo.MessageFlagsEphemeral,
 			},
 		})
@@ -206,7 +209,7 @@ func handleAccept(s *discordgo.Session, i *discordgo.InteractionCreate, userID s
 		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseUpdateMessage,
 			Data: &discordgo.InteractionResponseData{
-				Content:    fmt.Sprintf("❌ <@%s> does not have enough balance!", challenge.ChallengedID),
+				Content:    challenge.Locale.T("❌ <@%s> does not have enough balance!", challenge.ChallengedID),
 				Embeds:     []*discordgo.MessageEmbed{},
 				Components: []discordgo.MessageComponent{},
 			},
@@ -220,7 +223,7 @@ func handleAccept(s *discordgo.Session, i *discordgo.InteractionCreate, userID s
 		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseUpdateMessage,
 			Data: &discordgo.InteractionResponseData{
-				Content:    fmt.Sprintf("❌ <@%s> no longer has enough balance!", challenge.ChallengerID),
+				Content:    challenge.Locale.T("❌ <@%s> no longer has enough balance!", challenge.ChallengerID),
 				Embeds:     []*discordgo.MessageEmbed{},
 				Components: []discordgo.MessageComponent{},
 			},
@@ -232,42 +235,72 @@ func handleAccept(s *discordgo.Session, i *discordgo.InteractionCreate, userID s
 	challenge.TimeoutTimer.Stop()
 	cleanupChallenge(challenge.ChallengedID)
 
-	database.AddCoins(challenge.ChallengerID, -challenge.Bet)
-	database.AddCoins(challenge.ChallengedID, -challenge.Bet)
-
-	totalPot := challenge.Bet * 2
-
 	challengerMember, _ := s.GuildMember(i.GuildID, challenge.ChallengerID)
 	challengedMember, _ := s.GuildMember(i.GuildID, challenge.ChallengedID)
 
 	challengerName := challengerMember.User.Username
 	challengedName := challengedMember.User.Username
 
+	gameID := fmt.Sprintf("%s_%s", challenge.ChallengerID, challenge.ChallengedID)
+
 	game := &RussianRouletteGame{
-		Player1ID:   challenge.ChallengerID,
-		Player2ID:   challenge.ChallengedID,
-		Player1Name: challengerName,
-		Player2Name: challengedName,
-		CurrentTurn: challenge.ChallengerID,
+		ID: gameID,
+		Seats: []*roulettePlayer{
+			{ID: challenge.ChallengerID, Name: challengerName},
+			{ID: challenge.ChallengedID, Name: challengedName},
+		},
 		Bet:         challenge.Bet,
 		ChannelID:   i.ChannelID,
 		Round:       1,
 		Chamber:     rand.Intn(6) + 1,
 		CurrentShot: 1,
 		GameOver:    false,
+		Started:     true,
+		Locale:      challenge.Locale,
+		session:     newSession("russianroulette", i.ChannelID, challenge.ChallengerID, challenge.ChallengedID),
 	}
 
-	if rand.Intn(2) == 1 {
-		game.CurrentTurn = challenge.ChallengedID
+	// Both players must have a free game slot before any coins move
+	if err := Sessions.Start(game, nil); err != nil {
+		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{
+				Content:    "❌ " + challenge.Locale.T(err.Error()),
+				Embeds:     []*discordgo.MessageEmbed{},
+				Components: []discordgo.MessageComponent{},
+			},
+		})
+		return
 	}
 
-	gameID := fmt.Sprintf("%s_%s", challenge.ChallengerID, challenge.ChallengedID)
+	refused := func(userID string, err error) {
+		game.finish()
+		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{
+				Content:    fmt.Sprintf("❌ <@%s> %s", userID, challenge.Locale.Err(err)),
+				Embeds:     []*discordgo.MessageEmbed{},
+				Components: []discordgo.MessageComponent{},
+			},
+		})
+	}
+	if err := escrowStake(challenge.ChallengerID, challenge.Bet); err != nil {
+		refused(challenge.ChallengerID, err)
+		return
+	}
+	if err := escrowStake(challenge.ChallengedID, challenge.Bet); err != nil {
+		refundStake(challenge.ChallengerID, challenge.Bet)
+		refused(challenge.ChallengedID, err)
+		return
+	}
+
+	game.Turn = rand.Intn(2)
 
 	rouletteMu.Lock()
 	activeRouletteGames[gameID] = game
 	rouletteMu.Unlock()
 
-	embed := game.createGameEmbed(totalPot)
+	embed := game.createGameEmbed()
 	components := game.createShootButton()
 
 	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
@@ -291,11 +324,11 @@ func handleDecline(s *discordgo.Session, i *discordgo.InteractionCreate, userID
 	challenge, exists := pendingChallenges[userID]
 	pendingMu.Unlock()
 
-	if !exists {
+	if !exists || challenge.Game != "" {
 		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
-				Content: "❌ No pending challenge found!",
+				Content: i18n.ForInteraction(i).T("❌ No pending challenge found!"),
 				Flags:   discordgo.MessageFlagsEphemeral,
 			},
 		})
@@ -308,7 +341,7 @@ func handleDecline(s *discordgo.Session, i *discordgo.InteractionCreate, userID
 	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseUpdateMessage,
 		Data: &discordgo.InteractionResponseData{
-			Content:    fmt.Sprintf("❌ <@%s> declined the challenge!", userID),
+			Content:    challenge.Locale.T("❌ <@%s> declined the challenge!", userID),
 			Embeds:     []*discordgo.MessageEmbed{},
 			Components: []discordgo.MessageComponent{},
 		},
@@ -316,27 +349,17 @@ func handleDecline(s *discordgo.Session, i *discordgo.InteractionCreate, userID
 }
 
 func handleShoot(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	customID := i.MessageComponentData().CustomID
-	parts := strings.Split(customID, "_")
-	if len(parts) < 4 {
-		return
-	}
-
-	gameID := parts[2] + "_" + parts[3]
+	gameID := strings.TrimPrefix(i.MessageComponentData().CustomID, "rr_shoot_")
 
 	rouletteMu.Lock()
 	game, exists := activeRouletteGames[gameID]
-	if !exists {
-		gameID = parts[3] + "_" + parts[2]
-		game, exists = activeRouletteGames[gameID]
-	}
 	rouletteMu.Unlock()
 
 	if !exists {
 		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
-				Content: "❌ Game not found!",
+				Content: i18n.ForInteraction(i).T("❌ Game not found!"),
 				Flags:   discordgo.MessageFlagsEphemeral,
 			},
 		})
@@ -346,55 +369,33 @@ func handleShoot(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	game.mu.Lock()
 	defer game.mu.Unlock()
 
-	if i.Member.User.ID != game.CurrentTurn {
+	if game.GameOver || !game.Started {
+		return
+	}
+
+	shooter := game.Seats[game.Turn]
+	if i.Member.User.ID != shooter.ID {
 		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
-				Content: "❌ It's not your turn!",
+				Content: i18n.ForInteraction(i).T("❌ It's not your turn!"),
 				Flags:   discordgo.MessageFlagsEphemeral,
 			},
 		})
 		return
 	}
 
-	if game.GameOver {
-		return
-	}
-
 	died := game.CurrentShot == game.Chamber
-	totalPot := game.Bet * 2
+	totalPot := game.pot()
+	loc := game.Locale
 
 	if died {
+		shooter.Dead = true
+	}
+
+	if died && (game.SplitPot || len(game.survivors()) == 1) {
 		game.GameOver = true
-		winnerID := game.getOtherPlayer(game.CurrentTurn)
-
-		database.AddCoins(winnerID, totalPot)
-
-		embed := &discordgo.MessageEmbed{
-			Title:       "🔫 Russian Roulette - GAME OVER",
-			Description: fmt.Sprintf("💥 **POW!** <@%s> pulled the trigger and... **DIED!**", game.CurrentTurn),
-			Color:       0x8B0000,
-			Fields: []*discordgo.MessageEmbedField{
-				{
-					Name:   "🏆 Winner",
-					Value:  fmt.Sprintf("<@%s>", winnerID),
-					Inline: true,
-				},
-				{
-					Name:   "💰 Prize",
-					Value:  fmt.Sprintf("%d %s", totalPot, config.Bot.CurrencySymbol),
-					Inline: true,
-				},
-				{
-					Name:   "🎲 Details",
-					Value:  fmt.Sprintf("Round: %d | Shot position: %d/6", game.Round, game.CurrentShot),
-					Inline: false,
-				},
-			},
-			Footer: &discordgo.MessageEmbedFooter{
-				Text: "Game Over - The survivor takes all!",
-			},
-		}
+		embed := game.createGameOverEmbed(shooter, game.settle())
 
 		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseUpdateMessage,
@@ -407,117 +408,265 @@ func handleShoot(s *discordgo.Session, i *discordgo.InteractionCreate) {
 		rouletteMu.Lock()
 		delete(activeRouletteGames, gameID)
 		rouletteMu.Unlock()
+		game.finish()
+		return
+	}
 
-	} else {
-		survivor := game.CurrentTurn
-		nextPlayer := game.getOtherPlayer(survivor)
-
-		embed := &discordgo.MessageEmbed{
-			Title:       "🔫 Russian Roulette",
-			Description: fmt.Sprintf("😅 **CLICK!** <@%s> pulled the trigger and... survived!", survivor),
-			Color:       0x00FF00,
-			Fields: []*discordgo.MessageEmbedField{
-				{
-					Name:   "🎲 Result",
-					Value:  fmt.Sprintf("Chamber %d was empty!", game.CurrentShot),
-					Inline: false,
-				},
-				{
-					Name:   "💰 Total Pot",
-					Value:  fmt.Sprintf("%d %s", totalPot, config.Bot.CurrencySymbol),
-					Inline: true,
-				},
-				{
-					Name:   "🔄 Next",
-					Value:  fmt.Sprintf("<@%s>'s turn", nextPlayer),
-					Inline: true,
-				},
+	next := game.nextSurvivor(game.Turn)
+	embed := &discordgo.MessageEmbed{
+		Title:       loc.T("🔫 Russian Roulette"),
+		Description: loc.T("😅 **CLICK!** <@%s> pulled the trigger and... survived!", shooter.ID),
+		Color:       0x00FF00,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   loc.T("🎲 Result"),
+				Value:  loc.T("Chamber %d was empty!", game.CurrentShot),
+				Inline: false,
 			},
-		}
-
-		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-			Type: discordgo.InteractionResponseUpdateMessage,
-			Data: &discordgo.InteractionResponseData{
-				Embeds:     []*discordgo.MessageEmbed{embed},
-				Components: []discordgo.MessageComponent{},
+			{
+				Name:   loc.T("💰 Total Pot"),
+				Value:  fmt.Sprintf("%d %s", totalPot, config.Bot().CurrencySymbol),
+				Inline: true,
 			},
-		})
+			{
+				Name:   loc.T("🔄 Next"),
+				Value:  loc.T("<@%s>'s turn", game.Seats[next].ID),
+				Inline: true,
+			},
+		},
+	}
+	if died {
+		// Someone is out but more than one player is left: reload and go on
+		embed.Description = loc.T("💥 **POW!** <@%s> pulled the trigger and... **DIED!**", shooter.ID)
+		embed.Color = 0x8B0000
+		embed.Fields[0].Value = loc.T("%d players left. The cylinder is reloaded.", len(game.survivors()))
+	}
+
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: []discordgo.MessageComponent{},
+		},
+	})
+
+	time.Sleep(2 * time.Second)
+
+	game.CurrentShot++
+	game.Turn = next
+	game.Round++
+
+	if died || game.CurrentShot > 6 {
+		game.CurrentShot = 1
+		game.Chamber = rand.Intn(6) + 1
+	}
 
-		time.Sleep(2 * time.Second)
+	newEmbed := game.createGameEmbed()
+	components := game.createShootButton()
+
+	s.ChannelMessageEditComplex(&discordgo.MessageEdit{
+		Channel:    i.ChannelID,
+		ID:         i.Message.ID,
+		Embeds:     &[]*discordgo.MessageEmbed{newEmbed},
+		Components: &components,
+	})
+}
 
-		game.CurrentShot++
-		game.CurrentTurn = nextPlayer
-		game.Round++
+// pot is everything the players put in
+func (g *RussianRouletteGame) pot() int {
+	return g.Bet * len(g.Seats)
+}
 
-		if game.CurrentShot > 6 {
-			game.CurrentShot = 1
-			game.Chamber = rand.Intn(6) + 1
+func (g *RussianRouletteGame) survivors() []*roulettePlayer {
+	var alive []*roulettePlayer
+	for _, p := range g.Seats {
+		if !p.Dead {
+			alive = append(alive, p)
 		}
+	}
+	return alive
+}
 
-		newEmbed := game.createGameEmbed(totalPot)
-		components := game.createShootButton()
+// nextSurvivor is the seat after from that is still alive
+func (g *RussianRouletteGame) nextSurvivor(from int) int {
+	n := len(g.Seats)
+	for k := 1; k <= n; k++ {
+		if next := (from + k) % n; !g.Seats[next].Dead {
+			return next
+		}
+	}
+	return from
+}
 
-		s.ChannelMessageEditComplex(&discordgo.MessageEdit{
-			Channel:    i.ChannelID,
-			ID:         i.Message.ID,
-			Embeds:     &[]*discordgo.MessageEmbed{newEmbed},
-			Components: &components,
-		})
+// expire ends a game nobody kept shooting: whoever is still alive splits the
+// pot. Lobbies that have not started yet are left to their own countdown.
+func (g *RussianRouletteGame) expire() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !g.Started || g.GameOver {
+		return
 	}
+	g.GameOver = true
+	g.settle()
+
+	rouletteMu.Lock()
+	delete(activeRouletteGames, g.ID)
+	rouletteMu.Unlock()
+	g.finish()
 }
 
-func (g *RussianRouletteGame) createGameEmbed(totalPot int) *discordgo.MessageEmbed {
-	currentPlayerName := g.Player1Name
-	if g.CurrentTurn == g.Player2ID {
-		currentPlayerName = g.Player2Name
+// settle pays the pot to the survivors and the side-bet pool to whoever
+// backed them, returning the payouts by user for the final embed
+func (g *RussianRouletteGame) settle() map[string]int {
+	survivors := g.survivors()
+	ids := make([]string, len(survivors))
+	stakes := make([]int, len(survivors))
+	for n, p := range survivors {
+		ids[n], stakes[n] = p.ID, g.Bet
+	}
+	payouts := make(map[string]int)
+	for n, share := range splitShares(g.pot(), stakes) {
+		database.AddCoins(ids[n], share)
+		payouts[ids[n]] = share
+	}
+	for _, p := range g.Seats {
+		outcome := "survived"
+		if p.Dead {
+			outcome = "eliminated"
+		}
+		reportResult(p.ID, "russianroulette", g.Bet, payouts[p.ID], fmt.Sprintf("%s, %d players, %d rounds", outcome, len(g.Seats), g.Round))
 	}
+	g.settleSideBets(survivors)
+	return payouts
+}
 
-	return &discordgo.MessageEmbed{
-		Title:       "🔫 Russian Roulette",
-		Description: fmt.Sprintf("It's **%s**'s turn!\nClick the button to pull the trigger...", currentPlayerName),
+// splitShares divides a pool in proportion to the stakes. Coins left over
+// from rounding go one each to the first stakes.
+func splitShares(pool int, stakes []int) []int {
+	total := 0
+	for _, stake := range stakes {
+		total += stake
+	}
+	shares := make([]int, len(stakes))
+	if total == 0 {
+		return shares
+	}
+	paid := 0
+	for n, stake := range stakes {
+		shares[n] = pool * stake / total
+		paid += shares[n]
+	}
+	for n := 0; paid < pool; n = (n + 1) % len(shares) {
+		shares[n]++
+		paid++
+	}
+	return shares
+}
+
+func (g *RussianRouletteGame) createGameOverEmbed(loser *roulettePlayer, payouts map[string]int) *discordgo.MessageEmbed {
+	loc := g.Locale
+
+	var winners []string
+	for _, p := range g.survivors() {
+		winners = append(winners, loc.T("<@%s> (+%d %s)", p.ID, payouts[p.ID], config.Bot().CurrencySymbol))
+	}
+	footer := loc.T("Game Over - The survivor takes all!")
+	if g.SplitPot {
+		footer = loc.T("Game Over - The survivors split the pot!")
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       loc.T("🔫 Russian Roulette - GAME OVER"),
+		Description: loc.T("💥 **POW!** <@%s> pulled the trigger and... **DIED!**", loser.ID),
 		Color:       0x8B0000,
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name:   "👤 Player 1",
-				Value:  fmt.Sprintf("%s%s", g.Player1Name, getTurnIndicator(g.Player1ID, g.CurrentTurn)),
+				Name:   loc.T("🏆 Winner"),
+				Value:  strings.Join(winners, "\n"),
 				Inline: true,
 			},
 			{
-				Name:   "👤 Player 2",
-				Value:  fmt.Sprintf("%s%s", g.Player2Name, getTurnIndicator(g.Player2ID, g.CurrentTurn)),
+				Name:   loc.T("💰 Prize"),
+				Value:  fmt.Sprintf("%d %s", g.pot(), config.Bot().CurrencySymbol),
 				Inline: true,
 			},
 			{
-				Name:   "💰 Prize",
-				Value:  fmt.Sprintf("%d %s", totalPot, config.Bot.CurrencySymbol),
+				Name:   loc.T("🎲 Details"),
+				Value:  loc.T("Round: %d | Shot position: %d/6", g.Round, g.CurrentShot),
+				Inline: false,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: footer,
+		},
+	}
+	if field := g.sideBetsResultField(); field != nil {
+		embed.Fields = append(embed.Fields, field)
+	}
+	return embed
+}
+
+func (g *RussianRouletteGame) createGameEmbed() *discordgo.MessageEmbed {
+	loc := g.Locale
+	totalPot := g.pot()
+
+	var players []string
+	for n, p := range g.Seats {
+		if p.Dead {
+			players = append(players, "💀 ~~"+p.Name+"~~")
+			continue
+		}
+		players = append(players, p.Name+getTurnIndicator(loc, n, g.Turn))
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       loc.T("🔫 Russian Roulette"),
+		Description: loc.T("It's **%s**'s turn!\nClick the button to pull the trigger...", g.Seats[g.Turn].Name),
+		Color:       0x8B0000,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   loc.T("👥 Players (%d alive)", len(g.survivors())),
+				Value:  strings.Join(players, "\n"),
+				Inline: false,
+			},
+			{
+				Name:   loc.T("💰 Prize"),
+				Value:  fmt.Sprintf("%d %s", totalPot, config.Bot().CurrencySymbol),
 				Inline: true,
 			},
 			{
-				Name:   "🎲 Round",
+				Name:   loc.T("🎲 Round"),
 				Value:  fmt.Sprintf("%d", g.Round),
 				Inline: true,
 			},
 			{
-				Name:   "🔫 Cylinder",
-				Value:  fmt.Sprintf("Position %d/6", g.CurrentShot),
+				Name:   loc.T("🔫 Cylinder"),
+				Value:  loc.T("Position %d/6", g.CurrentShot),
 				Inline: true,
 			},
 		},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: fmt.Sprintf("Survivor takes %d %s!", totalPot, config.Bot.CurrencySymbol),
+			Text: g.prizeFooter(),
 		},
 	}
 }
 
+func (g *RussianRouletteGame) prizeFooter() string {
+	if g.SplitPot {
+		return g.Locale.T("The first death ends the game. Survivors split %d %s!", g.pot(), config.Bot().CurrencySymbol)
+	}
+	return g.Locale.T("Survivor takes %d %s!", g.pot(), config.Bot().CurrencySymbol)
+}
+
 func (g *RussianRouletteGame) createShootButton() []discordgo.MessageComponent {
-	gameID := fmt.Sprintf("%s_%s", g.Player1ID, g.Player2ID)
+	loc := g.Locale
 	return []discordgo.MessageComponent{
 		discordgo.ActionsRow{
 			Components: []discordgo.MessageComponent{
 				discordgo.Button{
-					Label:    "🔫 SHOOT",
+					Label:    loc.T("🔫 SHOOT"),
 					Style:    discordgo.DangerButton,
-					CustomID: fmt.Sprintf("rr_shoot_%s", gameID),
+					CustomID: fmt.Sprintf("rr_shoot_%s", g.ID),
 					Emoji:    &discordgo.ComponentEmoji{Name: "💀"},
 				},
 			},
@@ -525,47 +674,67 @@ func (g *RussianRouletteGame) createShootButton() []discordgo.MessageComponent {
 	}
 }
 
-func (g *RussianRouletteGame) getOtherPlayer(playerID string) string {
-	if playerID == g.Player1ID {
-		return g.Player2ID
-	}
-	return g.Player1ID
-}
-
-func getTurnIndicator(playerID string, currentTurn string) string {
-	if playerID == currentTurn {
-		return " ⬅️ (Your turn)"
+func getTurnIndicator(loc i18n.Locale, seat, turn int) string {
+	if seat == turn {
+		return loc.T(" ⬅️ (Your turn)")
 	}
 	return ""
 }
 
-func expireChallenge(s *discordgo.Session, challengedID string) {
+func expireChallenge(s *discordgo.Session, key string) {
 	pendingMu.Lock()
-	challenge, exists := pendingChallenges[challengedID]
+	challenge, exists := pendingChallenges[key]
 	if !exists {
 		pendingMu.Unlock()
 		return
 	}
-	delete(pendingChallenges, challengedID)
+	delete(pendingChallenges, key)
 	pendingMu.Unlock()
 
-	s.ChannelMessageSend(challenge.ChannelID, fmt.Sprintf("⏰ <@%s> did not respond to <@%s>'s challenge in time! Challenge expired.", challengedID, challenge.ChallengerID))
+	if challenge.ChallengedID == "" {
+		s.ChannelMessageSend(challenge.ChannelID, challenge.Locale.T("⏰ Nobody accepted <@%s>'s open challenge in time! Challenge expired.", challenge.ChallengerID))
+		return
+	}
+	s.ChannelMessageSend(challenge.ChannelID, challenge.Locale.T("⏰ <@%s> did not respond to <@%s>'s challenge in time! Challenge expired.", challenge.ChallengedID, challenge.ChallengerID))
 }
 
-func cleanupChallenge(challengedID string) {
+func cleanupChallenge(key string) {
 	pendingMu.Lock()
-	delete(pendingChallenges, challengedID)
+	delete(pendingChallenges, key)
 	pendingMu.Unlock()
 }
 
+// openChallengeKey is where an open challenge waits in pendingChallenges
+func openChallengeKey(challengerID string) string {
+	return "open_" + challengerID
+}
+
 func isPlayerInGame(playerID string) bool {
 	rouletteMu.Lock()
-	defer rouletteMu.Unlock()
+	games := make([]*RussianRouletteGame, 0, len(activeRouletteGames))
+	for _, game := range activeRouletteGames {
+		games = append(games, game)
+	}
+	rouletteMu.Unlock()
 
-	for gameID := range activeRouletteGames {
-		if strings.Contains(gameID, playerID) {
+	// Games lock rouletteMu while holding their own lock, so they are
+	// checked one by one after releasing it
+	for _, game := range games {
+		game.mu.Lock()
+		seated := game.player(playerID) != nil
+		game.mu.Unlock()
+		if seated {
 			return true
 		}
 	}
 	return false
 }
+
+func (g *RussianRouletteGame) player(userID string) *roulettePlayer {
+	for _, p := range g.Seats {
+		if p.ID == userID {
+			return p
+		}
+	}
+	return nil
+}
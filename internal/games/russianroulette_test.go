@@ -0,0 +1,40 @@
+package games
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSplitShares(t *testing.T) {
+	tests := []struct {
+		name   string
+		pool   int
+		stakes []int
+		want   []int
+	}{
+		{"even split", 300, []int{100, 100, 100}, []int{100, 100, 100}},
+		{"proportional", 400, []int{100, 300}, []int{100, 300}},
+		{"remainder to the first stakes", 100, []int{1, 1, 1}, []int{34, 33, 33}},
+		{"remainder after rounding down", 10, []int{1, 2, 4}, []int{2, 3, 5}},
+		{"single winner takes all", 999, []int{7}, []int{999}},
+		{"empty pool", 0, []int{5, 5}, []int{0, 0}},
+		{"no stakes", 100, []int{0, 0}, []int{0, 0}},
+		{"no players", 100, nil, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := splitShares(tt.pool, tt.stakes)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("splitShares(%d, %v) = %v, want %v", tt.pool, tt.stakes, got, tt.want)
+			}
+			paid, staked := 0, 0
+			for n, share := range got {
+				paid += share
+				staked += tt.stakes[n]
+			}
+			if staked > 0 && paid != tt.pool {
+				t.Errorf("paid %d of a pool of %d", paid, tt.pool)
+			}
+		})
+	}
+}
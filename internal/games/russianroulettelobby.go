@@ -0,0 +1,380 @@
+package games
+
+import (
+	"estudocoin/internal/database"
+	"estudocoin/internal/i18n"
+	"estudocoin/pkg/config"
+	"fmt"
+	"log"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Russian Roulette lobbies: one player opens a game in the channel, others
+// join with the button until the countdown ends or the table is full, and
+// spectators bet on who survives.
+
+const (
+	MinRussianRouletteBet = 50
+	MinLobbyPlayers       = 2
+	MaxLobbyPlayers       = 6
+	// LobbyCountdown is how long a lobby takes players before the first shot
+	LobbyCountdown = 45 * time.Second
+	// MinSideBet is the smallest spectator bet
+	MinSideBet = 10
+)
+
+// sideBet is a spectator's bet on a player surviving
+type sideBet struct {
+	UserID string
+	On     string // player ID
+	Amount int
+	Payout int
+}
+
+func lobbyID(channelID string) string {
+	return "lobby_" + channelID
+}
+
+// OpenRouletteLobby opens a lobby in the channel with the author seated.
+// With split, the first death ends the game and the survivors share the pot.
+func OpenRouletteLobby(s *discordgo.Session, guildID, channelID string, author *discordgo.User, bet int, split bool) error {
+	if bet < MinRussianRouletteBet {
+		return i18n.Errorf("Minimum bet is %d %s", MinRussianRouletteBet, config.Bot().CurrencySymbol)
+	}
+	if balance := database.GetBalance(author.ID); balance < bet {
+		return i18n.Errorf("Insufficient balance! You have %d %s", balance, config.Bot().CurrencySymbol)
+	}
+	if isPlayerInGame(author.ID) {
+		return i18n.Errorf("You are already in a Russian Roulette game!")
+	}
+
+	game := &RussianRouletteGame{
+		ID:        lobbyID(channelID),
+		Seats:     []*roulettePlayer{{ID: author.ID, Name: author.Username}},
+		Bet:       bet,
+		ChannelID: channelID,
+		SplitPot:  split,
+		Locale:    i18n.ForGuild(guildID),
+		StartsAt:  time.Now().Add(LobbyCountdown),
+		full:      make(chan struct{}),
+		session:   newSession("russianroulette", channelID, author.ID),
+	}
+
+	rouletteMu.Lock()
+	if _, exists := activeRouletteGames[game.ID]; exists {
+		rouletteMu.Unlock()
+		return i18n.Errorf("There is already a Russian Roulette lobby in this channel.")
+	}
+	// Held until the lobby message is posted, so the countdown starts with it
+	game.mu.Lock()
+	defer game.mu.Unlock()
+	if err := Sessions.Start(game, func() { runRouletteLobby(s, game) }); err != nil {
+		rouletteMu.Unlock()
+		return err
+	}
+	if err := escrowStake(author.ID, bet); err != nil {
+		rouletteMu.Unlock()
+		game.GameOver = true
+		game.finish()
+		return err
+	}
+	activeRouletteGames[game.ID] = game
+	rouletteMu.Unlock()
+
+	msg, err := s.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Embeds:     []*discordgo.MessageEmbed{game.lobbyEmbed()},
+		Components: game.lobbyButtons(),
+	})
+	if err != nil {
+		log.Printf("[ROULETTE ERROR] Could not post the lobby of %s: %v", channelID, err)
+		return nil
+	}
+	game.MessageID = msg.ID
+	return nil
+}
+
+// runRouletteLobby waits for the countdown (or a full table), then fires the
+// first round or cancels the lobby when too few players joined
+func runRouletteLobby(s *discordgo.Session, g *RussianRouletteGame) {
+	select {
+	case <-time.After(time.Until(g.StartsAt)):
+	case <-g.full:
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.GameOver {
+		return
+	}
+
+	if len(g.Seats) < MinLobbyPlayers {
+		g.GameOver = true
+		refundStake(g.Seats[0].ID, g.Bet)
+		for _, bet := range g.SideBets {
+			refundStake(bet.UserID, bet.Amount)
+		}
+		rouletteMu.Lock()
+		delete(activeRouletteGames, g.ID)
+		rouletteMu.Unlock()
+		g.finish()
+
+		embed := g.lobbyEmbed()
+		embed.Description = g.Locale.T("Not enough players joined. All bets were refunded.")
+		g.editLobby(s, embed, []discordgo.MessageComponent{})
+		return
+	}
+
+	g.Started = true
+	g.Round = 1
+	g.Chamber = rand.Intn(6) + 1
+	g.CurrentShot = 1
+	g.Turn = rand.Intn(len(g.Seats))
+	g.editLobby(s, g.createGameEmbed(), g.createShootButton())
+}
+
+// editLobby replaces the lobby message. Caller holds g.mu.
+func (g *RussianRouletteGame) editLobby(s *discordgo.Session, embed *discordgo.MessageEmbed, components []discordgo.MessageComponent) {
+	if g.MessageID == "" {
+		return
+	}
+	s.ChannelMessageEditComplex(&discordgo.MessageEdit{
+		ID:         g.MessageID,
+		Channel:    g.ChannelID,
+		Embeds:     &[]*discordgo.MessageEmbed{embed},
+		Components: &components,
+	})
+}
+
+// openLobby returns the lobby of a channel that is still taking players
+// and bets, locked. The caller unlocks it.
+func openLobby(channelID string) (*RussianRouletteGame, error) {
+	rouletteMu.Lock()
+	game, exists := activeRouletteGames[lobbyID(channelID)]
+	rouletteMu.Unlock()
+	if !exists {
+		return nil, i18n.Errorf("There is no Russian Roulette lobby in this channel.")
+	}
+
+	game.mu.Lock()
+	if game.Started || game.GameOver {
+		game.mu.Unlock()
+		return nil, i18n.Errorf("This game already started.")
+	}
+	return game, nil
+}
+
+func handleLobbyJoin(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	loc := i18n.ForInteraction(i)
+	user := i.Member.User
+	channelID := strings.TrimPrefix(i.MessageComponentData().CustomID, "rr_join_")
+
+	// Checked before taking the lobby lock, which isPlayerInGame also takes
+	if isPlayerInGame(user.ID) {
+		respondPrivate(s, i, loc.ErrorEmbed("You are already in a Russian Roulette game!"))
+		return
+	}
+
+	game, err := openLobby(channelID)
+	if err != nil {
+		respondPrivate(s, i, loc.ErrorEmbed(loc.Err(err)))
+		return
+	}
+	defer game.mu.Unlock()
+
+	if err := game.join(user); err != nil {
+		respondPrivate(s, i, loc.ErrorEmbed(loc.Err(err)))
+		return
+	}
+
+	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{game.lobbyEmbed()},
+			Components: game.lobbyButtons(),
+		},
+	})
+}
+
+// join seats a player and takes their bet. Caller holds g.mu.
+func (g *RussianRouletteGame) join(user *discordgo.User) error {
+	if len(g.Seats) >= MaxLobbyPlayers {
+		return i18n.Errorf("The lobby is full.")
+	}
+	for _, bet := range g.SideBets {
+		if bet.UserID == user.ID {
+			return i18n.Errorf("You have a side bet on this game and can't play in it.")
+		}
+	}
+	if balance := database.GetBalance(user.ID); balance < g.Bet {
+		return i18n.Errorf("Insufficient balance! You have %d %s", balance, config.Bot().CurrencySymbol)
+	}
+	if err := Sessions.Join(g, user.ID); err != nil {
+		return err
+	}
+	if err := escrowStake(user.ID, g.Bet); err != nil {
+		Sessions.Leave(g, user.ID)
+		return err
+	}
+
+	g.Seats = append(g.Seats, &roulettePlayer{ID: user.ID, Name: user.Username})
+	if len(g.Seats) == MaxLobbyPlayers {
+		close(g.full)
+	}
+	return nil
+}
+
+// PlaceRouletteSideBet bets on a lobby player surviving. The side-bet pool
+// is shared by everyone who backed a survivor, in proportion to their bets.
+func PlaceRouletteSideBet(s *discordgo.Session, channelID, userID, playerID string, amount int) error {
+	if playerID == "" {
+		return i18n.Errorf("Mention a valid user. Example: `!roulette bet @user 100`")
+	}
+	if amount < MinSideBet {
+		return i18n.Errorf("Minimum bet is %d %s", MinSideBet, config.Bot().CurrencySymbol)
+	}
+
+	game, err := openLobby(channelID)
+	if err != nil {
+		return err
+	}
+	defer game.mu.Unlock()
+
+	if game.player(userID) != nil {
+		return i18n.Errorf("Players can't place side bets on their own game.")
+	}
+	if game.player(playerID) == nil {
+		return i18n.Errorf("That user is not in the lobby.")
+	}
+	if err := escrowStake(userID, amount); err != nil {
+		return err
+	}
+
+	game.SideBets = append(game.SideBets, &sideBet{UserID: userID, On: playerID, Amount: amount})
+	game.editLobby(s, game.lobbyEmbed(), game.lobbyButtons())
+	return nil
+}
+
+// settleSideBets shares the side-bet pool among the bets on the survivors,
+// through the same split as the pot. When nobody backed a survivor every
+// bet is refunded. Caller holds g.mu.
+func (g *RussianRouletteGame) settleSideBets(survivors []*roulettePlayer) {
+	pool := 0
+	var winning []*sideBet
+	for _, bet := range g.SideBets {
+		pool += bet.Amount
+		for _, p := range survivors {
+			if bet.On == p.ID {
+				winning = append(winning, bet)
+			}
+		}
+	}
+	if pool == 0 {
+		return
+	}
+
+	if len(winning) == 0 {
+		for _, bet := range g.SideBets {
+			bet.Payout = bet.Amount
+			refundStake(bet.UserID, bet.Amount)
+		}
+		return
+	}
+
+	stakes := make([]int, len(winning))
+	for n, bet := range winning {
+		stakes[n] = bet.Amount
+	}
+	for n, share := range splitShares(pool, stakes) {
+		winning[n].Payout = share
+		database.AddCoins(winning[n].UserID, share)
+	}
+	for _, bet := range g.SideBets {
+		reportResult(bet.UserID, "russianroulette_sidebet", bet.Amount, bet.Payout, fmt.Sprintf("backed <@%s>", bet.On))
+	}
+}
+
+// sideBetsResultField lists what each spectator got back. Caller holds g.mu.
+func (g *RussianRouletteGame) sideBetsResultField() *discordgo.MessageEmbedField {
+	if len(g.SideBets) == 0 {
+		return nil
+	}
+	loc := g.Locale
+	var lines []string
+	for _, bet := range g.SideBets {
+		lines = append(lines, loc.T("<@%s> on <@%s>: %d ➜ %d %s", bet.UserID, bet.On, bet.Amount, bet.Payout, config.Bot().CurrencySymbol))
+	}
+	return &discordgo.MessageEmbedField{Name: loc.T("🎟️ Side Bets"), Value: strings.Join(lines, "\n")}
+}
+
+// lobbyEmbed renders the players and the side bets. Caller holds g.mu.
+func (g *RussianRouletteGame) lobbyEmbed() *discordgo.MessageEmbed {
+	loc := g.Locale
+	var players []string
+	backing := make(map[string]int)
+	pool := 0
+	for _, bet := range g.SideBets {
+		backing[bet.On] += bet.Amount
+		pool += bet.Amount
+	}
+	for _, p := range g.Seats {
+		line := fmt.Sprintf("<@%s>", p.ID)
+		if backing[p.ID] > 0 {
+			line += " " + loc.T("(🎟️ %d %s)", backing[p.ID], config.Bot().CurrencySymbol)
+		}
+		players = append(players, line)
+	}
+
+	mode := loc.T("The last survivor takes the pot.")
+	if g.SplitPot {
+		mode = loc.T("The first death ends the game. The survivors split the pot.")
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       loc.T("🔫 Russian Roulette Lobby"),
+		Description: loc.T("<@%s> opened a game of Russian Roulette! Join with the button.\nThe first shot is fired <t:%d:R>.", g.Seats[0].ID, g.StartsAt.Unix()),
+		Color:       0x8B0000,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   loc.T("👥 Players (%d/%d)", len(g.Seats), MaxLobbyPlayers),
+				Value:  strings.Join(players, "\n"),
+				Inline: false,
+			},
+			{
+				Name:   loc.T("💰 Bet"),
+				Value:  fmt.Sprintf("%d %s", g.Bet, config.Bot().CurrencySymbol),
+				Inline: true,
+			},
+			{
+				Name:   loc.T("🎟️ Side Bets"),
+				Value:  fmt.Sprintf("%d %s", pool, config.Bot().CurrencySymbol),
+				Inline: true,
+			},
+			{
+				Name:   loc.T("📜 Rules"),
+				Value:  mode,
+				Inline: false,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: loc.T("Spectators: !roulette bet @player <amount> to bet on a survivor"),
+		},
+	}
+}
+
+func (g *RussianRouletteGame) lobbyButtons() []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    g.Locale.T("✅ Join (%d %s)", g.Bet, config.Bot().CurrencySymbol),
+					Style:    discordgo.SuccessButton,
+					CustomID: "rr_join_" + g.ChannelID,
+				},
+			},
+		},
+	}
+}
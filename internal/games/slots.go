@@ -2,6 +2,7 @@ package games
 
 import (
 	"estudocoin/internal/database"
+	"estudocoin/internal/i18n"
 	"estudocoin/pkg/config"
 	"estudocoin/pkg/utils"
 	"fmt"
@@ -15,6 +16,10 @@ import (
 
 const MinSlotsBet = 10
 
+// progressiveSymbol is the symbol that wins the progressive pool when it
+// fills a payline
+const progressiveSymbol = "seven"
+
 var (
 	slotSymbols = []SlotSymbol{
 		{Name: "cherry", Emoji: "🍒", Value: 2, Weight: 35},
@@ -40,8 +45,18 @@ type SlotsSession struct {
 	UserID    string
 	Username  string
 	Bet       int
+	Lines     int // paylines on the 3x3 grid, 0 for the classic single reel
 	ChannelID string
 	MessageID string
+	*session
+}
+
+// stake is what a spin costs: the bet on each payline
+func (g *SlotsSession) stake() int {
+	if g.Lines > 0 {
+		return g.Bet * g.Lines
+	}
+	return g.Bet
 }
 
 type SlotsResult struct {
@@ -52,25 +67,63 @@ type SlotsResult struct {
 	IsJackpot  bool
 	IsTwoMatch bool
 	Multiplier float64
+
+	// HitProgressive is set by three sevens on a payline, which wins the
+	// whole progressive pool; Progressive is the amount that was paid
+	HitProgressive bool
+	Progressive    int
+
+	// Grid mode only
+	Grid     [3][3]SlotSymbol
+	LineWins []slotLineWin
 }
 
 func StartSlotsText(s *discordgo.Session, m *discordgo.MessageCreate, bet int) {
-	startSlots(s, m.Author.ID, m.Author.Username, bet, m.ChannelID, func(msg *discordgo.MessageSend) (*discordgo.Message, error) {
+	StartSlotsLinesText(s, m, bet, 0)
+}
+
+// StartSlotsLinesText starts a slots game on the 3x3 grid with the given
+// number of paylines (0 = classic single reel)
+func StartSlotsLinesText(s *discordgo.Session, m *discordgo.MessageCreate, bet, lines int) {
+	startSlots(s, i18n.ForMessage(m), m.Author.ID, m.Author.Username, bet, lines, m.ChannelID, func(msg *discordgo.MessageSend) (*discordgo.Message, error) {
 		return s.ChannelMessageSendComplex(m.ChannelID, msg)
 	})
 }
 
 func StartSlotsInteraction(s *discordgo.Session, i *discordgo.InteractionCreate, bet int) {
+	StartSlotsLinesInteraction(s, i, bet, 0)
+}
+
+// StartSlotsLinesInteraction is StartSlotsLinesText for slash commands
+func StartSlotsLinesInteraction(s *discordgo.Session, i *discordgo.InteractionCreate, bet, lines int) {
+	loc := i18n.ForInteraction(i)
+	if lines < 0 || lines > len(slotPaylines) {
+		respondPrivate(s, i, loc.ErrorEmbed("Choose between 1 and %d paylines.", len(slotPaylines)))
+		return
+	}
+	slots := &SlotsSession{
+		UserID:    i.Member.User.ID,
+		Username:  i.Member.User.Username,
+		Bet:       bet,
+		Lines:     lines,
+		ChannelID: i.ChannelID,
+		session:   newSession("slots", i.ChannelID, i.Member.User.ID),
+	}
+	if err := Sessions.Start(slots, nil); err != nil {
+		respondPrivate(s, i, loc.ErrorEmbed(err.Error()))
+		return
+	}
+
 	var msg *discordgo.Message
 	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseChannelMessageWithSource,
 		Data: &discordgo.InteractionResponseData{
-			Embeds: []*discordgo.MessageEmbed{createInitialEmbed(i.Member.User.Username, bet)},
+			Embeds: []*discordgo.MessageEmbed{createInitialEmbed(loc, slots)},
 			Components: []discordgo.MessageComponent{
 				discordgo.ActionsRow{
 					Components: []discordgo.MessageComponent{
 						discordgo.Button{
-							Label:    "🎰 PULL LEVER",
+							Label:    loc.T("🎰 PULL LEVER"),
 							Style:    discordgo.PrimaryButton,
 							CustomID: fmt.Sprintf("slots_spin_%s_%d", i.Member.User.ID, bet),
 							Emoji:    &discordgo.ComponentEmoji{Name: "🎰"},
@@ -81,45 +134,62 @@ func StartSlotsInteraction(s *discordgo.Session, i *discordgo.InteractionCreate,
 		},
 	})
 	if err == nil {
-		msg, _ = s.InteractionResponse(i.Interaction)
-		slotsMu.Lock()
-		activeSlotsSessions[i.Member.User.ID] = &SlotsSession{
-			UserID:    i.Member.User.ID,
-			Username:  i.Member.User.Username,
-			Bet:       bet,
-			ChannelID: i.ChannelID,
-			MessageID: msg.ID,
-		}
-		slotsMu.Unlock()
+		msg, err = s.InteractionResponse(i.Interaction)
 	}
+	if err != nil {
+		slots.finish()
+		return
+	}
+
+	slots.MessageID = msg.ID
+	slotsMu.Lock()
+	activeSlotsSessions[i.Member.User.ID] = slots
+	slotsMu.Unlock()
 }
 
-func startSlots(s *discordgo.Session, userID string, username string, bet int, channelID string, sender func(*discordgo.MessageSend) (*discordgo.Message, error)) {
+func startSlots(s *discordgo.Session, loc i18n.Locale, userID string, username string, bet, lines int, channelID string, sender func(*discordgo.MessageSend) (*discordgo.Message, error)) {
 	if bet < MinSlotsBet {
-		s.ChannelMessageSend(channelID, fmt.Sprintf("❌ Minimum bet is %d %s", MinSlotsBet, config.Bot.CurrencySymbol))
+		s.ChannelMessageSend(channelID, loc.T("❌ Minimum bet is %d %s", MinSlotsBet, config.Bot().CurrencySymbol))
+		return
+	}
+	if lines < 0 || lines > len(slotPaylines) {
+		s.ChannelMessageSend(channelID, "❌ "+loc.T("Choose between 1 and %d paylines.", len(slotPaylines)))
 		return
 	}
 
 	balance := database.GetBalance(userID)
-	if balance < bet {
-		s.ChannelMessageSend(channelID, fmt.Sprintf("❌ <@%s> Insufficient balance! You have %d %s", userID, balance, config.Bot.CurrencySymbol))
+	if balance < bet*max(lines, 1) {
+		s.ChannelMessageSend(channelID, loc.T("❌ <@%s> Insufficient balance! You have %d %s", userID, balance, config.Bot().CurrencySymbol))
 		return
 	}
 
 	slotsMu.Lock()
 	if activeSlotsSessions[userID] != nil {
 		slotsMu.Unlock()
-		s.ChannelMessageSend(channelID, fmt.Sprintf("❌ <@%s> You already have an active slots game!", userID))
+		s.ChannelMessageSend(channelID, loc.T("❌ <@%s> You already have an active slots game!", userID))
 		return
 	}
 	slotsMu.Unlock()
 
-	embed := createInitialEmbed(username, bet)
+	slots := &SlotsSession{
+		UserID:    userID,
+		Username:  username,
+		Bet:       bet,
+		Lines:     lines,
+		ChannelID: channelID,
+		session:   newSession("slots", channelID, userID),
+	}
+	if err := Sessions.Start(slots, nil); err != nil {
+		s.ChannelMessageSend(channelID, "❌ "+loc.T(err.Error()))
+		return
+	}
+
+	embed := createInitialEmbed(loc, slots)
 	buttons := []discordgo.MessageComponent{
 		discordgo.ActionsRow{
 			Components: []discordgo.MessageComponent{
 				discordgo.Button{
-					Label:    "🎰 PULL LEVER",
+					Label:    loc.T("🎰 PULL LEVER"),
 					Style:    discordgo.PrimaryButton,
 					CustomID: fmt.Sprintf("slots_spin_%s_%d", userID, bet),
 					Emoji:    &discordgo.ComponentEmoji{Name: "🎰"},
@@ -133,26 +203,30 @@ func startSlots(s *discordgo.Session, userID string, username string, bet int, c
 		Components: buttons,
 	})
 
-	if err == nil && msg != nil {
-		slotsMu.Lock()
-		activeSlotsSessions[userID] = &SlotsSession{
-			UserID:    userID,
-			Username:  username,
-			Bet:       bet,
-			ChannelID: channelID,
-			MessageID: msg.ID,
-		}
-		slotsMu.Unlock()
+	if err != nil || msg == nil {
+		slots.finish()
+		return
 	}
+
+	slots.MessageID = msg.ID
+	slotsMu.Lock()
+	activeSlotsSessions[userID] = slots
+	slotsMu.Unlock()
 }
 
-func createInitialEmbed(username string, bet int) *discordgo.MessageEmbed {
+func createInitialEmbed(loc i18n.Locale, session *SlotsSession) *discordgo.MessageEmbed {
+	description := loc.T("**%s** is ready to play!\n\n# ❓ | ❓ | ❓\n\n**Bet:** %d %s\n\n*Click the button to pull the lever!*", session.Username, session.Bet, config.Bot().CurrencySymbol)
+	if session.Lines > 0 {
+		description = loc.T("**%s** is ready to play!\n\n%s\n\n**Bet:** %d %s x %d lines = %d %s\n\n*Click the button to pull the lever!*",
+			session.Username, gridDisplay(unknownGrid()), session.Bet, config.Bot().CurrencySymbol, session.Lines, session.stake(), config.Bot().CurrencySymbol)
+	}
 	return &discordgo.MessageEmbed{
-		Title:       "🎰 Slot Machine",
-		Description: fmt.Sprintf("**%s** is ready to play!\n\n# ❓ | ❓ | ❓\n\n**Bet:** %d %s\n\n*Click the button to pull the lever!*", username, bet, config.Bot.CurrencySymbol),
+		Title:       loc.T("🎰 Slot Machine"),
+		Description: description,
 		Color:       0x8B0000,
+		Fields:      []*discordgo.MessageEmbedField{slotsJackpotField(loc)},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: "🍒🍋🍊 = Small | 🔔 = Medium | 💎 = High | 7️⃣ = JACKPOT!",
+			Text: loc.T("🍒🍋🍊 = Small | 🔔 = Medium | 💎 = High | 7️⃣ = JACKPOT!"),
 		},
 	}
 }
@@ -169,12 +243,13 @@ func HandleSlotsInteraction(s *discordgo.Session, i *discordgo.InteractionCreate
 	}
 
 	userID := parts[2]
+	loc := i18n.ForInteraction(i)
 
 	if i.Member.User.ID != userID {
 		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
-				Content: "❌ This is not your game!",
+				Content: loc.T("❌ This is not your game!"),
 				Flags:   discordgo.MessageFlagsEphemeral,
 			},
 		})
@@ -191,32 +266,58 @@ func HandleSlotsInteraction(s *discordgo.Session, i *discordgo.InteractionCreate
 	slotsMu.Unlock()
 
 	balance := database.GetBalance(userID)
-	if balance < session.Bet {
+	if balance < session.stake() {
 		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseUpdateMessage,
 			Data: &discordgo.InteractionResponseData{
-				Content:    fmt.Sprintf("❌ <@%s> Insufficient balance!", userID),
+				Content:    loc.T("❌ <@%s> Insufficient balance!", userID),
 				Embeds:     []*discordgo.MessageEmbed{},
 				Components: []discordgo.MessageComponent{},
 			},
 		})
+		session.finish()
 		return
 	}
 
-	database.CollectLostBet(userID, session.Bet)
+	if err := collectStake(userID, session.stake()); err != nil {
+		s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{
+				Content:    fmt.Sprintf("❌ <@%s> %s", userID, loc.Err(err)),
+				Embeds:     []*discordgo.MessageEmbed{},
+				Components: []discordgo.MessageComponent{},
+			},
+		})
+		session.finish()
+		return
+	}
+	feedSlotsJackpot(session.stake())
 
 	s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseUpdateMessage,
 		Data: &discordgo.InteractionResponseData{
-			Embeds:     []*discordgo.MessageEmbed{createSpinningEmbed(session.Username, session.Bet)},
+			Embeds:     []*discordgo.MessageEmbed{createSpinningEmbed(loc, session)},
 			Components: []discordgo.MessageComponent{},
 		},
 	})
 
-	go runSlotAnimation(s, i.ChannelID, i.Message.ID, session)
+	go runSlotAnimation(s, loc, i.ChannelID, i.Message.ID, session)
 }
 
-func runSlotAnimation(s *discordgo.Session, channelID, messageID string, session *SlotsSession) {
+// expire drops a machine left without a spin. A spin already under way
+// finishes the session by itself.
+func (session *SlotsSession) expire() {
+	slotsMu.Lock()
+	defer slotsMu.Unlock()
+	if activeSlotsSessions[session.UserID] == session {
+		delete(activeSlotsSessions, session.UserID)
+		session.finish()
+	}
+}
+
+func runSlotAnimation(s *discordgo.Session, loc i18n.Locale, channelID, messageID string, session *SlotsSession) {
+	defer session.finish()
+
 	animationFrames := []string{
 		"🍒 | 🍋 | 🍊",
 		"🍋 | 🍊 | 🔔",
@@ -229,11 +330,17 @@ func runSlotAnimation(s *discordgo.Session, channelID, messageID string, session
 		"🍊 | 7️⃣ | 💎",
 	}
 
-	for _, frame := range animationFrames {
+	jackpot := slotsJackpotField(loc)
+	for n, frame := range animationFrames {
+		if session.Lines > 0 {
+			// Each row of the grid shows a different frame
+			frame = strings.Join([]string{frame, animationFrames[(n+3)%len(animationFrames)], animationFrames[(n+6)%len(animationFrames)]}, "\n# ")
+		}
 		embed := &discordgo.MessageEmbed{
-			Title:       "🎰 Slot Machine",
-			Description: fmt.Sprintf("**%s** is spinning...\n\n# %s\n\n**Bet:** %d %s", session.Username, frame, session.Bet, config.Bot.CurrencySymbol),
+			Title:       loc.T("🎰 Slot Machine"),
+			Description: loc.T("**%s** is spinning...\n\n# %s\n\n**Bet:** %d %s", session.Username, frame, session.stake(), config.Bot().CurrencySymbol),
 			Color:       0xFFD700,
+			Fields:      []*discordgo.MessageEmbedField{jackpot},
 		}
 
 		s.ChannelMessageEditComplex(&discordgo.MessageEdit{
@@ -245,13 +352,27 @@ func runSlotAnimation(s *discordgo.Session, channelID, messageID string, session
 		time.Sleep(200 * time.Millisecond)
 	}
 
-	result := spinSlots(session.Bet)
+	var result SlotsResult
+	if session.Lines > 0 {
+		result = spinSlotsGrid(session.Bet, session.Lines)
+	} else {
+		result = spinSlots(session.Bet)
+	}
 
 	if result.WinAmount > 0 {
 		database.AddCoins(session.UserID, result.WinAmount)
 	}
+	if result.HitProgressive {
+		result.Progressive = winSlotsJackpot(s, session)
+	}
+	reportResult(session.UserID, "slots", session.stake(), result.WinAmount+result.Progressive, slotsDetail(session, result))
 
-	finalEmbed := createResultEmbed(session.Username, session.Bet, result)
+	var finalEmbed *discordgo.MessageEmbed
+	if session.Lines > 0 {
+		finalEmbed = createGridResultEmbed(loc, session, result)
+	} else {
+		finalEmbed = createResultEmbed(loc, session.Username, session.Bet, result)
+	}
 	s.ChannelMessageEditComplex(&discordgo.MessageEdit{
 		Channel:    channelID,
 		ID:         messageID,
@@ -260,14 +381,33 @@ func runSlotAnimation(s *discordgo.Session, channelID, messageID string, session
 	})
 }
 
-func createSpinningEmbed(username string, bet int) *discordgo.MessageEmbed {
+func createSpinningEmbed(loc i18n.Locale, session *SlotsSession) *discordgo.MessageEmbed {
 	return &discordgo.MessageEmbed{
-		Title:       "🎰 Slot Machine",
-		Description: fmt.Sprintf("**%s** is spinning...\n\n# 🎰 | 🎰 | 🎰\n\n**Bet:** %d %s", username, bet, config.Bot.CurrencySymbol),
+		Title:       loc.T("🎰 Slot Machine"),
+		Description: loc.T("**%s** is spinning...\n\n# 🎰 | 🎰 | 🎰\n\n**Bet:** %d %s", session.Username, session.stake(), config.Bot().CurrencySymbol),
 		Color:       0xFFD700,
+		Fields:      []*discordgo.MessageEmbedField{slotsJackpotField(loc)},
 	}
 }
 
+// slotsDetail describes a finished spin for the game history
+func slotsDetail(session *SlotsSession, result SlotsResult) string {
+	var detail string
+	if session.Lines > 0 {
+		rows := make([]string, len(result.Grid))
+		for i, row := range result.Grid {
+			rows[i] = row[0].Emoji + row[1].Emoji + row[2].Emoji
+		}
+		detail = fmt.Sprintf("%s, %d lines", strings.Join(rows, " / "), session.Lines)
+	} else {
+		detail = result.Reel1.Emoji + " " + result.Reel2.Emoji + " " + result.Reel3.Emoji
+	}
+	if result.Progressive > 0 {
+		detail += fmt.Sprintf(", progressive jackpot %d", result.Progressive)
+	}
+	return detail
+}
+
 func spinSlots(bet int) SlotsResult {
 	rand.Seed(time.Now().UnixNano())
 
@@ -280,9 +420,17 @@ func spinSlots(bet int) SlotsResult {
 		Reel2: r2,
 		Reel3: r3,
 	}
+	evaluateSlotsLine(&result, bet)
+	return result
+}
+
+// evaluateSlotsLine fills the win of the three reels of a result
+func evaluateSlotsLine(result *SlotsResult, bet int) {
+	r1, r2, r3 := result.Reel1, result.Reel2, result.Reel3
 
 	if r1.Name == r2.Name && r2.Name == r3.Name {
 		result.IsJackpot = true
+		result.HitProgressive = r1.Name == progressiveSymbol
 		result.Multiplier = float64(r1.Value)
 		result.WinAmount = int(float64(bet) * result.Multiplier)
 	} else if r1.Name == r2.Name || r2.Name == r3.Name || r1.Name == r3.Name {
@@ -301,8 +449,6 @@ func spinSlots(bet int) SlotsResult {
 			result.WinAmount = bet
 		}
 	}
-
-	return result
 }
 
 func getWeightedSymbol() SlotSymbol {
@@ -324,7 +470,7 @@ func getWeightedSymbol() SlotSymbol {
 	return slotSymbols[0]
 }
 
-func createResultEmbed(username string, bet int, result SlotsResult) *discordgo.MessageEmbed {
+func createResultEmbed(loc i18n.Locale, username string, bet int, result SlotsResult) *discordgo.MessageEmbed {
 	slotsDisplay := fmt.Sprintf("# %s | %s | %s", result.Reel1.Emoji, result.Reel2.Emoji, result.Reel3.Emoji)
 
 	var color int
@@ -332,37 +478,42 @@ func createResultEmbed(username string, bet int, result SlotsResult) *discordgo.
 
 	if result.IsJackpot {
 		color = utils.ColorGold
-		title = "🎰💰 JACKPOT! 💰🎰"
-		description = fmt.Sprintf("**%s** hit the JACKPOT!\n\n%s\n\n"+
+		title = loc.T("🎰💰 JACKPOT! 💰🎰")
+		description = loc.T("**%s** hit the JACKPOT!\n\n%s\n\n"+
 			"**Bet:** %d %s\n"+
 			"**Multiplier:** %.1fx\n"+
 			"**Won:** %d %s 🎉",
-			username, slotsDisplay, bet, config.Bot.CurrencySymbol,
-			result.Multiplier, result.WinAmount, config.Bot.CurrencySymbol)
+			username, slotsDisplay, bet, config.Bot().CurrencySymbol,
+			result.Multiplier, result.WinAmount, config.Bot().CurrencySymbol)
 	} else if result.IsTwoMatch {
 		color = utils.ColorGreen
-		title = "🎉 WINNER!"
-		description = fmt.Sprintf("**%s** got a match!\n\n%s\n\n"+
+		title = loc.T("🎉 WINNER!")
+		description = loc.T("**%s** got a match!\n\n%s\n\n"+
 			"**Bet:** %d %s\n"+
 			"**Multiplier:** %.1fx\n"+
 			"**Won:** %d %s",
-			username, slotsDisplay, bet, config.Bot.CurrencySymbol,
-			result.Multiplier, result.WinAmount, config.Bot.CurrencySymbol)
+			username, slotsDisplay, bet, config.Bot().CurrencySymbol,
+			result.Multiplier, result.WinAmount, config.Bot().CurrencySymbol)
 	} else {
 		color = utils.ColorRed
-		title = "😢 No Luck!"
-		description = fmt.Sprintf("**%s** spun the reels...\n\n%s\n\n"+
+		title = loc.T("😢 No Luck!")
+		description = loc.T("**%s** spun the reels...\n\n%s\n\n"+
 			"**Bet:** %d %s\n"+
 			"💔 No match this time!",
-			username, slotsDisplay, bet, config.Bot.CurrencySymbol)
+			username, slotsDisplay, bet, config.Bot().CurrencySymbol)
+	}
+
+	if result.Progressive > 0 {
+		description += "\n\n" + loc.T("💰 **PROGRESSIVE JACKPOT!** +%d %s", result.Progressive, config.Bot().CurrencySymbol)
 	}
 
 	return &discordgo.MessageEmbed{
 		Title:       title,
 		Description: description,
 		Color:       color,
+		Fields:      []*discordgo.MessageEmbedField{slotsJackpotField(loc)},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: "🍒🍋🍊 = Small | 🔔 = Medium | 💎 = High | 7️⃣ = JACKPOT!",
+			Text: loc.T("🍒🍋🍊 = Small | 🔔 = Medium | 💎 = High | 7️⃣ = JACKPOT!"),
 		},
 	}
 }
@@ -0,0 +1,95 @@
+package games
+
+import (
+	"estudocoin/internal/database"
+	"estudocoin/internal/i18n"
+	"estudocoin/pkg/config"
+	"estudocoin/pkg/utils"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Progressive slots jackpot: a share of every spin feeds a persisted pool
+// that three sevens on a payline win whole.
+
+// slotsJackpotHistory is how many past wins the jackpot embed lists
+const slotsJackpotHistory = 10
+
+// feedSlotsJackpot moves the configured share of a stake into the pool
+func feedSlotsJackpot(stake int) {
+	amount := int(float64(stake) * config.Economy().Slots.JackpotPercent / 100)
+	if err := database.FeedSlotsJackpot(amount); err != nil {
+		log.Printf("Error feeding slots jackpot: %v", err)
+	}
+}
+
+// winSlotsJackpot pays the whole pool to the player, announces it and
+// returns the amount won
+func winSlotsJackpot(s *discordgo.Session, session *SlotsSession) int {
+	amount, err := database.WinSlotsJackpot(session.UserID, config.Economy().Slots.JackpotSeed)
+	if err != nil {
+		log.Printf("Error paying slots jackpot to %s: %v", session.UserID, err)
+		return 0
+	}
+	if amount > 0 {
+		announceSlotsJackpot(s, session.UserID, amount)
+	}
+	return amount
+}
+
+// slotsJackpotChannel is where jackpot wins are announced
+func slotsJackpotChannel() string {
+	if config.Bot().SlotsJackpotChannelID != "" {
+		return config.Bot().SlotsJackpotChannelID
+	}
+	return config.Bot().RouletteChannelID
+}
+
+func announceSlotsJackpot(s *discordgo.Session, userID string, amount int) {
+	channelID := slotsJackpotChannel()
+	if channelID == "" {
+		return
+	}
+	loc := i18n.ForChannel(s, channelID)
+	s.ChannelMessageSendEmbed(channelID, &discordgo.MessageEmbed{
+		Title:       loc.T("🎰💰 PROGRESSIVE JACKPOT! 💰🎰"),
+		Description: loc.T("<@%s> hit 7️⃣ 7️⃣ 7️⃣ and won the progressive jackpot of **%d %s**!", userID, amount, config.Bot().CurrencySymbol),
+		Color:       utils.ColorGold,
+	})
+}
+
+// slotsJackpotField shows the current pool on the slots embeds
+func slotsJackpotField(loc i18n.Locale) *discordgo.MessageEmbedField {
+	return &discordgo.MessageEmbedField{
+		Name:  loc.T("💰 Progressive Jackpot"),
+		Value: fmt.Sprintf("%d %s", database.GetSlotsJackpot(), config.Bot().CurrencySymbol),
+	}
+}
+
+// SlotsJackpotEmbed shows the pool and its latest winners
+func SlotsJackpotEmbed(loc i18n.Locale) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Title: loc.T("🎰 Progressive Jackpot"),
+		Description: loc.T("Current pool: **%d %s**\n\n*%.0f%% of every slots bet feeds the pool. Hit 7️⃣ 7️⃣ 7️⃣ on a payline to win it all!*",
+			database.GetSlotsJackpot(), config.Bot().CurrencySymbol, config.Economy().Slots.JackpotPercent),
+		Color: utils.ColorGold,
+	}
+
+	wins, err := database.GetSlotsJackpotWins(slotsJackpotHistory)
+	if err != nil {
+		log.Printf("Error loading slots jackpot history: %v", err)
+	}
+	value := loc.T("Nobody has hit the jackpot yet.")
+	if len(wins) > 0 {
+		var lines []string
+		for _, w := range wins {
+			lines = append(lines, fmt.Sprintf("<@%s> - **%d %s** - <t:%d:R>", w.UserID, w.Amount, config.Bot().CurrencySymbol, w.WonAt.Unix()))
+		}
+		value = strings.Join(lines, "\n")
+	}
+	embed.Fields = []*discordgo.MessageEmbedField{{Name: loc.T("🏆 Recent Winners"), Value: value}}
+	return embed
+}
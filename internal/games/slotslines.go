@@ -0,0 +1,117 @@
+package games
+
+import (
+	"estudocoin/internal/i18n"
+	"estudocoin/pkg/config"
+	"estudocoin/pkg/utils"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Multi-line slots: a 3x3 grid where the player picks how many paylines to
+// play. Each line costs the bet and pays like the classic reel.
+
+// slotPaylines are the [row, column] cells of each payline, in the order
+// they are enabled: middle row, top, bottom, then the two diagonals
+var slotPaylines = [][3][2]int{
+	{{1, 0}, {1, 1}, {1, 2}},
+	{{0, 0}, {0, 1}, {0, 2}},
+	{{2, 0}, {2, 1}, {2, 2}},
+	{{0, 0}, {1, 1}, {2, 2}},
+	{{2, 0}, {1, 1}, {0, 2}},
+}
+
+// slotLineWin is a payline that paid
+type slotLineWin struct {
+	Line   int // 1-based
+	Result SlotsResult
+}
+
+// spinSlotsGrid spins the grid and pays each of the first lines paylines
+func spinSlotsGrid(bet, lines int) SlotsResult {
+	var result SlotsResult
+	for row := range result.Grid {
+		for col := range result.Grid[row] {
+			result.Grid[row][col] = getWeightedSymbol()
+		}
+	}
+
+	for n, payline := range slotPaylines[:lines] {
+		line := SlotsResult{
+			Reel1: result.Grid[payline[0][0]][payline[0][1]],
+			Reel2: result.Grid[payline[1][0]][payline[1][1]],
+			Reel3: result.Grid[payline[2][0]][payline[2][1]],
+		}
+		evaluateSlotsLine(&line, bet)
+		if line.WinAmount == 0 {
+			continue
+		}
+		result.WinAmount += line.WinAmount
+		result.IsJackpot = result.IsJackpot || line.IsJackpot
+		result.HitProgressive = result.HitProgressive || line.HitProgressive
+		result.LineWins = append(result.LineWins, slotLineWin{Line: n + 1, Result: line})
+	}
+	return result
+}
+
+// unknownGrid is the grid shown before the first spin
+func unknownGrid() [3][3]SlotSymbol {
+	var grid [3][3]SlotSymbol
+	for row := range grid {
+		for col := range grid[row] {
+			grid[row][col] = SlotSymbol{Emoji: "❓"}
+		}
+	}
+	return grid
+}
+
+func gridDisplay(grid [3][3]SlotSymbol) string {
+	rows := make([]string, len(grid))
+	for i, row := range grid {
+		rows[i] = fmt.Sprintf("# %s | %s | %s", row[0].Emoji, row[1].Emoji, row[2].Emoji)
+	}
+	return strings.Join(rows, "\n")
+}
+
+func createGridResultEmbed(loc i18n.Locale, session *SlotsSession, result SlotsResult) *discordgo.MessageEmbed {
+	color := utils.ColorRed
+	title := loc.T("😢 No Luck!")
+	if result.IsJackpot {
+		color = utils.ColorGold
+		title = loc.T("🎰💰 JACKPOT! 💰🎰")
+	} else if result.WinAmount > 0 {
+		color = utils.ColorGreen
+		title = loc.T("🎉 WINNER!")
+	}
+
+	description := loc.T("**%s** spun %d lines...\n\n%s\n\n**Bet:** %d %s x %d lines = %d %s\n**Won:** %d %s",
+		session.Username, session.Lines, gridDisplay(result.Grid),
+		session.Bet, config.Bot().CurrencySymbol, session.Lines, session.stake(), config.Bot().CurrencySymbol,
+		result.WinAmount, config.Bot().CurrencySymbol)
+	if result.Progressive > 0 {
+		description += "\n\n" + loc.T("💰 **PROGRESSIVE JACKPOT!** +%d %s", result.Progressive, config.Bot().CurrencySymbol)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       title,
+		Description: description,
+		Color:       color,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: loc.T("🍒🍋🍊 = Small | 🔔 = Medium | 💎 = High | 7️⃣ = JACKPOT!"),
+		},
+	}
+
+	if len(result.LineWins) > 0 {
+		var lines []string
+		for _, win := range result.LineWins {
+			lines = append(lines, loc.T("Line %d: %s %s %s (%.1fx) +%d %s", win.Line,
+				win.Result.Reel1.Emoji, win.Result.Reel2.Emoji, win.Result.Reel3.Emoji,
+				win.Result.Multiplier, win.Result.WinAmount, config.Bot().CurrencySymbol))
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: loc.T("📈 Winning Lines"), Value: strings.Join(lines, "\n")})
+	}
+	embed.Fields = append(embed.Fields, slotsJackpotField(loc))
+	return embed
+}
@@ -0,0 +1,140 @@
+package games
+
+import (
+	"estudocoin/internal/database"
+	"estudocoin/internal/i18n"
+	"estudocoin/pkg/config"
+	"estudocoin/pkg/utils"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Per-user gambling statistics, built from the results every game reports
+// through reportResult.
+
+// StatsGames are the game names recorded in the history, in display order
+var StatsGames = []string{
+	"blackjack", "slots", "aviator", "cups", "wheel", "poker", DuelCoinflip, DuelDice,
+	"russianroulette", "russianroulette_sidebet", "lottery", "event",
+}
+
+var statsGameLabels = map[string]string{
+	"blackjack":               "🃏 Blackjack",
+	"slots":                   "🎰 Slots",
+	"aviator":                 "✈️ Aviator",
+	"cups":                    "🥤 Cups",
+	"wheel":                   "🎡 Wheel",
+	"poker":                   "♠️ Poker",
+	DuelCoinflip:              "🪙 Coinflip",
+	DuelDice:                  "🎲 Dice",
+	"russianroulette":         "🔫 Russian Roulette",
+	"russianroulette_sidebet": "🔫 Roulette Side Bets",
+	"lottery":                 "🎟️ Lottery",
+	"event":                   "📊 Events",
+}
+
+// statsRecentGames is how many past games the stats embed lists
+const statsRecentGames = 5
+
+// statsDetailLength caps the outcome shown for each recent game
+const statsDetailLength = 80
+
+// IsStatsGame reports whether game is a known game name
+func IsStatsGame(game string) bool {
+	_, ok := statsGameLabels[game]
+	return ok
+}
+
+func gameLabel(loc i18n.Locale, game string) string {
+	if label, ok := statsGameLabels[game]; ok {
+		return loc.T(label)
+	}
+	return game
+}
+
+func winRate(wins, played int) float64 {
+	if played == 0 {
+		return 0
+	}
+	return float64(wins) * 100 / float64(played)
+}
+
+// StatsEmbed shows a user's totals, a breakdown per game and their latest
+// games. game narrows everything to a single game ("" for all).
+func StatsEmbed(loc i18n.Locale, user *discordgo.User, game string) *discordgo.MessageEmbed {
+	stats, err := database.GetGameStats(user.ID, game)
+	if err != nil {
+		log.Printf("[STATS] Error loading stats of %s: %v", user.ID, err)
+		return loc.ErrorEmbed("Could not load the statistics. Try again later.")
+	}
+
+	title := loc.T("📊 Gambling Stats: %s", user.Username)
+	if game != "" {
+		title = loc.T("📊 %s Stats: %s", gameLabel(loc, game), user.Username)
+	}
+	if len(stats) == 0 {
+		return utils.InfoEmbed(title, loc.T("No games played yet."))
+	}
+
+	var total database.GameStats
+	for _, st := range stats {
+		total.Played += st.Played
+		total.Wagered += st.Wagered
+		total.Net += st.Net
+		total.Wins += st.Wins
+		total.BiggestWin = max(total.BiggestWin, st.BiggestWin)
+	}
+
+	color := utils.ColorGreen
+	if total.Net < 0 {
+		color = utils.ColorRed
+	}
+	embed := &discordgo.MessageEmbed{
+		Title: title,
+		Description: loc.T("**Games played:** %d\n**Total wagered:** %d %s\n**Net profit:** %+d %s\n**Biggest win:** %d %s\n**Win rate:** %.1f%%",
+			total.Played, total.Wagered, config.Bot().CurrencySymbol, total.Net, config.Bot().CurrencySymbol,
+			total.BiggestWin, config.Bot().CurrencySymbol, winRate(total.Wins, total.Played)),
+		Color: color,
+	}
+
+	// A single game is already summed up in the description
+	if game == "" {
+		for _, st := range stats {
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+				Name: gameLabel(loc, st.Game),
+				Value: loc.T("Played: %d\nWagered: %d\nNet: %+d\nBiggest win: %d\nWin rate: %.0f%%",
+					st.Played, st.Wagered, st.Net, st.BiggestWin, winRate(st.Wins, st.Played)),
+				Inline: true,
+			})
+		}
+	}
+
+	recent, err := database.GetGameHistory(user.ID, game, statsRecentGames, 0)
+	if err != nil {
+		log.Printf("[STATS] Error loading history of %s: %v", user.ID, err)
+	}
+	if len(recent) > 0 {
+		lines := make([]string, len(recent))
+		for n, r := range recent {
+			line := fmt.Sprintf("<t:%d:R> %s **%+d %s**", r.PlayedAt.Unix(), gameLabel(loc, r.Game), r.Payout-r.Stake, config.Bot().CurrencySymbol)
+			if r.Detail != "" {
+				line += " - " + truncateDetail(r.Detail)
+			}
+			lines[n] = line
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: loc.T("🕒 Recent Games"), Value: strings.Join(lines, "\n")})
+	}
+	return embed
+}
+
+// truncateDetail shortens a game outcome for the embed
+func truncateDetail(detail string) string {
+	runes := []rune(detail)
+	if len(runes) <= statsDetailLength {
+		return detail
+	}
+	return string(runes[:statsDetailLength-1]) + "…"
+}
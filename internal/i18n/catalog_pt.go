@@ -0,0 +1,835 @@
+package i18n
+
+// portuguese traduz as mensagens para pt-BR. A chave é o texto em inglês
+// exatamente como aparece no código (incluindo os verbos de formatação,
+// que devem aparecer na mesma ordem na tradução).
+var portuguese = map[string]string{
+	// Geral
+	"Error":     "Erro",
+	"Admin":     "Admin",
+	"Moderator": "Moderador",
+	"Everyone":  "Todos",
+
+	// Comandos (bot/registry.go)
+	"%s\nUsage: `%s`": "%s\nUso: `%s`",
+	"Invalid `%s`.":   "`%s` inválido.",
+	"Missing `%s`.":   "Falta `%s`.",
+	"This command requires the **%s** level.": "Este comando exige o nível **%s**.",
+	"Usage: `%s`": "Uso: `%s`",
+	"You don't have permission to use this command.":      "Você não tem permissão para usar este comando.",
+	"`%s` must be a number.":                              "`%s` deve ser um número.",
+	"`%s` must be a whole number.":                        "`%s` deve ser um número inteiro.",
+	"`%s` must be at least %v.":                           "`%s` deve ser no mínimo %v.",
+	"`%s` must be at most %v.":                            "`%s` deve ser no máximo %v.",
+	"`%s` must be one of: %s.":                            "`%s` deve ser um de: %s.",
+	"`%s` must be true or false.":                         "`%s` deve ser true ou false.",
+	"❌ This bot can only be used in designated channels.": "❌ Este bot só pode ser usado nos canais designados.",
+	"Slow down! You can use `%s` again in %s.":            "Calma! Você pode usar `%s` de novo em %s.",
+	"You're sending commands too fast. Try again in %s.":  "Você está mandando comandos rápido demais. Tente de novo em %s.",
+
+	// Serviços (service)
+	"Your account is frozen. Contact a moderator.":               "Sua conta está congelada. Fale com um moderador.",
+	"The other user's account is frozen.":                        "A conta do outro usuário está congelada.",
+	"Daily reward already claimed":                               "Recompensa diária já coletada",
+	"Loan not found or already paid!":                            "Empréstimo não encontrado ou já pago!",
+	"This loan offer has expired or is invalid!":                 "Esta oferta de empréstimo expirou ou é inválida!",
+	"You don't have any active loans to pay!":                    "Você não tem empréstimos ativos para pagar!",
+	"<@%s> is already frozen.":                                   "<@%s> já está congelado.",
+	"<@%s> is not frozen.":                                       "<@%s> não está congelado.",
+	"<@%s> only has %d. Use set to change the balance directly.": "<@%s> só tem %d. Use set para alterar o saldo diretamente.",
+	"A reason is required.":                                      "É preciso informar um motivo.",
+	"Balance cannot be negative.":                                "O saldo não pode ser negativo.",
+	"Could not load the audit log.":                              "Não foi possível carregar o log de auditoria.",
+	"Error resetting account.":                                   "Erro ao resetar a conta.",
+	"Error updating account.":                                    "Erro ao atualizar a conta.",
+	"Error updating balance.":                                    "Erro ao atualizar o saldo.",
+	"Could not fetch crypto price. Try again later.":             "Não foi possível buscar o preço da cripto. Tente novamente mais tarde.",
+	"Could not fetch crypto prices. Try again later.":            "Não foi possível buscar os preços das criptos. Tente novamente mais tarde.",
+	"Could not fetch current prices.":                            "Não foi possível buscar os preços atuais.",
+	"Invalid amount.":                                            "Valor inválido.",
+	"Invalid cryptocurrency symbol.":                             "Símbolo de criptomoeda inválido.",
+	"You don't own any %s.":                                      "Você não possui nenhum %s.",
+	"You only own %s %s.":                                        "Você só possui %s %s.",
+	"Amount must be positive":                                    "O valor deve ser positivo",
+	"Cannot transfer to yourself":                                "Você não pode transferir para si mesmo",
+	"Could not retrieve leaderboard.":                            "Não foi possível carregar o ranking.",
+	"Error adding coins.":                                        "Erro ao adicionar moedas.",
+	"Error claiming daily reward.":                               "Erro ao coletar a recompensa diária.",
+	"Insufficient funds or transaction failed":                   "Saldo insuficiente ou falha na transação",
+	"<@%s> already has a pending loan request!":                  "<@%s> já tem um pedido de empréstimo pendente!",
+	"<@%s> no longer has sufficient balance!":                    "<@%s> não tem mais saldo suficiente!",
+	"Error processing loan transaction!":                         "Erro ao processar a transação do empréstimo!",
+	"Error processing payment. You need %d %s.":                  "Erro ao processar o pagamento. Você precisa de %d %s.",
+	"Error saving loan to database!":                             "Erro ao salvar o empréstimo no banco de dados!",
+	"Insufficient balance! You have %d %s":                       "Saldo insuficiente! Você tem %d %s",
+	"Insufficient balance! You need %d %s but have %d %s.":       "Saldo insuficiente! Você precisa de %d %s, mas tem %d %s.",
+	"Invalid amount. Must be a positive number.":                 "Valor inválido. Deve ser um número positivo.",
+	"Invalid interest rate. Must be between 0 and 100.":          "Taxa de juros inválida. Deve estar entre 0 e 100.",
+	"Invalid number of days. Must be between 1 and 365.":         "Número de dias inválido. Deve estar entre 1 e 365.",
+	"This offer was cancelled by an admin.":                      "Esta oferta foi cancelada por um admin.",
+	"You cannot lend money to bots!":                             "Você não pode emprestar dinheiro para bots!",
+	"You cannot lend money to yourself!":                         "Você não pode emprestar dinheiro para si mesmo!",
+	"Amount must be positive.":                                   "O valor deve ser positivo.",
+	"Could not fetch stock price. Try again later.":              "Não foi possível buscar o preço da ação. Tente novamente mais tarde.",
+	"Database error.":                                            "Erro no banco de dados.",
+	"Insufficient funds.":                                        "Saldo insuficiente.",
+	"Invalid number of shares.":                                  "Número de ações inválido.",
+	"Invalid ticker.":                                            "Ticker inválido.",
+	"Transaction failed.":                                        "A transação falhou.",
+	"You don't have that many to sell.":                          "Você não tem tudo isso para vender.",
+	"You don't own any shares of this company.":                  "Você não possui ações desta empresa.",
+	"You only own %.4f shares.":                                  "Você só possui %.4f ações.",
+
+	// Administração
+	"<t:%d:d> **%s** by <@%s>":   "<t:%d:d> **%s** por <@%s>",
+	"Audit Log":                  "Log de Auditoria",
+	"Audit Log: %s":              "Log de Auditoria: %s",
+	"Configuration Reloaded":     "Configuração Recarregada",
+	"No admin actions on <@%s>.": "Nenhuma ação de admin em <@%s>.",
+	"Reload failed, keeping the current configuration:\n%s":                                            "Falha ao recarregar, mantendo a configuração atual:\n%s",
+	"config.json and economy.json were reloaded.\nDatabase and API port changes still need a restart.": "config.json e economy.json foram recarregados.\nMudanças no banco de dados e na porta da API ainda exigem reiniciar.",
+	"🧊 **Account frozen**": "🧊 **Conta congelada**",
+	"Coins Given":          "Moedas Dadas",
+	"Coins Taken":          "Moedas Retiradas",
+	"Balance Set":          "Saldo Definido",
+	"Account Frozen":       "Conta Congelada",
+	"Account Unfrozen":     "Conta Descongelada",
+	"Account Reset":        "Conta Resetada",
+
+	// Chaves de API
+	"**%s**: `%s` (Created: %s)\n":                       "**%s**: `%s` (Criada em: %s)\n",
+	"Check your DM!":                                     "Confira sua DM!",
+	"Error creating API key.":                            "Erro ao criar a chave de API.",
+	"Error deleting key.":                                "Erro ao apagar a chave.",
+	"Error listing keys.":                                "Erro ao listar as chaves.",
+	"Failed to send DM.":                                 "Falha ao enviar a DM.",
+	"I cannot DM you. Please open your DMs.":             "Não consigo te mandar DM. Abra suas DMs.",
+	"I sent your API Key securely.":                      "Enviei sua chave de API com segurança.",
+	"If a key matched that prefix, it has been revoked.": "Se alguma chave tinha esse prefixo, ela foi revogada.",
+	"Key Deleted":                                        "Chave Apagada",
+	"My Key":                                             "Minha Chave",
+	"No Keys":                                            "Nenhuma Chave",
+	"Provide at least the first 5 characters of the key.": "Informe pelo menos os 5 primeiros caracteres da chave.",
+	"You don't have any API keys.":                        "Você não tem nenhuma chave de API.",
+	"Your API Keys":                                       "Suas Chaves de API",
+	"🔑 **Your API Key** (%s)\n\n`%s`\n\n⚠️ This message will be deleted in 60 seconds.": "🔑 **Sua Chave de API** (%s)\n\n`%s`\n\n⚠️ Esta mensagem será apagada em 60 segundos.",
+
+	// Criptomoedas
+	"%s **%s** (%s): %s coins (~%d %s @ $%s)\n":              "%s **%s** (%s): %s moedas (~%d %s @ $%s)\n",
+	"%s You bought **%s %s** for **%d %s** (at $%s/coin).%s": "%s Você comprou **%s %s** por **%d %s** (a $%s/moeda).%s",
+	"%s You sold **%s %s** for **%d %s** (at $%s/coin).":     "%s Você vendeu **%s %s** por **%d %s** (a $%s/moeda).",
+	"**Major Cryptocurrencies:**":                            "**Principais Criptomoedas:**",
+	"**Meme Coins (High Volatility!):**":                     "**Meme Coins (Alta Volatilidade!):**",
+	"Crypto Market":                                          "Mercado de Cripto",
+	"Crypto Portfolio":                                       "Carteira de Cripto",
+	"Crypto Purchase Successful!":                            "Compra de Cripto Realizada!",
+	"Crypto Sale Successful!":                                "Venda de Cripto Realizada!",
+	"Error fetching crypto prices. Try again later.":         "Erro ao buscar os preços das criptos. Tente novamente mais tarde.",
+	"Invalid cryptocurrency symbol. Use `!crypto market` to see available options.": "Símbolo de criptomoeda inválido. Use `!crypto market` para ver as opções.",
+	"Usage: `!crypto <market|buy|sell|portfolio>`":                                  "Uso: `!crypto <market|buy|sell|portfolio>`",
+	"You have no cryptocurrency investments.":                                       "Você não tem investimentos em criptomoedas.",
+	"Your Crypto Portfolio":                                                         "Sua Carteira de Cripto",
+
+	// Economia
+	"\n\n🔥 **Streak: %d days**":                "\n\n🔥 **Sequência: %d dias**",
+	"\n🏆 Max Streak: %d":                       "\n🏆 Maior Sequência: %d",
+	"\n💰 = Total | 🪙 = Wallet | 📈 = Stocks":    "\n💰 = Total | 🪙 = Carteira | 📈 = Ações",
+	"**%s** has **%d %s**.":                    "**%s** tem **%d %s**.",
+	"Balance":                                  "Saldo",
+	"Daily Collected!":                         "Diária Coletada!",
+	"Insufficient funds or transaction error.": "Saldo insuficiente ou erro na transação.",
+	"Leaderboard":                              "Ranking",
+	"No users found.":                          "Nenhum usuário encontrado.",
+	"Transfer Successful":                      "Transferência Realizada",
+	"You already collected your daily reward! Come back %s.": "Você já coletou sua recompensa diária! Volte %s.",
+	"You cannot pay yourself.":                               "Você não pode pagar a si mesmo.",
+	"You received **%d %s**!%s":                              "Você recebeu **%d %s**!%s",
+	"You sent **%d %s** to **%s**.":                          "Você enviou **%d %s** para **%s**.",
+	"🏆 Richest Users (Net Worth)":                            "🏆 Usuários Mais Ricos (Patrimônio)",
+
+	// Ajuda
+	"%s %s - Page %d/%d": "%s %s - Página %d/%d",
+	"Administration":     "Administração",
+	"Casino Roulette":    "Roleta do Cassino",
+	"Cryptocurrency":     "Criptomoedas",
+	"Developer & API":    "Desenvolvedor & API",
+	"Economy":            "Economia",
+	"Event Betting":      "Apostas em Eventos",
+	"Gambling":           "Jogos",
+	"Voice Rewards":      "Recompensas de Voz",
+	"Loans":              "Empréstimos",
+	"Shop":               "Loja",
+	"Stock Market":       "Mercado de Ações",
+	"Language":           "Idioma",
+	"➡️ Next":            "➡️ Próxima",
+	"⬅️ Previous":        "⬅️ Anterior",
+	"Earn **%d %s/min** in voice channels.\n*Need 2+ people, not muted/deafened.*":                                                                        "Ganhe **%d %s/min** nos canais de voz.\n*Precisa de 2+ pessoas, sem mute/ensurdecido.*",
+	"Use !help <section> to jump | Sections: economy, shop, gambling, casino, lottery, duels, events, stocks, crypto, voice, loans, api, language, admin": "Use !help <seção> para pular | Seções: economy, shop, gambling, casino, lottery, duels, events, stocks, crypto, voice, loans, api, language, admin",
+	"`!admin reload` / `/admin reload`\nReload config.json and economy.json without restarting.\n\n`/admin coins give|take|set @user <amount> <reason>`\nFix a balance. Every action is audited.\n\n`/admin freeze|unfreeze @user [reason]`\nBlock or allow transfers, games, trading and API use.\n\n`/admin reset @user [reason]` - Wipe balance, holdings and loans\n`/admin audit @user` - Recent admin actions\n`/admin limits @user` - Gambling limits (read-only)\n\n*Admins are the roles/users in `permissions` of config.json, plus members with Manage Server.*":                                                                                                                                                                                                                                                                                                                                                                                                                                                                                               "`!admin reload` / `/admin reload`\nRecarrega config.json e economy.json sem reiniciar.\n\n`/admin coins give|take|set @usuário <valor> <motivo>`\nCorrige um saldo. Toda ação é auditada.\n\n`/admin freeze|unfreeze @usuário [motivo]`\nBloqueia ou libera transferências, jogos, negociações e uso da API.\n\n`/admin reset @usuário [motivo]` - Zera saldo, investimentos e empréstimos\n`/admin audit @usuário` - Ações de admin recentes\n`/admin limits @usuário` - Limites de jogo (só leitura)\n\n*Admins são os cargos/usuários em `permissions` do config.json, mais os membros com Gerenciar Servidor.*",
+	"`!bet aviator <amount>` / `/bet aviator`\nPlay the Aviator crash game.\n*Watch out for turbulence!*\n\n`!bet crash <amount> [auto]` / `/bet crash`\nJoin the channel's shared Aviator flight.\n*Everyone crashes together. Set `auto` to cash out at a target!*\n\n`!bet cups <amount>` / `/bet cups`\nFind the hidden coin under 6 cups.\n*Win 5x, then 10x, 20x, 40x... or Cash Out!*\n\n`!bet blackjack <amount>` / `/blackjack`\nClassic Blackjack vs dealer.\n*Hit, Stand, Double, Insurance.*\n\n`!bet table <amount>` / `/bet table`\nBlackjack table for up to 5 players.\n*Split, Double, Surrender. One shoe per channel.*\n\n`!slots <amount> [lines]` / `/slots`\nSlot machine, or a 3x3 grid with up to 5 lines.\n*7️⃣7️⃣7️⃣ wins the `!jackpot` pool!*\n\n`!poker join <buy-in>` / `/poker join`\nTexas Hold'em against other players.\n*Leave with `!poker leave` to cash out.*\n\n`!roulette @user <amount>` / `!roulette lobby <amount>`\nRussian Roulette PvP, up to 6 players.\n*Survivor takes all! Spectators: `!roulette bet @user <amount>`*": "`!bet aviator <valor>` / `/bet aviator`\nJogue o Aviator.\n*Cuidado com a turbulência!*\n\n`!bet crash <valor> [auto]` / `/bet crash`\nEmbarque no voo compartilhado do Aviator no canal.\n*Todos caem juntos. Use `auto` para sacar num alvo!*\n\n`!bet cups <valor>` / `/bet cups`\nAche a moeda escondida em um dos 6 copos.\n*Ganhe 5x, depois 10x, 20x, 40x... ou Saque!*\n\n`!bet blackjack <valor>` / `/blackjack`\nBlackjack clássico contra o dealer.\n*Pedir, Parar, Dobrar, Seguro.*\n\n`!bet table <valor>` / `/bet table`\nMesa de blackjack para até 5 jogadores.\n*Dividir, Dobrar, Desistir. Um sapato por canal.*\n\n`!slots <valor> [linhas]` / `/slots`\nCaça-níquel, ou grade 3x3 com até 5 linhas.\n*7️⃣7️⃣7️⃣ leva o pote do `!jackpot`!*\n\n`!poker join <entrada>` / `/poker join`\nTexas Hold'em contra outros jogadores.\n*Saia com `!poker leave` para sacar suas fichas.*\n\n`!roulette @usuário <valor>` / `!roulette lobby <valor>`\nRoleta Russa PvP, até 6 jogadores.\n*O sobrevivente leva tudo! Espectadores: `!roulette bet @usuário <valor>`*",
+	"`!createevent <q> | <opt1> | <opt2> | <min>` / `/event create`\n*Moderators only.* Create betting event.\n\n`!betevent <id> <opt_num> <amount>`\nPlace bet on event, or click an option on the event message.\n\n`!events` / `/event list` - List active events\n`!event <id>` / `/event view` - View event details\n`!closeevent <id>` / `/event close` - Close early\n`!result <id> <opt>` / `/event result` - Set winner (creator or admin)\n\n*Dynamic odds: less popular = higher payout!*":                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                     "`!createevent <pergunta> | <opç1> | <opç2> | <min>` / `/event create`\n*Só moderadores.* Cria um evento de apostas.\n\n`!betevent <id> <núm_opç> <valor>`\nAposta em um evento, ou clique em uma opção na mensagem do evento.\n\n`!events` / `/event list` - Lista os eventos ativos\n`!event <id>` / `/event view` - Detalhes do evento\n`!closeevent <id>` / `/event close` - Encerra antes\n`!result <id> <opç>` / `/event result` - Define o vencedor (criador ou admin)\n\n*Odds dinâmicas: menos popular = prêmio maior!*",
+	"`!crypto market` / `/crypto market`\nView crypto prices.\n\n`!crypto buy <SYMBOL> <amount>` / `/crypto buy`\nBuy crypto (BTC, ETH, etc) after confirming the quote.\n\n`!crypto sell <SYMBOL> <amount|all>` / `/crypto sell`\nSell crypto.\n\n`!crypto portfolio` / `/crypto portfolio`\nView crypto holdings (private with `/`).\n\n⚠️ Meme coins are highly volatile!":                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                             "`!crypto market` / `/crypto market`\nVeja os preços das criptos.\n\n`!crypto buy <SÍMBOLO> <valor>` / `/crypto buy`\nCompre cripto (BTC, ETH, etc) depois de confirmar a cotação.\n\n`!crypto sell <SÍMBOLO> <quantidade|all>` / `/crypto sell`\nVenda cripto.\n\n`!crypto portfolio` / `/crypto portfolio`\nVeja suas criptos (privado com `/`).\n\n⚠️ Meme coins são muito voláteis!",
+	"`!daily` / `/daily`\nCollect your daily reward (**100-5000**).\n🔥 **Streak System:** Day 1 = 100, Day 2 = 200... up to 5000!\n⚠️ Skip a day = streak resets to 100.\n\n`!balance` / `/balance [user]`\nCheck your wallet or someone else's.\n\n`!leaderboard` / `/leaderboard`\nSee the richest users.\n\n`!pay` / `/pay <user> <amount>`\nTransfer coins to another user.\n\n`!settings privacy <hidden|public>` / `/settings privacy`\nHide your balance from others and from the leaderboard.\n\n`!limits` / `/limits`\nSet loss limits, a max bet or a self-exclusion period.\n\n`!stats` / `/stats [user] [game]`\nGames played, profit and win rate per game.":                                                                                                                                                                                                                                                                                                                                                                                                 "`!daily` / `/daily`\nColete sua recompensa diária (**100-5000**).\n🔥 **Sequência:** Dia 1 = 100, Dia 2 = 200... até 5000!\n⚠️ Pulou um dia = a sequência volta para 100.\n\n`!balance` / `/balance [usuário]`\nVeja a sua carteira ou a de outra pessoa.\n\n`!leaderboard` / `/leaderboard`\nVeja os usuários mais ricos.\n\n`!pay` / `/pay <usuário> <valor>`\nTransfira moedas para outro usuário.\n\n`!settings privacy <hidden|public>` / `/settings privacy`\nEsconda seu saldo dos outros e do ranking.\n\n`!limits` / `/limits`\nDefina limites de perda, uma aposta máxima ou um período de autoexclusão.\n\n`!stats` / `/stats [usuário] [jogo]`\nPartidas, lucro e taxa de vitória por jogo.",
+	"`!language` / `/language`\nShow the language the bot uses with you.\n\n`!language set <en|pt-BR|auto>` / `/language set`\nChoose your language. *auto* follows your Discord language.\n\n`!language server <en|pt-BR|auto>` / `/language server`\n*Admins only.* Default language of this server.":                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                   "`!language` / `/language`\nMostra o idioma que o bot usa com você.\n\n`!language set <en|pt-BR|auto>` / `/language set`\nEscolha seu idioma. *auto* segue o idioma do seu Discord.\n\n`!language server <en|pt-BR|auto>` / `/language server`\n*Só admins.* Idioma padrão deste servidor.",
+	"`!loan offer @user <amount> <interest> <days>` / `/loan offer`\nOffer a loan to another user. They have 1 minute to accept.\n\n`!loan pay [loan_id]` / `/loan pay`\nPay an active loan (pays oldest if no ID specified).\n\n`!loan list [@user]` / `/loan list`\nView active loans.\n\n⚠️ **Auto-collection:** If not paid by due date, funds are automatically deducted!":                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                           "`!loan offer @usuário <valor> <juros> <dias>` / `/loan offer`\nOfereça um empréstimo a outro usuário. Ele tem 1 minuto para aceitar.\n\n`!loan pay [id_empréstimo]` / `/loan pay`\nPague um empréstimo ativo (paga o mais antigo se não informar o ID).\n\n`!loan list [@usuário]` / `/loan list`\nVeja os empréstimos ativos.\n\n⚠️ **Cobrança automática:** Se não for pago até o vencimento, o valor é descontado automaticamente!",
+	"`!shop` / `/shop`\nView available items.\n\n`!buy nickname <n>`\nChange your own nickname (**%d %s**).\n\n`!buy rename @user <n>`\nChange someone else's nickname (**%d %s**).\n\n`!buy punishment @user <min>`\nTimeout user (**%d %s/min**) - text & voice.\n*Note: Punishments are accumulative!*\n\n`!buy mute @user <min>`\nMute user in voice (**%d %s/min**) - voice only.\n*User must be in a call!*":                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                        "`!shop` / `/shop`\nVeja os itens disponíveis.\n\n`!buy nickname <n>`\nMude seu próprio apelido (**%d %s**).\n\n`!buy rename @usuário <n>`\nMude o apelido de outra pessoa (**%d %s**).\n\n`!buy punishment @usuário <min>`\nCastigo (**%d %s/min**) - texto e voz.\n*Obs.: os castigos se acumulam!*\n\n`!buy mute @usuário <min>`\nSilencia na voz (**%d %s/min**) - só voz.\n*O usuário precisa estar em call!*",
+	"`!stock market` / `/stock market`\nView stocks and prices.\n\n`!stock buy <ticker> <amount>` / `/stock buy`\nBuy shares (confirm the quoted price first).\n\n`!stock sell <ticker> <shares|all>` / `/stock sell`\nSell shares.\n\n`!stock portfolio` / `/stock portfolio`\nView investments (private with `/`).":                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                     "`!stock market` / `/stock market`\nVeja as ações e os preços.\n\n`!stock buy <ticker> <valor>` / `/stock buy`\nCompre ações (confirme a cotação antes).\n\n`!stock sell <ticker> <ações|all>` / `/stock sell`\nVenda ações.\n\n`!stock portfolio` / `/stock portfolio`\nVeja seus investimentos (privado com `/`).",
+	"`!wheel`\nView roulette options and time until spin.\n\n`!wheel number <0-36> <amount>` - **35:1**\n`!wheel red/black <amount>` - **1:1**\n`!wheel even/odd <amount>` - **1:1**\n`!wheel low/high <amount>` - **1:1**\n`!wheel dozen <1st/2nd/3rd> <amount>` - **2:1**\n\n`/wheel bet` / `/wheel time` - Same bets with slash commands\n\n*Rounds every 10 min. Betting closes on spin!*":                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                            "`!wheel`\nVeja as apostas da roleta e o tempo até o giro.\n\n`!wheel number <0-36> <valor>` - **35:1**\n`!wheel red/black <valor>` - **1:1**\n`!wheel even/odd <valor>` - **1:1**\n`!wheel low/high <valor>` - **1:1**\n`!wheel dozen <1st/2nd/3rd> <valor>` - **2:1**\n\n`/wheel bet` / `/wheel time` - As mesmas apostas com comandos de barra\n\n*Rodadas a cada 10 min. As apostas fecham no giro!*",
+	"`/apikey create` - Generate API key\n`/apikey list` - View keys\n`/webhook set <url>` - Coin notifications\n`/webhook deliveries` - Recent delivery attempts": "`/apikey create` - Gera uma chave de API\n`/apikey list` - Lista as chaves\n`/webhook set <url>` - Notificações de moedas\n`/webhook deliveries` - Entregas recentes",
+
+	// Configurações e privacidade
+	"**%s** keeps their balance private.":                           "**%s** mantém o saldo em segredo.",
+	"%s\n\nUse `/settings privacy` to change it.":                   "%s\n\nUse `/settings privacy` para mudar.",
+	"Could not save your settings.":                                 "Não foi possível salvar suas configurações.",
+	"I couldn't send you a DM. Open your DMs or use `/%s` instead.": "Não consegui te mandar DM. Abra suas DMs ou use `/%s`.",
+	"Privacy": "Privacidade",
+	"🔒 **Hidden**: only you can see your balance and you don't appear in the leaderboard.": "🔒 **Escondido**: só você vê seu saldo e você não aparece no ranking.",
+	"🔓 **Public**: anyone can see your balance and you appear in the leaderboard.":         "🔓 **Público**: qualquer um vê seu saldo e você aparece no ranking.",
+
+	// Idioma
+	"Automatic":                           "Automático",
+	"Could not save the server language.": "Não foi possível salvar o idioma do servidor.",
+	"Could not save your language.":       "Não foi possível salvar seu idioma.",
+	"Current language: **%s**\nYour choice: **%s**\n\nUse `/language set` to change it.": "Idioma atual: **%s**\nSua escolha: **%s**\n\nUse `/language set` para mudar.",
+	"Default server language set to **%s**.":                                             "Idioma padrão do servidor definido para **%s**.",
+	"The bot will now talk to you in **%s**.":                                            "Agora o bot vai falar com você em **%s**.",
+	"This command only works in a server.":                                               "Este comando só funciona em um servidor.",
+	"Unknown language.":                                                                  "Idioma desconhecido.",
+	"the default of the bot":                                                             "o padrão do bot",
+
+	// Empréstimos
+	"%d days overdue":                   "%d dias de atraso",
+	"%s doesn't have any active loans!": "%s não tem empréstimos ativos!",
+	"**%d.** `%s`\nRole: %s | Other: <@%s>\nAmount: %d %s | Total: %d %s\nDue: %s %s\n\n": "**%d.** `%s`\nPapel: %s | Outro: <@%s>\nValor: %d %s | Total: %d %s\nVencimento: %s %s\n\n",
+	"**Active Loans for %s**": "**Empréstimos Ativos de %s**",
+	"**Commands:**\n`!loan offer @user <amount> <interest> <days>` - Offer a loan\n`!loan pay [loan_id]` - Pay a loan\n`!loan list [@user]` - List active loans": "**Comandos:**\n`!loan offer @usuário <valor> <juros> <dias>` - Oferece um empréstimo\n`!loan pay [id_empréstimo]` - Paga um empréstimo\n`!loan list [@usuário]` - Lista os empréstimos ativos",
+	"**LOAN DEFAULTED**\n<@%s> didn't have enough funds!\nCollected: **%d %s** | Remaining debt: **%d %s**\nLoan `%s` marked as paid with negative balance! 💸":   "**EMPRÉSTIMO INADIMPLENTE**\n<@%s> não tinha saldo suficiente!\nCobrado: **%d %s** | Dívida restante: **%d %s**\nEmpréstimo `%s` marcado como pago com saldo negativo! 💸",
+	"1 minute": "1 minuto",
+	"<@%s> paid **%d %s** to <@%s>**!**\nLoan `%s` is now fully repaid! 🎉": "<@%s> pagou **%d %s** para <@%s>**!**\nO empréstimo `%s` foi quitado! 🎉",
+	"<@%s> wants to lend money to <@%s>!":                                  "<@%s> quer emprestar dinheiro para <@%s>!",
+	"Auto Payment Executed":                                                "Pagamento Automático Executado",
+	"Borrower":                                                             "Devedor",
+	"Error creating loan offer.":                                           "Erro ao criar a oferta de empréstimo.",
+	"Lender":                                                               "Credor",
+	"Loan ID: %s":                                                          "ID do empréstimo: %s",
+	"Loan Paid!":                                                           "Empréstimo Pago!",
+	"Loan System":                                                          "Sistema de Empréstimos",
+	"Unknown loan command. Use `!loan offer`, `!loan pay`, or `!loan list`": "Comando de empréstimo desconhecido. Use `!loan offer`, `!loan pay` ou `!loan list`",
+	"You don't have any active loans!":                                      "Você não tem empréstimos ativos!",
+	"⏰ **Loan offer expired!** <@%s> did not respond in time.":              "⏰ **A oferta de empréstimo expirou!** <@%s> não respondeu a tempo.",
+	"⏱️ Time to Accept":                                                     "⏱️ Tempo para Aceitar",
+	"⚠️ Loan Defaulted":                                                     "⚠️ Empréstimo Inadimplente",
+	"✅ **Loan Accepted!**\n<@%s> received **%d %s** from <@%s>.\nTotal to pay: **%d %s** by <t:%d:f>": "✅ **Empréstimo Aceito!**\n<@%s> recebeu **%d %s** de <@%s>.\nTotal a pagar: **%d %s** até <t:%d:f>",
+	"❌ <@%s> declined the loan offer.": "❌ <@%s> recusou a oferta de empréstimo.",
+	"💰 Loan Offer":                     "💰 Oferta de Empréstimo",
+	"💰 Loan auto-collected!\n<@%s> paid **%d %s** to <@%s>.\nLoan `%s` is now fully repaid! ✅": "💰 Empréstimo cobrado automaticamente!\n<@%s> pagou **%d %s** para <@%s>.\nO empréstimo `%s` foi quitado! ✅",
+	"💵 Amount":                    "💵 Valor",
+	"💸 Total to Pay":              "💸 Total a Pagar",
+	"📅 Due Date":                  "📅 Vencimento",
+	"📈 Interest Rate":             "📈 Taxa de Juros",
+	"📋 Active Loans":              "📋 Empréstimos Ativos",
+	"📩 Loan offer sent to <@%s>!": "📩 Oferta de empréstimo enviada para <@%s>!",
+	"🔴 OVERDUE":                   "🔴 ATRASADO",
+
+	// Loja
+	"\n**Available Items:**\n\n1. **Change Own Nickname**\n   Cost: %d %s\n   Command: `!buy nickname <new name>`\n\n2. **Change Other's Nickname**\n   Cost: %d %s\n   Command: `!buy rename @user <new name>`\n\n3. **Punishment/Timeout User** (Server timeout - text & voice)\n   Cost: %d %s per minute\n   Command: `!buy punishment @user <minutes>`\n\n4. **Mute User** (Voice only - must be in call)\n   Cost: %d %s per minute\n   Command: `!buy mute @user <minutes>`\n\n*Every item is also available as* `/buy`.\n": "\n**Itens Disponíveis:**\n\n1. **Mudar o Próprio Apelido**\n   Custo: %d %s\n   Comando: `!buy nickname <novo nome>`\n\n2. **Mudar o Apelido de Outra Pessoa**\n   Custo: %d %s\n   Comando: `!buy rename @usuário <novo nome>`\n\n3. **Castigar Usuário** (Timeout no servidor - texto e voz)\n   Custo: %d %s por minuto\n   Comando: `!buy punishment @usuário <minutos>`\n\n4. **Silenciar Usuário** (Só voz - precisa estar em call)\n   Custo: %d %s por minuto\n   Comando: `!buy mute @usuário <minutos>`\n\n*Todos os itens também estão em* `/buy`.\n",
+	"%s has been muted in voice for %d minutes.":                                         "%s foi silenciado na voz por %d minutos.",
+	"%s has been timed out until %s.":                                                    "%s está de castigo até %s.",
+	"%s is in an active game. Waiting for the game to end to apply the %s...":            "%s está em um jogo. Aguardando o jogo terminar para aplicar o %s...",
+	"%s is not in a voice channel! You can only mute users who are currently in a call.": "%s não está em um canal de voz! Você só pode silenciar quem está em call.",
+	"Could not change nickname (check my permissions).":                                  "Não foi possível mudar o apelido (verifique minhas permissões).",
+	"Error applying timeout (check permissions/hierarchy).":                              "Erro ao aplicar o castigo (verifique permissões/hierarquia).",
+	"Error changing nickname (check permissions/hierarchy).":                             "Erro ao mudar o apelido (verifique permissões/hierarquia).",
+	"Error muting user (check permissions/hierarchy).":                                   "Erro ao silenciar o usuário (verifique permissões/hierarquia).",
+	"Insufficient funds. Cost: %d %s.":                                                   "Saldo insuficiente. Custo: %d %s.",
+	"Member not found.":                                                                  "Membro não encontrado.",
+	"Nickname of %s changed.":                                                            "Apelido de %s alterado.",
+	"Punishment Applied!":                                                                "Castigo Aplicado!",
+	"Purchase Successful":                                                                "Compra Realizada",
+	"Use `!shop` to see available items.":                                                "Use `!shop` para ver os itens disponíveis.",
+	"User Muted!":                                                                        "Usuário Silenciado!",
+	"Your nickname has been changed!":                                                    "Seu apelido foi alterado!",
+	"mute":                                                                               "silenciamento",
+	"punishment":                                                                         "castigo",
+	"⏳ Waiting":                                                                          "⏳ Aguardando",
+	"🛒 %s Shop":                                                                          "🛒 Loja %s",
+
+	// Ações
+	"\n**Total Value**: ~%d %s":                                              "\n**Valor Total**: ~%d %s",
+	"**%s**: %.4f shares (~%d %s @ $%.2f)\n":                                 "**%s**: %.4f ações (~%d %s @ $%.2f)\n",
+	"Current Market Prices (Updates every 10m, Earnings Multiplier: %.1fx):": "Preços Atuais (Atualiza a cada 10m, Multiplicador de Ganhos: %.1fx):",
+	"Fetching...":                            "Buscando...",
+	"Invalid Ticker. Check `!stock market`.": "Ticker inválido. Veja `!stock market`.",
+	"Investment Successful":                  "Investimento Realizado",
+	"Portfolio":                              "Carteira",
+	"Sale Successful":                        "Venda Realizada",
+	"Unknown subcommand. Use `market`, `buy`, `sell`, or `portfolio`.":     "Subcomando desconhecido. Use `market`, `buy`, `sell` ou `portfolio`.",
+	"Usage: `!stock <market|buy|sell|portfolio>`":                          "Uso: `!stock <market|buy|sell|portfolio>`",
+	"You bought **%.4f** shares of **%s** for **%d %s** (at $%.2f/share).": "Você comprou **%.4f** ações de **%s** por **%d %s** (a $%.2f/ação).",
+	"You have no investments.":                                             "Você não tem investimentos.",
+	"You sold **%.4f** shares of **%s** for **%d %s** (at $%.2f/share).":   "Você vendeu **%.4f** ações de **%s** por **%d %s** (a $%.2f/ação).",
+	"Your Portfolio": "Sua Carteira",
+
+	// Cotações e confirmação de ordens
+	"$%.2f/share":      "$%.2f/ação",
+	"$%s/coin":         "$%s/moeda",
+	"%s - %.4f shares": "%s - %.4f ações",
+	"%s - %s coins":    "%s - %s moedas",
+	"**Quoted price:** %s\n**You pay:** %d %s\n**You get:** %s\n\nQuote expires <t:%d:R>. The order fills at the market price when you confirm.%s": "**Preço cotado:** %s\n**Você paga:** %d %s\n**Você recebe:** %s\n\nA cotação expira <t:%d:R>. A ordem é executada ao preço de mercado quando você confirmar.%s",
+	"Buy %s (%s)?":            "Comprar %s (%s)?",
+	"No coins were spent.":    "Nenhuma moeda foi gasta.",
+	"Order Cancelled":         "Ordem Cancelada",
+	"Quote Expired":           "Cotação Expirada",
+	"This order isn't yours.": "Esta ordem não é sua.",
+	"~%.4f shares":            "~%.4f ações",
+	"⏰ This quote has expired. Run the command again for a fresh price.": "⏰ Esta cotação expirou. Use o comando de novo para um preço atualizado.",
+	"⚠️ **Meme coins are highly volatile! Invest at your own risk.**":    "⚠️ **Meme coins são muito voláteis! Invista por sua conta e risco.**",
+	"✅ Confirm": "✅ Confirmar",
+	"❌ Cancel":  "❌ Cancelar",
+	"📉 **The price moved since your quote.** Please confirm the new price.": "📉 **O preço mudou desde a sua cotação.** Confirme o novo preço.",
+
+	// Webhooks
+	"\nGame events are sent for wins/losses of at least **%d %s**.": "\nEventos de jogos são enviados para ganhos/perdas de pelo menos **%d %s**.",
+	"\nGame threshold: **%d %s**":                                   "\nLimite de jogos: **%d %s**",
+	"  ↳ next retry <t:%d:R>\n":                                     "  ↳ próxima tentativa <t:%d:R>\n",
+	"%s `%s` • HTTP %s • %d attempt(s) • <t:%d:R>\n":                "%s `%s` • HTTP %s • %d tentativa(s) • <t:%d:R>\n",
+	"**New signing secret:** `%s`":                                  "**Novo segredo de assinatura:** `%s`",
+	"Database error loading deliveries.":                            "Erro no banco de dados ao carregar as entregas.",
+	"Database error loading subscriptions.":                         "Erro no banco de dados ao carregar as inscrições.",
+	"Database error saving subscription.":                           "Erro no banco de dados ao salvar a inscrição.",
+	"Database error saving threshold.":                              "Erro no banco de dados ao salvar o limite.",
+	"Database error saving webhook.":                                "Erro no banco de dados ao salvar o webhook.",
+	"Error removing webhook.":                                       "Erro ao remover o webhook.",
+	"Error rotating secret.":                                        "Erro ao trocar o segredo.",
+	"Invalid URL: %s":                                               "URL inválida: %s",
+	"No deliveries yet.":                                            "Nenhuma entrega ainda.",
+	"Secret Rotated":                                                "Segredo Trocado",
+	"Set a webhook first with `/webhook set <url>`.":                "Configure um webhook antes com `/webhook set <url>`.",
+	"Subscribed":      "Inscrito",
+	"Test Failed: %s": "Falha no Teste: %s",
+	"Test Sent":       "Teste Enviado",
+	"This URL points to a private or reserved address and can't be used.": "Esta URL aponta para um endereço privado ou reservado e não pode ser usada.",
+	"Unknown event.": "Evento desconhecido.",
+	"Unsubscribed":   "Inscrição Cancelada",
+	"We sent a signed test payload to your URL (HTTP %d).": "Enviamos um payload de teste assinado para sua URL (HTTP %d).",
+	"Webhook Configured": "Webhook Configurado",
+	"Webhook Deliveries": "Entregas do Webhook",
+	"Webhook Events":     "Eventos do Webhook",
+	"Webhook Removed":    "Webhook Removido",
+	"Webhook saved, but the signing secret could not be created.": "Webhook salvo, mas o segredo de assinatura não pôde ser criado.",
+	"You don't have a webhook configured.":                        "Você não tem um webhook configurado.",
+	"You will no longer receive `%s` events.":                     "Você não vai mais receber eventos `%s`.",
+	"You will no longer receive notifications.":                   "Você não vai mais receber notificações.",
+	"You will receive `%s` events.%s":                             "Você vai receber eventos `%s`.%s",
+	"Your webhook URL has been saved.\n\n**Signing secret:** `%s`\nEvery request carries an `%s` header with `sha256=HMAC(secret, timestamp + \".\" + body)`.": "A URL do seu webhook foi salva.\n\n**Segredo de assinatura:** `%s`\nToda requisição leva um header `%s` com `sha256=HMAC(segredo, timestamp + \".\" + corpo)`.",
+	"Coins received from another user":          "Moedas recebidas de outro usuário",
+	"Stock or crypto order executed":            "Ordem de ação ou cripto executada",
+	"Daily reward claimed":                      "Recompensa diária coletada",
+	"Voice activity reward paid":                "Recompensa por atividade de voz paga",
+	"Game won (above your threshold)":           "Jogo ganho (acima do seu limite)",
+	"Game lost (above your threshold)":          "Jogo perdido (acima do seu limite)",
+	"Someone offered you a loan":                "Alguém te ofereceu um empréstimo",
+	"Your loan offer was accepted":              "Sua oferta de empréstimo foi aceita",
+	"A loan is due within 24 hours":             "Um empréstimo vence em menos de 24 horas",
+	"A loan was auto-collected at its due date": "Um empréstimo foi cobrado automaticamente no vencimento",
+	"Stock dividend paid":                       "Dividendo de ação pago",
+
+	// Jogos
+	"Usage: `!bet aviator <amount>`, `!bet crash <amount> [auto]`, `!bet cups <amount>`, `!bet blackjack <amount>`, `!bet slots <amount>`, `!bet table <amount>`, or `!roulette @user <amount>`": "Uso: `!bet aviator <valor>`, `!bet crash <valor> [auto]`, `!bet cups <valor>`, `!bet blackjack <valor>`, `!bet slots <valor>`, `!bet table <valor>` ou `!roulette @usuário <valor>`",
+	"Minimum bet is %d %s": "A aposta mínima é %d %s",
+	"Bet Placed!":          "Aposta Feita!",
+	"You already have a game running. Finish it first.":            "Você já tem um jogo em andamento. Termine-o primeiro.",
+	"Too many games are running right now. Try again in a moment.": "Há jogos demais rodando agora. Tente de novo em instantes.",
+
+	// Aviator
+	"<@%s> You ran out of money while waiting.":                    "<@%s> Seu dinheiro acabou enquanto você esperava.",
+	"<@%s> Your Aviator game is starting!":                         "<@%s> Seu jogo de Aviator está começando!",
+	"Altitude":                                                     "Altitude",
+	"Bet: **%d**\nPreparing for takeoff...":                        "Aposta: **%d**\nPreparando para decolar...",
+	"Cannot queue game (Min bet: %d, Check balance/active games).": "Não foi possível entrar na fila (Aposta mín.: %d, verifique saldo/jogos ativos).",
+	"GAME OVER": "FIM DE JOGO",
+	"Multiplier: **x%.2f**\nPotential Win: **%d**": "Multiplicador: **x%.2f**\nGanho Possível: **%d**",
+	"You are position **#%d** in the queue.":       "Você está na posição **#%d** da fila.",
+	"You jumped at **x%.2f**\nProfit: **+%d %s**":  "Você pulou em **x%.2f**\nLucro: **+%d %s**",
+	"You ran out of money while waiting in queue!": "Seu dinheiro acabou enquanto você esperava na fila!",
+	"⏳ Queued":               "⏳ Na Fila",
+	"⚠️ Inactive game.":      "⚠️ Jogo inativo.",
+	"✅ CASHED OUT!":          "✅ SACOU!",
+	"✈️ Aviator Flying...":   "✈️ Aviator Voando...",
+	"✈️ Aviator Starting...": "✈️ Aviator Decolando...",
+	"💥 CRASHED at x%.2f":     "💥 CAIU em x%.2f",
+	"🛑 CASH OUT":             "🛑 SACAR",
+
+	// Aviator multiplayer
+	"Multiplier: **x%.2f**\nCash out before the crash!": "Multiplicador: **x%.2f**\nSaque antes da queda!",
+	"Passengers (%d)": "Passageiros (%d)",
+	"Takeoff <t:%d:R>. Join with `/bet crash` or `!bet crash <amount> [auto]`.": "Decolagem <t:%d:R>. Embarque com `/bet crash` ou `!bet crash <valor> [auto]`.",
+	"The auto cash-out must be between x1.01 and x%.0f.":                        "O saque automático precisa estar entre x1.01 e x%.0f.",
+	"The flight is over.":                                         "O voo acabou.",
+	"The plane hasn't taken off yet.":                             "O avião ainda não decolou.",
+	"This flight already took off. Bet again when it lands.":      "Este voo já decolou. Aposte de novo quando ele pousar.",
+	"Too late, the plane crashed!":                                "Tarde demais, o avião caiu!",
+	"You already cashed out.":                                     "Você já sacou.",
+	"You are already on this flight.":                             "Você já está neste voo.",
+	"You have no bet on this flight.":                             "Você não tem aposta neste voo.",
+	"You're on board with **%d %s**, auto cash-out at **x%.2f**.": "Você embarcou com **%d %s**, saque automático em **x%.2f**.",
+	"You're on board with **%d %s**. Cash out before the crash!":  "Você embarcou com **%d %s**. Saque antes da queda!",
+	"✅ x%.2f (+%d %s)":                                            "✅ x%.2f (+%d %s)",
+	"✈️ Aviator - Boarding":                                       "✈️ Aviator - Embarque",
+	"✈️ Aviator - Flying":                                         "✈️ Aviator - Voando",
+	"✈️ auto x%.2f":                                               "✈️ auto x%.2f",
+	"💥 lost":                                                      "💥 perdeu",
+
+	// Blackjack
+	"\n💰 Profit: **+%d %s**":               "\n💰 Lucro: **+%d %s**",
+	"\n💸 Loss: **%d %s**":                  "\n💸 Perda: **%d %s**",
+	"\n🛡️ Insurance lost: -%d %s":          "\n🛡️ Seguro perdido: -%d %s",
+	"\n🛡️ Insurance paid: +%d %s":          "\n🛡️ Seguro pago: +%d %s",
+	"%s\nScore: %d":                        "%s\nPontos: %d",
+	"%s\nScore: %s":                        "%s\nPontos: %s",
+	"Choose your action":                   "Escolha sua jogada",
+	"Double Down":                          "Dobrar",
+	"Failed to start game.":                "Falha ao iniciar o jogo.",
+	"Game ended":                           "Jogo encerrado",
+	"Hit":                                  "Pedir",
+	"Insufficient balance for insurance!":  "Saldo insuficiente para o seguro!",
+	"Insufficient balance to double down!": "Saldo insuficiente para dobrar!",
+	"Insurance":                            "Seguro",
+	"Insurance purchased: %d %s":           "Seguro comprado: %d %s",
+	"Minimum bet is 10 %s":                 "A aposta mínima é 10 %s",
+	"No active game found!":                "Nenhum jogo ativo encontrado!",
+	"Stand":                                "Parar",
+	"Use: !bj hit | !bj stand | !bj double | !bj insurance (User: %s)": "Use: !bj hit | !bj stand | !bj double | !bj insurance (Usuário: %s)",
+	"You already have an active Blackjack game!":                       "Você já tem um jogo de Blackjack ativo!",
+	"✅ **YOU WIN!**":               "✅ **VOCÊ GANHOU!**",
+	"❌ **DEALER WINS**":            "❌ **O DEALER GANHOU**",
+	"🃏 Blackjack":                  "🃏 Blackjack",
+	"🃏 Blackjack - Game Over":      "🃏 Blackjack - Fim de Jogo",
+	"🎉 **BLACKJACK!**":             "🎉 **BLACKJACK!**",
+	"🎰 Dealer's Hand":              "🎰 Mão do Dealer",
+	"🎴 Your Hand":                  "🎴 Sua Mão",
+	"💥 **BUST - YOU LOSE**":        "💥 **ESTOUROU - VOCÊ PERDEU**",
+	"💥 **DEALER BUST - YOU WIN!**": "💥 **O DEALER ESTOUROU - VOCÊ GANHOU!**",
+	"💵 Balance":                    "💵 Saldo",
+	"🤝 **PUSH - TIE**":             "🤝 **EMPATE**",
+
+	// Mesa de blackjack
+	"%d decks | Blackjack pays %s | %s":                            "%d baralhos | Blackjack paga %s | %s",
+	"(hand %d of %d)":                                              "(mão %d de %d)",
+	"<@%s>, it's your turn!":                                       "<@%s>, é a sua vez!",
+	"A hand is being played at this table. Sit down when it ends.": "Uma mão está em jogo nesta mesa. Sente-se quando ela acabar.",
+	"Bet: %d %s":               "Aposta: %d %s",
+	"Dealer hits soft 17":      "Dealer pede no 17 soft",
+	"Dealer stands on soft 17": "Dealer para no 17 soft",
+	"Dealing <t:%d:R>. Sit down with `/bet table` or `!bet table <amount>` (%d/%d seats).": "Distribuição <t:%d:R>. Sente-se com `/bet table` ou `!bet table <valor>` (%d/%d lugares).",
+	"Hand over. Use `/bet table` to play again.":                                           "Mão encerrada. Use `/bet table` para jogar de novo.",
+	"It's not your turn.":                    "Não é a sua vez.",
+	"Split":                                  "Dividir",
+	"Surrender":                              "Desistir",
+	"The table is full.":                     "A mesa está cheia.",
+	"You already have a seat at this table.": "Você já tem um lugar nesta mesa.",
+	"You can't split this hand.":             "Você não pode dividir esta mão.",
+	"You sat at the table with **%d %s**. Cards are dealt when the betting window closes.": "Você se sentou à mesa com **%d %s**. As cartas são distribuídas quando as apostas fecharem.",
+	"blackjack!":                 "blackjack!",
+	"bust":                       "estourou",
+	"doubled":                    "dobrou",
+	"surrendered":                "desistiu",
+	"🃏 Blackjack Table":          "🃏 Mesa de Blackjack",
+	"🔀 A new shoe was shuffled.": "🔀 Um sapato novo foi embaralhado.",
+
+	// Copos
+	"<@%s> It's your turn!":                                  "<@%s> É a sua vez!",
+	"Current Pot: **%d %s**\n\n**Guess where the coin is!**": "Prêmio Atual: **%d %s**\n\n**Adivinhe onde está a moeda!**",
+	"The coin was in **Cup %d**.\n\nYou have **%d %s**.\n\nDo you want to **Cash Out** or continue for **%dx**?": "A moeda estava no **Copo %d**.\n\nVocê tem **%d %s**.\n\nQuer **Sacar** ou continuar por **%dx**?",
+	"You picked Cup %d, but the coin was in **Cup %d**.\n\n📉 You lost **%d %s**.":                                "Você escolheu o Copo %d, mas a moeda estava no **Copo %d**.\n\n📉 Você perdeu **%d %s**.",
+	"⏰ Game timed out. You lost your bet.":                                                                       "⏰ O tempo do jogo acabou. Você perdeu sua aposta.",
+	"⏰ Timeout. Auto-cashing out **%d %s**.":                                                                     "⏰ Tempo esgotado. Sacando automaticamente **%d %s**.",
+	"⏳ <@%s> Queued for Cup Game (Pos: #%d)":                                                                     "⏳ <@%s> Na fila do Jogo dos Copos (Pos: #%d)",
+	"⚠️ This is not your game.":                                                                                  "⚠️ Este jogo não é seu.",
+	"✅ CORRECT!":                                                                                                 "✅ ACERTOU!",
+	"❌ <@%s> You ran out of funds while waiting.":                                                                "❌ <@%s> Seu saldo acabou enquanto você esperava.",
+	"❌ Insufficient funds.":                                                                                      "❌ Saldo insuficiente.",
+	"❌ WRONG!":                                                                                                   "❌ ERROU!",
+	"🎉 **Congratulations!**\n<@%s> walked away with **%d %s**!":                                                  "🎉 **Parabéns!**\n<@%s> saiu com **%d %s**!",
+	"🎲 Continue (10x or Nothing)":                                                                                "🎲 Continuar (10x ou Nada)",
+	"🎲 Continue (Double or Nothing)":                                                                             "🎲 Continuar (Dobro ou Nada)",
+	"💰 Cash Out":                                                                                                 "💰 Sacar",
+	"🥤 Cup Game - Round %d":                                                                                      "🥤 Jogo dos Copos - Rodada %d",
+
+	// Eventos de apostas
+	"Betting is now closed. Use `/event result` to set the winner.": "As apostas estão fechadas. Use `/event result` para definir o vencedor.",
+	"Betting is now closed. Use `!result` to set the winner.":       "As apostas estão fechadas. Use `!result` para definir o vencedor.",
+	" | Ends in %d min":                          " | Termina em %d min",
+	"**%d. %s** - Odds: %s | Bets: %d (%d %s)\n": "**%d. %s** - Odds: %s | Apostas: %d (%d %s)\n",
+	"**%s**\n\n**Winner:** %s":                   "**%s**\n\n**Vencedor:** %s",
+	"**%s**\n\nBetting is now closed! Waiting for admin to set the result.\n\nTotal Pool: **%d %s** | Total Bets: **%d**": "**%s**\n\nAs apostas estão fechadas! Aguardando o admin definir o resultado.\n\nTotal Apostado: **%d %s** | Apostas: **%d**",
+	"**%s** - %s\nID: `%s` | Pool: %d %s | %s\n\n": "**%s** - %s\nID: `%s` | Total: %d %s | %s\n\n",
+	"**Status:** %s\n**Total Pool:** %d %s\n\n%s":  "**Status:** %s\n**Total Apostado:** %d %s\n\n%s",
+	"Active Events": "Eventos Ativos",
+	"Betting is still active. Close the event first or wait for time to end.": "As apostas ainda estão abertas. Encerre o evento antes ou espere o tempo acabar.",
+	"Betting time has ended.":                                 "O tempo de apostas acabou.",
+	"Duration must be between 1 and 1440 minutes (24 hours).": "A duração deve estar entre 1 e 1440 minutos (24 horas).",
+	"Ends in %dm":                                                 "Termina em %dm",
+	"Error processing bet.":                                       "Erro ao processar a aposta.",
+	"Event Closed":                                                "Evento Encerrado",
+	"Event ID: %s | Min Bet: %d %s":                               "ID do evento: %s | Aposta mín.: %d %s",
+	"Event ID: %s | Use !result %s <option>":                      "ID do evento: %s | Use !result %s <opção>",
+	"Event not found. Use `!events` to see active events.":        "Evento não encontrado. Use `!events` para ver os eventos ativos.",
+	"Invalid option number.":                                      "Número de opção inválido.",
+	"Invalid winning option.":                                     "Opção vencedora inválida.",
+	"Maximum 10 options allowed.":                                 "No máximo 10 opções.",
+	"Need at least 2 options.":                                    "São necessárias pelo menos 2 opções.",
+	"Need question and at least 2 options separated by |":         "Informe a pergunta e pelo menos 2 opções separadas por |",
+	"No active betting events.":                                   "Nenhum evento de apostas ativo.",
+	"No winners this time.":                                       "Nenhum vencedor desta vez.",
+	"No winners! House keeps the pool.":                           "Nenhum vencedor! A casa fica com o total.",
+	"Only the event creator or an admin can close it early.":      "Só o criador do evento ou um admin pode encerrá-lo antes.",
+	"Only the event creator or an admin can set the result.":      "Só o criador do evento ou um admin pode definir o resultado.",
+	"Question must be between 5 and 200 characters.":              "A pergunta deve ter entre 5 e 200 caracteres.",
+	"Result already set.":                                         "O resultado já foi definido.",
+	"Result set! Distributed %d %s to winners. House kept %d %s.": "Resultado definido! %d %s distribuídos aos vencedores. A casa ficou com %d %s.",
+	"This event is closed.":                                       "Este evento está encerrado.",
+	"Usage: `!betevent <event_id> <option_number> <amount>`\nExample: `!betevent evt_123456 1 100` (bets 100 on option 1)": "Uso: `!betevent <id_evento> <número_opção> <valor>`\nExemplo: `!betevent evt_123456 1 100` (aposta 100 na opção 1)",
+	"Usage: `!closeevent <event_id>`": "Uso: `!closeevent <id_evento>`",
+	"Usage: `!createevent <question> | <option1> | <option2> | ... | <duration_minutes>`\nExample: `!createevent Will Team A win? | Yes | No | Maybe | 30`": "Uso: `!createevent <pergunta> | <opção1> | <opção2> | ... | <duração_minutos>`\nExemplo: `!createevent O Time A vai ganhar? | Sim | Não | Talvez | 30`",
+	"Usage: `!event <event_id>`": "Uso: `!event <id_evento>`",
+	"Usage: `!result <event_id> <option_number>`\nExample: `!result evt_123456 1` (sets option 1 as winner)": "Uso: `!result <id_evento> <número_opção>`\nExemplo: `!result evt_123456 1` (define a opção 1 como vencedora)",
+	"Waiting for result": "Aguardando resultado",
+	"You already bet on '%s'. Only one bet per event allowed.":                           "Você já apostou em '%s'. Só é permitida uma aposta por evento.",
+	"You already bet on this option. Use a different option or wait for the next event.": "Você já apostou nesta opção. Escolha outra opção ou espere o próximo evento.",
+	"You bet **%d %s** on **%s** (Option %d)":                                            "Você apostou **%d %s** em **%s** (Opção %d)",
+	"⏰ Ended":                      "⏰ Terminado",
+	"🎲 Active Betting Events":      "🎲 Eventos de Apostas Ativos",
+	"🏆 Event Result!":              "🏆 Resultado do Evento!",
+	"💰 Winners":                    "💰 Vencedores",
+	"📊 Summary":                    "📊 Resumo",
+	"🔒 Betting Closed":             "🔒 Apostas Encerradas",
+	"🔒 Closed":                     "🔒 Encerrado",
+	"🟢 Open":                       "🟢 Aberto",
+	"Amount (min %d %s)":           "Valor (mín. %d %s)",
+	"Bet on %s":                    "Apostar em %s",
+	"Create Betting Event":         "Criar Evento de Apostas",
+	"Duration in minutes (1-1440)": "Duração em minutos (1-1440)",
+	"Event Created":                "Evento Criado",
+	"Event `%s` is open for %d minutes. Use `/event result` when it ends.": "O evento `%s` fica aberto por %d minutos. Use `/event result` quando terminar.",
+	"Event created, but I couldn't post it in this channel.":               "Evento criado, mas não consegui postá-lo neste canal.",
+	"Event not found.":                           "Evento não encontrado.",
+	"Invalid amount. Minimum is %d":              "Valor inválido. O mínimo é %d",
+	"Invalid duration. Use minutes (e.g., 30)":   "Duração inválida. Use minutos (ex.: 30)",
+	"Invalid option.":                            "Opção inválida.",
+	"Only moderators can create betting events.": "Só moderadores podem criar eventos de apostas.",
+	"Options (one per line, 2-10)":               "Opções (uma por linha, 2-10)",
+	"Question":                                   "Pergunta",
+	"Will Team A win?":                           "O Time A vai ganhar?",
+	"Yes\nNo\nDraw":                              "Sim\nNão\nEmpate",
+	"You bet **%d %s** on **%s**":                "Você apostou **%d %s** em **%s**",
+
+	// Roleta do cassino
+	"Pick the `dozen` to bet on.":                  "Escolha a `dozen` (dúzia) da aposta.",
+	"Pick the `number` (0-36) to bet on.":          "Escolha o `number` (0-36) da aposta.",
+	"# %s **%d**\n\nThe ball landed on **%s %d**!": "# %s **%d**\n\nA bola caiu no **%s %d**!",
+	"%s dozen": "%s dúzia",
+	"Betting is closed! The wheel is spinning.":                          "Apostas fechadas! A roleta está girando.",
+	"Error placing bet.":                                                 "Erro ao fazer a aposta.",
+	"Invalid bet type. Use `!wheel` for help.":                           "Tipo de aposta inválido. Use `!wheel` para ajuda.",
+	"Invalid bet.":                                                       "Aposta inválida.",
+	"Next round starting soon...":                                        "A próxima rodada começa em breve...",
+	"Next spin in **%d minutes and %d seconds**.":                        "Próximo giro em **%d minutos e %d segundos**.",
+	"No active roulette round.":                                          "Nenhuma rodada de roleta ativa.",
+	"No winners this round.":                                             "Nenhum vencedor nesta rodada.",
+	"Place your bets! The wheel spins in **%d minutes and %d seconds**.": "Façam suas apostas! A roleta gira em **%d minutos e %d segundos**.",
+	"Roulette": "Roleta",
+	"Too late! Betting is closed for this round.": "Tarde demais! As apostas desta rodada fecharam.",
+	"Too late! The wheel is already spinning.":    "Tarde demais! A roleta já está girando.",
+	"Total Bets: %d\nTotal Wagered: %d %s":        "Apostas: %d\nTotal Apostado: %d %s",
+	"Usage:\n`!wheel number <0-36> <amount>` - Bet on a specific number (35:1)\n`!wheel red <amount>` - Bet on red (1:1)\n`!wheel black <amount>` - Bet on black (1:1)\n`!wheel even <amount>` - Bet on even (1:1)\n`!wheel odd <amount>` - Bet on odd (1:1)\n`!wheel low <amount>` - Bet on 1-18 (1:1)\n`!wheel high <amount>` - Bet on 19-36 (1:1)\n`!wheel dozen <1st/2nd/3rd> <amount>` - Bet on dozen (2:1)\n\nUse `!wheel time` to see when the next spin is, or `/wheel bet`.": "Uso:\n`!wheel number <0-36> <valor>` - Aposta em um número (35:1)\n`!wheel red <valor>` - Aposta no vermelho (1:1)\n`!wheel black <valor>` - Aposta no preto (1:1)\n`!wheel even <valor>` - Aposta nos pares (1:1)\n`!wheel odd <valor>` - Aposta nos ímpares (1:1)\n`!wheel low <valor>` - Aposta em 1-18 (1:1)\n`!wheel high <valor>` - Aposta em 19-36 (1:1)\n`!wheel dozen <1st/2nd/3rd> <valor>` - Aposta em uma dúzia (2:1)\n\nUse `!wheel time` para ver quando é o próximo giro, ou `/wheel bet`.",
+	"Usage: `!wheel number <0-36> <amount>`":       "Uso: `!wheel number <0-36> <valor>`",
+	"Usage: `!wheel dozen <1st/2nd/3rd> <amount>`": "Uso: `!wheel dozen <1st/2nd/3rd> <valor>`",
+	"You bet **%d %s** on **%s**.":                 "Você apostou **%d %s** em **%s**.",
+	"high (19-36)":                                 "altos (19-36)",
+	"low (1-18)":                                   "baixos (1-18)",
+	"number %s":                                    "número %s",
+	"red":                                          "vermelho",
+	"black":                                        "preto",
+	"even":                                         "par",
+	"odd":                                          "ímpar",
+	"• `!wheel number <0-36> <amount>` - **35:1**\n• `!wheel red <amount>` or `!wheel black <amount>` - **1:1**\n• `!wheel even <amount>` or `!wheel odd <amount>` - **1:1**\n• `!wheel low <amount>` (1-18) or `!wheel high <amount>` (19-36) - **1:1**\n• `!wheel dozen <1st/2nd/3rd> <amount>` - **2:1**": "• `!wheel number <0-36> <valor>` - **35:1**\n• `!wheel red <valor>` ou `!wheel black <valor>` - **1:1**\n• `!wheel even <valor>` ou `!wheel odd <valor>` - **1:1**\n• `!wheel low <valor>` (1-18) ou `!wheel high <valor>` (19-36) - **1:1**\n• `!wheel dozen <1st/2nd/3rd> <valor>` - **2:1**",
+	"⏰ Next Spin":                "⏰ Próximo Giro",
+	"🍀 Good luck!":               "🍀 Boa sorte!",
+	"🎰 ROULETTE - Betting Open!": "🎰 ROLETA - Apostas Abertas!",
+	"🎰 ROULETTE - Result!":       "🎰 ROLETA - Resultado!",
+	"🏆 Winners":                  "🏆 Vencedores",
+	"💰 Minimum Bet":              "💰 Aposta Mínima",
+	"📊 Round Stats":              "📊 Estatísticas da Rodada",
+	"📋 Available Bets":           "📋 Apostas Disponíveis",
+
+	// Roleta russa
+	" ⬅️ (Your turn)":      " ⬅️ (Sua vez)",
+	"30 seconds to accept": "30 segundos para aceitar",
+	"<@%s> challenged <@%s> to a game of Russian Roulette!": "<@%s> desafiou <@%s> para uma Roleta Russa!",
+	"<@%s>'s turn":                                                 "Vez de <@%s>",
+	"Chamber %d was empty!":                                        "A câmara %d estava vazia!",
+	"Game Over - The survivor takes all!":                          "Fim de Jogo - O sobrevivente leva tudo!",
+	"Invalid amount. Use a positive number.":                       "Valor inválido. Use um número positivo.",
+	"Invalid user or bot.":                                         "Usuário inválido ou bot.",
+	"It's **%s**'s turn!\nClick the button to pull the trigger...": "É a vez de **%s**!\nClique no botão para puxar o gatilho...",
+	"Mention a valid user. Example: `!roulette @user 100`":         "Mencione um usuário válido. Exemplo: `!roulette @usuário 100`",
+	"Position %d/6":                                                "Posição %d/6",
+	"Round: %d | Shot position: %d/6":                              "Rodada: %d | Posição do tiro: %d/6",
+	"Survivor takes %d %s!":                                        "O sobrevivente leva %d %s!",
+	"This user already has a pending challenge!":                   "Este usuário já tem um desafio pendente!",
+	"This user is already in a Russian Roulette game!":             "Este usuário já está em uma Roleta Russa!",
+	"Usage: `!roulette @user <amount>`\n\nChallenge another user to a game of Russian Roulette. Winner takes all!\n\n`!roulette lobby <amount> [split]` opens a game for up to %d players. With `split`, the first death ends the game and the survivors share the pot.\n`!roulette bet @player <amount>` bets on a lobby player surviving.": "Uso: `!roulette @usuário <valor>`\n\nDesafie outro usuário para uma Roleta Russa. O vencedor leva tudo!\n\n`!roulette lobby <valor> [split]` abre um jogo para até %d jogadores. Com `split`, a primeira morte encerra o jogo e os sobreviventes dividem o pote.\n`!roulette bet @jogador <valor>` aposta que um jogador do lobby sobrevive.",
+	"You are already in a Russian Roulette game!":                              "Você já está em uma Roleta Russa!",
+	"You cannot challenge yourself!":                                           "Você não pode desafiar a si mesmo!",
+	"⏰ <@%s> did not respond to <@%s>'s challenge in time! Challenge expired.": "⏰ <@%s> não respondeu ao desafio de <@%s> a tempo! O desafio expirou.",
+	"⏱️ Time":                               "⏱️ Tempo",
+	"✅ Accept":                              "✅ Aceitar",
+	"❌ <@%s> declined the challenge!":       "❌ <@%s> recusou o desafio!",
+	"❌ <@%s> does not have enough balance!": "❌ <@%s> não tem saldo suficiente!",
+	"❌ <@%s> no longer has enough balance!": "❌ <@%s> não tem mais saldo suficiente!",
+	"❌ Decline":                             "❌ Recusar",
+	"❌ Game not found!":                     "❌ Jogo não encontrado!",
+	"❌ It's not your turn!":                 "❌ Não é a sua vez!",
+	"❌ No pending challenge found!":         "❌ Nenhum desafio pendente encontrado!",
+	"🎲 Details":                             "🎲 Detalhes",
+	"🎲 Result":                              "🎲 Resultado",
+	"🎲 Round":                               "🎲 Rodada",
+	"🏆 Winner":                              "🏆 Vencedor",
+	"💥 **POW!** <@%s> pulled the trigger and... **DIED!**": "💥 **POW!** <@%s> puxou o gatilho e... **MORREU!**",
+	"💰 Bet":                          "💰 Aposta",
+	"💰 Prize":                        "💰 Prêmio",
+	"💰 Total Pot":                    "💰 Prêmio Total",
+	"🔄 Next":                         "🔄 Próximo",
+	"🔫 Cylinder":                     "🔫 Tambor",
+	"🔫 Russian Roulette":             "🔫 Roleta Russa",
+	"🔫 Russian Roulette - GAME OVER": "🔫 Roleta Russa - FIM DE JOGO",
+	"🔫 Russian Roulette Challenge":   "🔫 Desafio de Roleta Russa",
+	"🔫 SHOOT":                        "🔫 ATIRAR",
+	"😅 **CLICK!** <@%s> pulled the trigger and... survived!": "😅 **CLICK!** <@%s> puxou o gatilho e... sobreviveu!",
+	"%d players left. The cylinder is reloaded.":             "Restam %d jogadores. O tambor foi recarregado.",
+	"(🎟️ %d %s)":                 "(🎟️ %d %s)",
+	"<@%s> (+%d %s)":             "<@%s> (+%d %s)",
+	"<@%s> on <@%s>: %d ➜ %d %s": "<@%s> em <@%s>: %d ➜ %d %s",
+	"<@%s> opened a game of Russian Roulette! Join with the button.\nThe first shot is fired <t:%d:R>.": "<@%s> abriu uma Roleta Russa! Entre pelo botão.\nO primeiro tiro é disparado <t:%d:R>.",
+	"Game Over - The survivors split the pot!":                                                          "Fim de Jogo - Os sobreviventes dividem o pote!",
+	"Mention a valid user. Example: `!roulette bet @user 100`":                                          "Mencione um usuário válido. Exemplo: `!roulette bet @usuário 100`",
+	"Not enough players joined. All bets were refunded.":                                                "Jogadores insuficientes. Todas as apostas foram devolvidas.",
+	"Players can't place side bets on their own game.":                                                  "Jogadores não podem fazer apostas paralelas no próprio jogo.",
+	"Spectators: !roulette bet @player <amount> to bet on a survivor":                                   "Espectadores: !roulette bet @jogador <valor> para apostar num sobrevivente",
+	"That user is not in the lobby.":                                                                    "Esse usuário não está no lobby.",
+	"The first death ends the game. Survivors split %d %s!":                                             "A primeira morte encerra o jogo. Os sobreviventes dividem %d %s!",
+	"The first death ends the game. The survivors split the pot.":                                       "A primeira morte encerra o jogo. Os sobreviventes dividem o pote.",
+	"The last survivor takes the pot.":                                                                  "O último sobrevivente leva o pote.",
+	"The lobby is full.":                                                                                "O lobby está cheio.",
+	"There is already a Russian Roulette lobby in this channel.":                                        "Já existe um lobby de Roleta Russa neste canal.",
+	"There is no Russian Roulette lobby in this channel.":                                               "Não há um lobby de Roleta Russa neste canal.",
+	"This game already started.":                                                                        "Este jogo já começou.",
+	"You have a side bet on this game and can't play in it.":                                            "Você tem uma aposta paralela neste jogo e não pode jogar nele.",
+	"✅ Join (%d %s)":           "✅ Entrar (%d %s)",
+	"🎟️ Side Bets":             "🎟️ Apostas Paralelas",
+	"👥 Players (%d alive)":     "👥 Jogadores (%d vivos)",
+	"👥 Players (%d/%d)":        "👥 Jogadores (%d/%d)",
+	"📜 Rules":                  "📜 Regras",
+	"🔫 Russian Roulette Lobby": "🔫 Lobby de Roleta Russa",
+
+	// Duelos e roleta russa pelo slash
+	"Duel":                                  "Duelo",
+	"Your duel is open to the channel!":     "Seu duelo está aberto para o canal!",
+	"Challenge sent to <@%s>!":              "Desafio enviado para <@%s>!",
+	"Your lobby is open!":                   "Sua sala está aberta!",
+	"You bet **%d %s** on <@%s> surviving.": "Você apostou **%d %s** na sobrevivência de <@%s>.",
+
+	// Caça-níquel
+	"**%s** got a match!\n\n%s\n\n**Bet:** %d %s\n**Multiplier:** %.1fx\n**Won:** %d %s":                 "**%s** acertou uma combinação!\n\n%s\n\n**Aposta:** %d %s\n**Multiplicador:** %.1fx\n**Ganhou:** %d %s",
+	"**%s** hit the JACKPOT!\n\n%s\n\n**Bet:** %d %s\n**Multiplier:** %.1fx\n**Won:** %d %s 🎉":           "**%s** ganhou o JACKPOT!\n\n%s\n\n**Aposta:** %d %s\n**Multiplicador:** %.1fx\n**Ganhou:** %d %s 🎉",
+	"**%s** is ready to play!\n\n# ❓ | ❓ | ❓\n\n**Bet:** %d %s\n\n*Click the button to pull the lever!*": "**%s** está pronto para jogar!\n\n# ❓ | ❓ | ❓\n\n**Aposta:** %d %s\n\n*Clique no botão para puxar a alavanca!*",
+	"**%s** is spinning...\n\n# %s\n\n**Bet:** %d %s":                                                    "**%s** está girando...\n\n# %s\n\n**Aposta:** %d %s",
+	"**%s** is spinning...\n\n# 🎰 | 🎰 | 🎰\n\n**Bet:** %d %s":                                             "**%s** está girando...\n\n# 🎰 | 🎰 | 🎰\n\n**Aposta:** %d %s",
+	"**%s** spun the reels...\n\n%s\n\n**Bet:** %d %s\n💔 No match this time!":                            "**%s** girou os rolos...\n\n%s\n\n**Aposta:** %d %s\n💔 Nenhuma combinação desta vez!",
+	"❌ <@%s> Insufficient balance!":                                                                      "❌ <@%s> Saldo insuficiente!",
+	"❌ <@%s> Insufficient balance! You have %d %s":                                                       "❌ <@%s> Saldo insuficiente! Você tem %d %s",
+	"❌ <@%s> You already have an active slots game!":                                                     "❌ <@%s> Você já tem um caça-níquel ativo!",
+	"❌ Minimum bet is %d %s":                                                                             "❌ A aposta mínima é %d %s",
+	"❌ This is not your game!":                                                                           "❌ Este jogo não é seu!",
+	"🍒🍋🍊 = Small | 🔔 = Medium | 💎 = High | 7️⃣ = JACKPOT!":                                               "🍒🍋🍊 = Pequeno | 🔔 = Médio | 💎 = Alto | 7️⃣ = JACKPOT!",
+	"🎉 WINNER!":      "🎉 VENCEDOR!",
+	"🎰 PULL LEVER":   "🎰 PUXAR ALAVANCA",
+	"🎰 Slot Machine": "🎰 Caça-Níquel",
+	"🎰💰 JACKPOT! 💰🎰": "🎰💰 JACKPOT! 💰🎰",
+	"😢 No Luck!":     "😢 Sem Sorte!",
+
+	// Pôquer
+	"%s calls %d.":                   "%s paga %d.",
+	"%s checks.":                     "%s passa.",
+	"%s folds and leaves the table.": "%s desiste e sai da mesa.",
+	"%s folds.":                      "%s desiste.",
+	"%s is all-in with %d.":          "%s está all-in com %d.",
+	"%s posts the small blind (%d), %s the big blind (%d).": "%s paga o small blind (%d), %s o big blind (%d).",
+	"%s raises to %d.":     "%s aumenta para %d.",
+	"%s sat down with %d.": "%s sentou-se com %d.",
+	"**Board:** %s":        "**Mesa:** %s",
+	"**Pot:** %d %s":       "**Pote:** %d %s",
+	"All-in %d":            "All-in %d",
+	"Bet: %d":              "Aposta: %d",
+	"Blinds %d/%d | Rake %g%% | Use 🃏 to see your cards": "Blinds %d/%d | Rake %g%% | Use 🃏 para ver suas cartas",
+	"Call %d":                   "Pagar %d",
+	"Cards":                     "Cartas",
+	"Cashed Out":                "Fichas Sacadas",
+	"Check":                     "Passar",
+	"Fold":                      "Desistir",
+	"Leaving the Table":         "Saindo da Mesa",
+	"Main pot":                  "Pote principal",
+	"Poker":                     "Pôquer",
+	"Raise":                     "Aumentar",
+	"Raise to (min %d, max %d)": "Aumentar para (mín %d, máx %d)",
+	"Rake: %d":                  "Rake: %d",
+	"Seat Taken!":               "Lugar Ocupado!",
+	"Side pot %d":               "Pote paralelo %d",
+	"Stack: %d":                 "Fichas: %d",
+	"The buy-in must be between %d and %d %s.":      "A entrada deve ser entre %d e %d %s.",
+	"The minimum raise is to %d.":                   "O aumento mínimo é para %d.",
+	"This table is closing, try again in a moment.": "Esta mesa está fechando, tente novamente em instantes.",
+	"To call: **%d**":                               "Para pagar: **%d**",
+	"Unknown action.":                               "Ação desconhecida.",
+	"Usage: `!poker join <buy-in>` or `!poker leave`\nBlinds %d/%d, buy-in from %d to %d %s.": "Uso: `!poker join <entrada>` ou `!poker leave`\nBlinds %d/%d, entrada de %d a %d %s.",
+	"Waiting for players. Sit down with `/poker join`.":                                       "Aguardando jogadores. Sente-se com `/poker join`.",
+	"You are already sitting at this table.":                                                  "Você já está sentado nesta mesa.",
+	"You are not playing this hand.":                                                          "Você não está jogando esta mão.",
+	"You are not sitting at a poker table in this channel.":                                   "Você não está sentado em uma mesa de pôquer neste canal.",
+	"You left the table with **%d %s**.":                                                      "Você saiu da mesa com **%d %s**.",
+	"You sat at the poker table with **%d %s** in chips. You play from the next hand.":        "Você se sentou à mesa de pôquer com **%d %s** em fichas. Você joga a partir da próxima mão.",
+	"You will stand up when this hand ends and your chips will go back to your balance.":      "Você sairá quando esta mão terminar e suas fichas voltarão para o seu saldo.",
+	"Your cards: %s":              "Suas cartas: %s",
+	"Your hand: **%s**":           "Sua mão: **%s**",
+	"all-in":                      "all-in",
+	"folded":                      "desistiu",
+	"leaving":                     "saindo",
+	"waiting":                     "aguardando",
+	"⏰ %s ran out of time.":       "⏰ O tempo de %s acabou.",
+	"♠️ Texas Hold'em - Hand #%d": "♠️ Texas Hold'em - Mão #%d",
+	"🃏 Your Hole Cards":           "🃏 Suas Cartas",
+	"🏆 %s wins %d.":               "🏆 %s ganha %d.",
+	"🏆 %s: %s wins %d with %s.":   "🏆 %s: %s ganha %d com %s.",
+	"High Card":                   "Carta Alta",
+	"Pair":                        "Par",
+	"Two Pair":                    "Dois Pares",
+	"Three of a Kind":             "Trinca",
+	"Straight":                    "Sequência",
+	"Flush":                       "Flush",
+	"Full House":                  "Full House",
+	"Four of a Kind":              "Quadra",
+	"Straight Flush":              "Straight Flush",
+	"Royal Flush":                 "Royal Flush",
+
+	// Loteria
+	"Buy tickets with !lottery buy <tickets>": "Compre bilhetes com !lottery buy <bilhetes>",
+	"Each ticket gets a number from 1 to %d. The holders of the drawn number share the jackpot; if nobody has it, the jackpot rolls over!": "Cada bilhete recebe um número de 1 a %d. Quem tiver o número sorteado divide o prêmio; se ninguém tiver, o prêmio acumula!",
+	"Lottery": "Loteria",
+	"Next draw #%d | Buy tickets with !lottery buy <tickets>":                     "Próximo sorteio #%d | Compre bilhetes com !lottery buy <bilhetes>",
+	"Nobody had number %d. The jackpot of **%d %s** rolls over to the next draw!": "Ninguém tinha o número %d. O prêmio de **%d %s** acumula para o próximo sorteio!",
+	"The lottery is closed.":                                              "A loteria está fechada.",
+	"The winning number is... **%d**!":                                    "O número sorteado é... **%d**!",
+	"You bought **%d** tickets. Your numbers: %s\nGood luck in the draw!": "Você comprou **%d** bilhetes. Seus números: %s\nBoa sorte no sorteio!",
+	"You can have at most %d tickets per draw (you have %d).":             "Você pode ter no máximo %d bilhetes por sorteio (você tem %d).",
+	"You have no tickets for this draw.":                                  "Você não tem bilhetes para este sorteio.",
+	"Your Tickets (%d/%d)":                                                "Seus Bilhetes (%d/%d)",
+	"`!lottery` / `/lottery info`\nJackpot, next draw and your ticket numbers.\n\n`!lottery buy <tickets>` / `/lottery buy`\nBuy tickets (**%d %s** each) for the next draw.\n\n*Holders of the drawn number share the jackpot. No winner? It rolls over!*": "`!lottery` / `/lottery info`\nPrêmio, próximo sorteio e os números dos seus bilhetes.\n\n`!lottery buy <bilhetes>` / `/lottery buy`\nCompre bilhetes (**%d %s** cada) para o próximo sorteio.\n\n*Quem tiver o número sorteado divide o prêmio. Sem ganhador? Acumula!*",
+	"⏰ Draw":                "⏰ Sorteio",
+	"🎟️ LOTTERY - Draw #%d": "🎟️ LOTERIA - Sorteio #%d",
+	"🎟️ Lottery - Draw #%d": "🎟️ Loteria - Sorteio #%d",
+	"🎟️ Tickets Bought!":    "🎟️ Bilhetes Comprados!",
+	"🎫 Ticket Price":        "🎫 Preço do Bilhete",
+	"🎫 Tickets Sold":        "🎫 Bilhetes Vendidos",
+	"💰 Jackpot":             "💰 Prêmio",
+	"💸 No winner":           "💸 Sem ganhador",
+
+	// Duelos
+	"Duels":             "Duelos",
+	"🪙 Coinflip Duel":   "🪙 Duelo de Cara ou Coroa",
+	"🎲 Dice Duel":       "🎲 Duelo de Dados",
+	"🎲 Dice Duel (d%d)": "🎲 Duelo de Dados (d%d)",
+	"Usage: `!coinflip @user <amount>`\n\nThe challenger is heads, the opponent is tails. Winner takes the pot.\n\n`!coinflip open <amount>` lets anyone in the channel accept.":                                                    "Uso: `!coinflip @usuário <valor>`\n\nQuem desafia fica com cara, o oponente com coroa. O vencedor leva o pote.\n\n`!coinflip open <valor>` deixa qualquer pessoa do canal aceitar.",
+	"Usage: `!dice @user <amount> [sides]`\n\nBoth players roll a die (%d sides by default, up to %d) and the highest roll takes the pot. Ties are re-rolled.\n\n`!dice open <amount> [sides]` lets anyone in the channel accept.":  "Uso: `!dice @usuário <valor> [lados]`\n\nOs dois jogadores rolam um dado (%d lados por padrão, até %d) e o maior resultado leva o pote. Empates são rolados de novo.\n\n`!dice open <valor> [lados]` deixa qualquer pessoa do canal aceitar.",
+	"`!coinflip @user <amount>`\nHeads or tails against another user.\n\n`!dice @user <amount> [sides]`\nHighest roll wins. Ties are re-rolled.\n\n*Use `open` instead of a mention to let anyone accept. Minimum bet: **%d %s**.*": "`!coinflip @usuário <valor>`\nCara ou coroa contra outro usuário.\n\n`!dice @usuário <valor> [lados]`\nO maior dado vence. Empates são rolados de novo.\n\n*Use `open` no lugar da menção para qualquer pessoa aceitar. Aposta mínima: **%d %s**.*",
+	"Mention a valid user. Example: `!%s @user 100`":                       "Mencione um usuário válido. Exemplo: `!%s @usuário 100`",
+	"The die must have between 2 and %d sides.":                            "O dado deve ter entre 2 e %d lados.",
+	"You already have an open challenge!":                                  "Você já tem um desafio aberto!",
+	"<@%s> challenged <@%s> to a duel!":                                    "<@%s> desafiou <@%s> para um duelo!",
+	"<@%s> opened a duel! Anyone in the channel can accept.":               "<@%s> abriu um duelo! Qualquer pessoa do canal pode aceitar.",
+	"House cut: %.0f%% of the pot":                                         "Taxa da casa: %.0f%% do pote",
+	"House cut: %d %s":                                                     "Taxa da casa: %d %s",
+	"No pending challenge found!":                                          "Nenhum desafio pendente encontrado!",
+	"This challenge is not for you!":                                       "Este desafio não é para você!",
+	"You cannot accept your own challenge!":                                "Você não pode aceitar o próprio desafio!",
+	"Only <@%s> can cancel this challenge.":                                "Só <@%s> pode cancelar este desafio.",
+	"❌ <@%s> cancelled the challenge.":                                     "❌ <@%s> cancelou o desafio.",
+	"<@%s> rolled **%d**\n<@%s> rolled **%d**":                             "<@%s> tirou **%d**\n<@%s> tirou **%d**",
+	"*(%d tie(s) re-rolled)*":                                              "*(%d empate(s) rolado(s) de novo)*",
+	"Heads":                                                                "Cara",
+	"Tails":                                                                "Coroa",
+	"<@%s> (Heads) vs <@%s> (Tails)\nThe coin lands on **%s**!":            "<@%s> (Cara) vs <@%s> (Coroa)\nA moeda cai em **%s**!",
+	"⏰ Nobody accepted <@%s>'s open challenge in time! Challenge expired.": "⏰ Ninguém aceitou o desafio aberto de <@%s> a tempo! O desafio expirou.",
+	"%d seconds to accept":                                                 "%d segundos para aceitar",
+
+	// Prêmio acumulado do caça-níquel
+	"**%s** is ready to play!\n\n%s\n\n**Bet:** %d %s x %d lines = %d %s\n\n*Click the button to pull the lever!*": "**%s** está pronto para jogar!\n\n%s\n\n**Aposta:** %d %s x %d linhas = %d %s\n\n*Clique no botão para puxar a alavanca!*",
+	"**%s** spun %d lines...\n\n%s\n\n**Bet:** %d %s x %d lines = %d %s\n**Won:** %d %s":                           "**%s** girou %d linhas...\n\n%s\n\n**Aposta:** %d %s x %d linhas = %d %s\n**Ganhou:** %d %s",
+	"Choose between 1 and %d paylines.": "Escolha entre 1 e %d linhas.",
+	"Line %d: %s %s %s (%.1fx) +%d %s":  "Linha %d: %s %s %s (%.1fx) +%d %s",
+	"📈 Winning Lines":                   "📈 Linhas Premiadas",
+	"💰 Progressive Jackpot":             "💰 Prêmio Acumulado",
+	"🎰 Progressive Jackpot":             "🎰 Prêmio Acumulado",
+	"💰 **PROGRESSIVE JACKPOT!** +%d %s": "💰 **PRÊMIO ACUMULADO!** +%d %s",
+	"🎰💰 PROGRESSIVE JACKPOT! 💰🎰":        "🎰💰 PRÊMIO ACUMULADO! 💰🎰",
+	"<@%s> hit 7️⃣ 7️⃣ 7️⃣ and won the progressive jackpot of **%d %s**!":                                                "<@%s> tirou 7️⃣ 7️⃣ 7️⃣ e levou o prêmio acumulado de **%d %s**!",
+	"Current pool: **%d %s**\n\n*%.0f%% of every slots bet feeds the pool. Hit 7️⃣ 7️⃣ 7️⃣ on a payline to win it all!*": "Pote atual: **%d %s**\n\n*%.0f%% de cada aposta no caça-níquel vai para o pote. Tire 7️⃣ 7️⃣ 7️⃣ em uma linha para levar tudo!*",
+	"Nobody has hit the jackpot yet.": "Ninguém levou o prêmio acumulado ainda.",
+	"🏆 Recent Winners":                "🏆 Últimos Ganhadores",
+
+	// Limites de jogo responsável
+	"🛡️ Gambling Limits":     "🛡️ Limites de Jogo",
+	"🛡️ Gambling Limits: %s": "🛡️ Limites de Jogo: %s",
+	"Limits cap what you can lose. Tighter limits apply at once; looser ones only after %d hours.": "Os limites controlam quanto você pode perder. Limites mais rígidos valem na hora; mais folgados só depois de %d horas.",
+	"📅 Daily Loss":              "📅 Perda Diária",
+	"🗓️ Weekly Loss":            "🗓️ Perda Semanal",
+	"🎯 Max Bet":                 "🎯 Aposta Máxima",
+	"No limit":                  "Sem limite",
+	"%d %s (lost: %d)":          "%d %s (perdido: %d)",
+	"⛔ Self-Excluded":           "⛔ Autoexcluído",
+	"Until <t:%d:f> (<t:%d:R>)": "Até <t:%d:f> (<t:%d:R>)",
+	"⏳ Pending Change":          "⏳ Mudança Pendente",
+	"Could not check your gambling limits. Try again later.":                  "Não foi possível verificar seus limites de jogo. Tente novamente mais tarde.",
+	"Could not save your limits.":                                             "Não foi possível salvar seus limites.",
+	"Unknown limit.":                                                          "Limite desconhecido.",
+	"Self-exclusion must last between 24 hours and 30 days.":                  "A autoexclusão deve durar entre 24 horas e 30 dias.",
+	"You are already self-excluded until <t:%d:f>.":                           "Você já está autoexcluído até <t:%d:f>.",
+	"You are self-excluded from gambling until <t:%d:f>.":                     "Você está autoexcluído dos jogos até <t:%d:f>.",
+	"This bet is over your limit of %d %s per bet.":                           "Esta aposta passa do seu limite de %d %s por aposta.",
+	"This bet could take you past your daily loss limit of %d %s (%d left).":  "Esta aposta pode passar do seu limite de perda diária de %d %s (restam %d).",
+	"This bet could take you past your weekly loss limit of %d %s (%d left).": "Esta aposta pode passar do seu limite de perda semanal de %d %s (restam %d).",
+	"Limit Scheduled": "Limite Agendado",
+	"Limit Updated":   "Limite Atualizado",
+	"Loosening a limit takes %d hours. Your new limit applies <t:%d:R>; until then the current one stays.": "Afrouxar um limite leva %d horas. Seu novo limite vale <t:%d:R>; até lá o atual continua.",
+	"Your new limit applies now.":    "Seu novo limite já está valendo.",
+	"Choose 1d, 3d, 7d, 14d or 30d.": "Escolha 1d, 3d, 7d, 14d ou 30d.",
+	"Self-Exclusion Active":          "Autoexclusão Ativa",
+	"You can't place any bet until <t:%d:f>. This can't be undone, not even by an admin.": "Você não pode fazer nenhuma aposta até <t:%d:f>. Isso não pode ser desfeito, nem por um admin.",
+
+	// Estatísticas de jogo
+	"📊 Gambling Stats: %s":                            "📊 Estatísticas de Jogo: %s",
+	"📊 %s Stats: %s":                                  "📊 Estatísticas de %s: %s",
+	"Could not load the statistics. Try again later.": "Não foi possível carregar as estatísticas. Tente novamente mais tarde.",
+	"No games played yet.":                            "Nenhuma partida jogada ainda.",
+	"**Games played:** %d\n**Total wagered:** %d %s\n**Net profit:** %+d %s\n**Biggest win:** %d %s\n**Win rate:** %.1f%%": "**Partidas:** %d\n**Total apostado:** %d %s\n**Lucro líquido:** %+d %s\n**Maior vitória:** %d %s\n**Taxa de vitória:** %.1f%%",
+	"Played: %d\nWagered: %d\nNet: %+d\nBiggest win: %d\nWin rate: %.0f%%":                                                 "Partidas: %d\nApostado: %d\nLíquido: %+d\nMaior vitória: %d\nTaxa de vitória: %.0f%%",
+	"🕒 Recent Games":       "🕒 Partidas Recentes",
+	"🎰 Slots":              "🎰 Caça-níquel",
+	"🥤 Cups":               "🥤 Copos",
+	"🎡 Wheel":              "🎡 Roleta",
+	"🪙 Coinflip":           "🪙 Cara ou Coroa",
+	"🎲 Dice":               "🎲 Dados",
+	"🔫 Roulette Side Bets": "🔫 Apostas na Roleta Russa",
+	"🎟️ Lottery":           "🎟️ Loteria",
+	"📊 Events":             "📊 Eventos",
+}
@@ -0,0 +1,114 @@
+// Package i18n traduz as mensagens mostradas aos usuários.
+//
+// As mensagens são escritas em inglês no código e o próprio texto em inglês é
+// a chave do catálogo (como no gettext): o catálogo "en" é o código-fonte e os
+// demais idiomas mapeiam cada texto para a tradução. Textos sem tradução
+// aparecem em inglês.
+//
+//	loc.T("You have %d %s", balance, symbol)
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"estudocoin/pkg/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Locale é um idioma suportado pelo bot
+type Locale string
+
+const (
+	English    Locale = "en"
+	Portuguese Locale = "pt-BR"
+)
+
+// Locales lista os idiomas suportados, na ordem mostrada em /language
+var Locales = []Locale{English, Portuguese}
+
+// catalogs mapeia o texto em inglês para a tradução de cada idioma
+var catalogs = map[Locale]map[string]string{
+	Portuguese: portuguese,
+}
+
+// Name retorna o nome do idioma no próprio idioma
+func (l Locale) Name() string {
+	switch l {
+	case Portuguese:
+		return "Português (Brasil)"
+	default:
+		return "English"
+	}
+}
+
+// Parse aceita códigos como "en", "en-US", "pt", "pt-BR" ou "pt_br"
+func Parse(code string) (Locale, bool) {
+	code = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "_", "-"))
+	switch {
+	case code == "en" || strings.HasPrefix(code, "en-"):
+		return English, true
+	case code == "pt" || strings.HasPrefix(code, "pt-"):
+		return Portuguese, true
+	}
+	return "", false
+}
+
+// T traduz msg e, se houver argumentos, formata com fmt.Sprintf
+func (l Locale) T(msg string, args ...interface{}) string {
+	if tr, ok := catalogs[l][msg]; ok {
+		msg = tr
+	}
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+// Templated é implementado por erros cuja mensagem pode ser traduzida
+// (o formato é a chave do catálogo)
+type Templated interface {
+	Template() (format string, args []interface{})
+}
+
+// Err traduz a mensagem de um erro. Erros sem Templated são traduzidos pelo texto.
+func (l Locale) Err(err error) string {
+	var t Templated
+	if errors.As(err, &t) {
+		format, args := t.Template()
+		return l.T(format, args...)
+	}
+	return l.T(err.Error())
+}
+
+// ErrorEmbed monta um utils.ErrorEmbed com título e mensagem traduzidos
+func (l Locale) ErrorEmbed(msg string, args ...interface{}) *discordgo.MessageEmbed {
+	embed := utils.ErrorEmbed(l.T(msg, args...))
+	embed.Title = "❌ " + l.T("Error")
+	return embed
+}
+
+// Message é um erro traduzível criado por Errorf
+type Message struct {
+	format string
+	args   []interface{}
+}
+
+// Errorf cria um erro cuja mensagem é traduzida por Locale.Err
+func Errorf(format string, args ...interface{}) error {
+	return &Message{format: format, args: args}
+}
+
+func (m *Message) Error() string {
+	if len(m.args) == 0 {
+		return m.format
+	}
+	return fmt.Sprintf(m.format, m.args...)
+}
+
+// Template implementa Templated
+func (m *Message) Template() (string, []interface{}) {
+	return m.format, m.args
+}
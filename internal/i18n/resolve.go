@@ -0,0 +1,139 @@
+package i18n
+
+import (
+	"estudocoin/internal/database"
+	"estudocoin/pkg/config"
+	"log"
+	"sync"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Escolhas de idioma em cache (userID/guildID -> Locale, "" = sem escolha)
+var (
+	userLocales  = make(map[string]Locale)
+	guildLocales = make(map[string]Locale)
+	cacheMu      sync.RWMutex
+)
+
+// Default retorna o idioma padrão do config.json
+func Default() Locale {
+	if l, ok := Parse(config.Bot().DefaultLocale); ok {
+		return l
+	}
+	return English
+}
+
+// ForMessage retorna o idioma de um comando de prefixo:
+// escolha do usuário, depois a do servidor, depois o padrão
+func ForMessage(m *discordgo.MessageCreate) Locale {
+	if l := userLocale(m.Author.ID); l != "" {
+		return l
+	}
+	return ForGuild(m.GuildID)
+}
+
+// ForInteraction retorna o idioma de uma interação: escolha do usuário,
+// depois o idioma do cliente Discord, depois o do servidor, depois o padrão
+func ForInteraction(i *discordgo.InteractionCreate) Locale {
+	userID := ""
+	if i.Member != nil && i.Member.User != nil {
+		userID = i.Member.User.ID
+	} else if i.User != nil {
+		userID = i.User.ID
+	}
+
+	if l := userLocale(userID); l != "" {
+		return l
+	}
+	if l, ok := Parse(string(i.Locale)); ok {
+		return l
+	}
+	return ForGuild(i.GuildID)
+}
+
+// ForGuild retorna o idioma padrão do servidor (mensagens sem usuário, como a roleta)
+func ForGuild(guildID string) Locale {
+	if guildID != "" {
+		if l := guildLocale(guildID); l != "" {
+			return l
+		}
+	}
+	return Default()
+}
+
+// ForChannel retorna o idioma do servidor dono do canal
+func ForChannel(s *discordgo.Session, channelID string) Locale {
+	if ch, err := s.State.Channel(channelID); err == nil {
+		return ForGuild(ch.GuildID)
+	}
+	if ch, err := s.Channel(channelID); err == nil {
+		return ForGuild(ch.GuildID)
+	}
+	return Default()
+}
+
+// UserChoice retorna o idioma escolhido pelo usuário ("" = automático)
+func UserChoice(userID string) Locale {
+	return userLocale(userID)
+}
+
+// GuildChoice retorna o idioma escolhido para o servidor ("" = padrão do config.json)
+func GuildChoice(guildID string) Locale {
+	return guildLocale(guildID)
+}
+
+// SetUser grava o idioma do usuário. Vazio volta ao automático.
+func SetUser(userID string, l Locale) error {
+	if err := database.SetUserLocale(userID, string(l)); err != nil {
+		return err
+	}
+	cacheMu.Lock()
+	userLocales[userID] = l
+	cacheMu.Unlock()
+	return nil
+}
+
+// SetGuild grava o idioma padrão do servidor. Vazio volta ao padrão do config.json.
+func SetGuild(guildID string, l Locale) error {
+	if err := database.SetGuildLocale(guildID, string(l)); err != nil {
+		return err
+	}
+	cacheMu.Lock()
+	guildLocales[guildID] = l
+	cacheMu.Unlock()
+	return nil
+}
+
+func userLocale(userID string) Locale {
+	if userID == "" {
+		return ""
+	}
+	return cached(userLocales, userID, database.GetUserLocale)
+}
+
+func guildLocale(guildID string) Locale {
+	return cached(guildLocales, guildID, database.GetGuildLocale)
+}
+
+func cached(cache map[string]Locale, id string, load func(string) (string, error)) Locale {
+	cacheMu.RLock()
+	l, ok := cache[id]
+	cacheMu.RUnlock()
+	if ok {
+		return l
+	}
+
+	code, err := load(id)
+	if err != nil {
+		// Sem cache: tenta de novo na próxima mensagem
+		log.Printf("Error loading locale for %s: %v", id, err)
+		return ""
+	}
+	l, _ = Parse(code)
+
+	cacheMu.Lock()
+	cache[id] = l
+	cacheMu.Unlock()
+	return l
+}
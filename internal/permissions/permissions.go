@@ -0,0 +1,87 @@
+// Package permissions define quem pode usar os comandos administrativos do bot.
+//
+// O nível de um membro vem de três fontes, na ordem:
+//   - IDs de usuário listados em config.json (permissions.admin_users / moderator_users)
+//   - Cargos listados em config.json (permissions.admin_roles / moderator_roles)
+//   - Permissões do Discord: Administrator ou Manage Guild contam como admin
+package permissions
+
+import (
+	"estudocoin/pkg/config"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Level é o nível de acesso de um membro
+type Level int
+
+const (
+	Everyone Level = iota
+	Moderator
+	Admin
+)
+
+func (l Level) String() string {
+	switch l {
+	case Admin:
+		return "Admin"
+	case Moderator:
+		return "Moderator"
+	default:
+		return "Everyone"
+	}
+}
+
+// Of calcula o nível a partir do usuário, dos cargos e das permissões do Discord no canal
+func Of(userID string, roles []string, perms int64) Level {
+	cfg := config.Bot().Permissions
+
+	if contains(cfg.AdminUsers, userID) || containsAny(cfg.AdminRoles, roles) ||
+		perms&(discordgo.PermissionAdministrator|discordgo.PermissionManageGuild) != 0 {
+		return Admin
+	}
+	if contains(cfg.ModeratorUsers, userID) || containsAny(cfg.ModeratorRoles, roles) {
+		return Moderator
+	}
+	return Everyone
+}
+
+// ForMessage retorna o nível do autor de uma mensagem
+func ForMessage(s *discordgo.Session, m *discordgo.MessageCreate) Level {
+	var roles []string
+	if m.Member != nil {
+		roles = m.Member.Roles
+	}
+	perms, _ := s.UserChannelPermissions(m.Author.ID, m.ChannelID)
+	return Of(m.Author.ID, roles, perms)
+}
+
+// ForInteraction retorna o nível de quem disparou a interação
+func ForInteraction(i *discordgo.InteractionCreate) Level {
+	if i.Member == nil {
+		// Fora de servidor só valem os usuários configurados
+		if i.User == nil {
+			return Everyone
+		}
+		return Of(i.User.ID, nil, 0)
+	}
+	return Of(i.Member.User.ID, i.Member.Roles, i.Member.Permissions)
+}
+
+func contains(list []string, id string) bool {
+	for _, v := range list {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAny(list, ids []string) bool {
+	for _, id := range ids {
+		if contains(list, id) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"estudocoin/internal/database"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AdminService reúne as correções manuais da economia feitas pelos admins.
+// Toda ação fica registrada na auditoria com o admin e o motivo.
+type AdminService struct{}
+
+// Admin é a instância usada pelos comandos
+var Admin = &AdminService{}
+
+// GiveCoins credita moedas ao usuário
+func (a AdminService) GiveCoins(ctx context.Context, adminID, targetID string, amount int, reason string) (*database.AdminAction, error) {
+	if err := validateAdminAmount(amount, reason); err != nil {
+		return nil, err
+	}
+	before, after, err := database.ChangeBalance(ctx, targetID, amount)
+	if err != nil {
+		return nil, newError(ErrInternal, "Error updating balance.")
+	}
+	return a.record(database.AdminActionGive, adminID, targetID, amount, before, after, reason)
+}
+
+// TakeCoins debita moedas do usuário, sem deixar o saldo negativo
+func (a AdminService) TakeCoins(ctx context.Context, adminID, targetID string, amount int, reason string) (*database.AdminAction, error) {
+	if err := validateAdminAmount(amount, reason); err != nil {
+		return nil, err
+	}
+	before, after, err := database.ChangeBalance(ctx, targetID, -amount)
+	if errors.Is(err, database.ErrInsufficientBalance) {
+		return nil, newError(ErrInsufficientFunds, "<@%s> only has %d. Use set to change the balance directly.",
+			targetID, database.GetBalance(targetID))
+	}
+	if err != nil {
+		return nil, newError(ErrInternal, "Error updating balance.")
+	}
+	return a.record(database.AdminActionTake, adminID, targetID, amount, before, after, reason)
+}
+
+// SetCoins define o saldo do usuário
+func (a AdminService) SetCoins(ctx context.Context, adminID, targetID string, amount int, reason string) (*database.AdminAction, error) {
+	if amount < 0 {
+		return nil, newError(ErrInvalidInput, "Balance cannot be negative.")
+	}
+	if strings.TrimSpace(reason) == "" {
+		return nil, newError(ErrInvalidInput, "A reason is required.")
+	}
+	before, err := database.SetBalance(ctx, targetID, amount)
+	if err != nil {
+		return nil, newError(ErrInternal, "Error updating balance.")
+	}
+	return a.record(database.AdminActionSet, adminID, targetID, amount, before, amount, reason)
+}
+
+// Freeze congela a conta: transferências, jogos, trading e API ficam bloqueados
+func (a AdminService) Freeze(ctx context.Context, adminID, targetID, reason string) (*database.AdminAction, error) {
+	return a.setFrozen(adminID, targetID, true, reason)
+}
+
+// Unfreeze libera uma conta congelada
+func (a AdminService) Unfreeze(ctx context.Context, adminID, targetID, reason string) (*database.AdminAction, error) {
+	return a.setFrozen(adminID, targetID, false, reason)
+}
+
+func (a AdminService) setFrozen(adminID, targetID string, frozen bool, reason string) (*database.AdminAction, error) {
+	if database.IsFrozen(targetID) == frozen {
+		if frozen {
+			return nil, newError(ErrConflict, "<@%s> is already frozen.", targetID)
+		}
+		return nil, newError(ErrConflict, "<@%s> is not frozen.", targetID)
+	}
+	if err := database.SetFrozen(targetID, frozen); err != nil {
+		return nil, newError(ErrInternal, "Error updating account.")
+	}
+
+	action := database.AdminActionFreeze
+	if !frozen {
+		action = database.AdminActionUnfreeze
+	}
+	balance := database.GetBalance(targetID)
+	return a.record(action, adminID, targetID, 0, balance, balance, reason)
+}
+
+// Reset zera o saldo, as ações, as criptos e os empréstimos em aberto do usuário
+func (a AdminService) Reset(ctx context.Context, adminID, targetID, reason string) (*database.AdminAction, error) {
+	before, err := database.ResetUser(ctx, targetID)
+	if err != nil {
+		return nil, newError(ErrInternal, "Error resetting account.")
+	}
+	Loans.Drop(targetID)
+	return a.record(database.AdminActionReset, adminID, targetID, 0, before, 0, reason)
+}
+
+// History retorna as últimas ações administrativas sobre o usuário
+func (AdminService) History(targetID string, limit int) ([]*database.AdminAction, error) {
+	actions, err := database.GetAdminActions(targetID, limit)
+	if err != nil {
+		return nil, newError(ErrInternal, "Could not load the audit log.")
+	}
+	return actions, nil
+}
+
+// record grava a ação na auditoria. A ação já foi aplicada, então uma falha
+// aqui só é registrada no log.
+func (AdminService) record(action, adminID, targetID string, amount, before, after int, reason string) (*database.AdminAction, error) {
+	entry := &database.AdminAction{
+		ID:            uuid.New().String(),
+		AdminID:       adminID,
+		TargetID:      targetID,
+		Action:        action,
+		Amount:        amount,
+		BalanceBefore: before,
+		BalanceAfter:  after,
+		Reason:        strings.TrimSpace(reason),
+		CreatedAt:     time.Now(),
+	}
+	if err := database.LogAdminAction(entry); err != nil {
+		log.Printf("Error writing admin audit entry (%s by %s on %s): %v", action, adminID, targetID, err)
+	}
+	return entry, nil
+}
+
+func validateAdminAmount(amount int, reason string) error {
+	if amount <= 0 {
+		return newError(ErrInvalidInput, "Amount must be positive.")
+	}
+	if strings.TrimSpace(reason) == "" {
+		return newError(ErrInvalidInput, "A reason is required.")
+	}
+	return nil
+}
+
+// checkFrozen retorna erro se alguma das contas estiver congelada.
+// O primeiro ID é o de quem faz a operação.
+func checkFrozen(userID string, others ...string) error {
+	if database.IsFrozen(userID) {
+		return ErrAccountFrozen
+	}
+	for _, id := range others {
+		if database.IsFrozen(id) {
+			return ErrOtherAccountFrozen
+		}
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"estudocoin/internal/crypto"
+	"estudocoin/internal/database"
+	"fmt"
+	"strings"
+)
+
+// CryptoQuote é a cotação de uma criptomoeda
+type CryptoQuote struct {
+	Symbol string
+	Name   string
+	Type   string
+	Price  float64
+}
+
+// CryptoService implementa as regras do mercado de criptomoedas
+type CryptoService struct{}
+
+// Crypto é a instância usada pelos comandos e pela API
+var Crypto = &CryptoService{}
+
+// Coin retorna a criptomoeda do símbolo ou nil se não for negociável
+func (CryptoService) Coin(symbol string) *crypto.Crypto {
+	return crypto.GetCryptoBySymbol(strings.ToUpper(symbol))
+}
+
+// Quotes retorna as cotações atuais, na ordem de crypto.AvailableCryptos
+func (CryptoService) Quotes(ctx context.Context) ([]CryptoQuote, error) {
+	prices, err := crypto.GetCryptoPricesContext(ctx)
+	if err != nil {
+		return nil, newError(ErrUnavailable, "Could not fetch crypto prices. Try again later.")
+	}
+
+	var quotes []CryptoQuote
+	for _, c := range crypto.AvailableCryptos {
+		if price := prices[c.ID]; price > 0 {
+			quotes = append(quotes, CryptoQuote{Symbol: c.Symbol, Name: c.Name, Type: c.Type, Price: price})
+		}
+	}
+	return quotes, nil
+}
+
+// Quote retorna o preço atual de uma criptomoeda, usado para confirmar compras
+func (s CryptoService) Quote(ctx context.Context, symbol string) (float64, error) {
+	c := s.Coin(symbol)
+	if c == nil {
+		return 0, newError(ErrInvalidInput, "Invalid cryptocurrency symbol.")
+	}
+	return s.price(ctx, c)
+}
+
+// price busca o preço atual de uma criptomoeda
+func (CryptoService) price(ctx context.Context, c *crypto.Crypto) (float64, error) {
+	price, err := crypto.GetSingleCryptoPriceContext(ctx, c.ID)
+	if err != nil || price <= 0 {
+		return 0, newError(ErrUnavailable, "Could not fetch crypto price. Try again later.")
+	}
+	return price, nil
+}
+
+// Portfolio retorna as criptomoedas do usuário avaliadas pelo preço atual
+func (CryptoService) Portfolio(ctx context.Context, userID string) (*Portfolio, error) {
+	investments, err := database.GetAllCryptoInvestmentsByUser(userID)
+	if err != nil {
+		return nil, newError(ErrInternal, "Database error.")
+	}
+
+	portfolio := &Portfolio{}
+	if len(investments) == 0 {
+		return portfolio, nil
+	}
+
+	prices, err := crypto.GetCryptoPricesContext(ctx)
+	if err != nil {
+		return nil, newError(ErrUnavailable, "Could not fetch current prices.")
+	}
+
+	totalValue := 0.0
+	for _, inv := range investments {
+		c := crypto.GetCryptoBySymbol(inv.Symbol)
+		if c == nil {
+			continue
+		}
+		price := prices[c.ID]
+		if price <= 0 {
+			continue
+		}
+
+		value := inv.Coins * price
+		totalValue += value
+		portfolio.Holdings = append(portfolio.Holdings, Holding{
+			Symbol:   inv.Symbol,
+			Name:     c.Name,
+			Type:     c.Type,
+			Quantity: inv.Coins,
+			Price:    price,
+			Value:    int(value),
+		})
+	}
+	portfolio.TotalValue = int(totalValue)
+	return portfolio, nil
+}
+
+// Buy compra criptomoedas gastando amount moedas
+func (s CryptoService) Buy(ctx context.Context, userID, symbol string, amount int) (*Order, error) {
+	c := s.Coin(symbol)
+	if c == nil {
+		return nil, newError(ErrInvalidInput, "Invalid cryptocurrency symbol.")
+	}
+	if err := checkFrozen(userID); err != nil {
+		return nil, err
+	}
+	if amount <= 0 {
+		return nil, newError(ErrInvalidInput, "Amount must be positive.")
+	}
+	if database.GetBalance(userID) < amount {
+		return nil, newError(ErrInsufficientFunds, "Insufficient funds.")
+	}
+
+	price, err := s.price(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	coins := float64(amount) / price
+	if err := database.BuyCrypto(ctx, userID, c.Symbol, amount, coins); err != nil {
+		return nil, tradeError(err)
+	}
+
+	return completeOrder(userID, "crypto", "buy", c.Symbol, coins, price, amount), nil
+}
+
+// Sell vende a quantidade de coins informada
+func (s CryptoService) Sell(ctx context.Context, userID, symbol string, coins float64) (*Order, error) {
+	return s.sell(ctx, userID, symbol, coins, false)
+}
+
+// SellAll vende todas as coins do símbolo
+func (s CryptoService) SellAll(ctx context.Context, userID, symbol string) (*Order, error) {
+	return s.sell(ctx, userID, symbol, 0, true)
+}
+
+func (s CryptoService) sell(ctx context.Context, userID, symbol string, coins float64, all bool) (*Order, error) {
+	c := s.Coin(symbol)
+	if c == nil {
+		return nil, newError(ErrInvalidInput, "Invalid cryptocurrency symbol.")
+	}
+	if err := checkFrozen(userID); err != nil {
+		return nil, err
+	}
+
+	owned, err := database.GetCryptoInvestment(userID, c.Symbol)
+	if err != nil {
+		return nil, newError(ErrInternal, "Database error.")
+	}
+	if owned <= 0 {
+		return nil, newError(ErrInvalidInput, "You don't own any %s.", c.Symbol)
+	}
+
+	if all {
+		coins = owned
+	}
+	if coins <= 0 {
+		return nil, newError(ErrInvalidInput, "Invalid amount.")
+	}
+	if coins > owned {
+		return nil, newError(ErrInvalidInput, "You only own %s %s.", FormatCryptoAmount(owned), c.Symbol)
+	}
+
+	price, err := s.price(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	payout := int(coins * price)
+	if err := database.SellCrypto(ctx, userID, c.Symbol, coins, payout); err != nil {
+		return nil, tradeError(err)
+	}
+
+	return completeOrder(userID, "crypto", "sell", c.Symbol, coins, price, payout), nil
+}
+
+// FormatCryptoAmount formata uma quantidade de coins com a precisão adequada
+func FormatCryptoAmount(amount float64) string {
+	if amount >= 1 {
+		return fmt.Sprintf("%.4f", amount)
+	} else if amount >= 0.0001 {
+		return fmt.Sprintf("%.6f", amount)
+	}
+	return fmt.Sprintf("%.8f", amount)
+}
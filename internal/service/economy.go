@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"estudocoin/internal/audit"
+	"estudocoin/internal/database"
+	"estudocoin/internal/webhook"
+	"time"
+)
+
+// EconomyService agrupa saldo, transferências, daily e ranking
+type EconomyService struct{}
+
+// Economy é a instância usada pelos comandos e pela API
+var Economy = &EconomyService{}
+
+// Balance retorna o saldo do usuário
+func (EconomyService) Balance(ctx context.Context, userID string) int {
+	return database.GetBalance(userID)
+}
+
+// Transfer envia moedas de um usuário para outro e notifica o destinatário
+func (EconomyService) Transfer(ctx context.Context, fromID, toID string, amount int) error {
+	if amount <= 0 {
+		return newError(ErrInvalidInput, "Amount must be positive")
+	}
+	if fromID == toID {
+		return newError(ErrInvalidInput, "Cannot transfer to yourself")
+	}
+	if err := checkFrozen(fromID, toID); err != nil {
+		return err
+	}
+
+	if err := database.TransferCoinsContext(ctx, fromID, toID, amount); err != nil {
+		return newError(ErrInsufficientFunds, "Insufficient funds or transaction failed")
+	}
+
+	webhook.SendTransferNotification(fromID, toID, amount)
+	audit.Transfer(fromID, toID, amount)
+	return nil
+}
+
+// DailyClaim é o daily coletado, como ficou salvo. Streak e MaxStreak contam
+// dias seguidos, incluindo o de hoje.
+type DailyClaim struct {
+	Reward    int
+	Streak    int
+	MaxStreak int
+	NextDaily time.Time
+	Balance   int
+}
+
+// ClaimDaily coleta o daily do usuário e credita a recompensa.
+// Se já foi coletado, retorna ErrDailyAlreadyClaimed junto com claim (claim.NextDaily diz quando volta).
+func (EconomyService) ClaimDaily(ctx context.Context, userID string) (*DailyClaim, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	info := database.GetDailyStreakInfo(userID)
+	if !info.CanClaim {
+		return &DailyClaim{NextDaily: info.NextDaily}, ErrDailyAlreadyClaimed
+	}
+
+	info, err := database.ClaimDaily(userID)
+	if err != nil {
+		return nil, newError(ErrInternal, "Error claiming daily reward.")
+	}
+
+	// Adiciona as moedas
+	if err := database.AddCoins(userID, info.Reward); err != nil {
+		return nil, newError(ErrInternal, "Error adding coins.")
+	}
+
+	// A streak salva começa em 0 no primeiro dia
+	claim := &DailyClaim{
+		Reward:    info.Reward,
+		Streak:    info.Streak + 1,
+		MaxStreak: info.MaxStreak + 1,
+		NextDaily: info.NextDaily,
+		Balance:   database.GetBalance(userID),
+	}
+	webhook.Dispatch(userID, webhook.EventDailyClaimed, webhook.DailyClaimedData{Reward: claim.Reward, Streak: claim.Streak})
+	return claim, nil
+}
+
+// Leaderboard retorna os usuários mais ricos por patrimônio
+func (EconomyService) Leaderboard(ctx context.Context, limit int) ([]database.UserBalance, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	users, err := database.GetLeaderboard(limit)
+	if err != nil {
+		return nil, newError(ErrInternal, "Could not retrieve leaderboard.")
+	}
+	return users, nil
+}
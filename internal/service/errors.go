@@ -0,0 +1,55 @@
+// Package service concentra as regras de negócio usadas tanto pelos comandos
+// do Discord quanto pelos handlers da API HTTP.
+package service
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Categorias de erro. Use errors.Is para decidir a resposta (ex.: status HTTP).
+var (
+	ErrInvalidInput      = errors.New("invalid input")
+	ErrNotFound          = errors.New("not found")
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	ErrConflict          = errors.New("conflict")
+	ErrForbidden         = errors.New("forbidden")
+	ErrUnavailable       = errors.New("unavailable")
+	ErrInternal          = errors.New("internal error")
+)
+
+// Error é um erro com mensagem para o usuário e uma das categorias acima
+type Error struct {
+	Kind    error
+	Message string
+
+	// format e args permitem traduzir a mensagem (ver i18n.Templated)
+	format string
+	args   []interface{}
+}
+
+func (e *Error) Error() string { return e.Message }
+
+func (e *Error) Unwrap() error { return e.Kind }
+
+// Template retorna o formato e os argumentos da mensagem, para tradução
+func (e *Error) Template() (string, []interface{}) {
+	if e.format == "" {
+		return e.Message, nil
+	}
+	return e.format, e.args
+}
+
+func newError(kind error, format string, args ...interface{}) *Error {
+	return &Error{Kind: kind, Message: fmt.Sprintf(format, args...), format: format, args: args}
+}
+
+// Erros específicos comparáveis com errors.Is
+var (
+	ErrDailyAlreadyClaimed = newError(ErrConflict, "Daily reward already claimed")
+	ErrLoanOfferNotFound   = newError(ErrNotFound, "This loan offer has expired or is invalid!")
+	ErrLoanNotFound        = newError(ErrNotFound, "Loan not found or already paid!")
+	ErrNoActiveLoans       = newError(ErrNotFound, "You don't have any active loans to pay!")
+	ErrAccountFrozen       = newError(ErrForbidden, "Your account is frozen. Contact a moderator.")
+	ErrOtherAccountFrozen  = newError(ErrForbidden, "The other user's account is frozen.")
+)
@@ -0,0 +1,492 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"estudocoin/internal/audit"
+	"estudocoin/internal/database"
+	"estudocoin/internal/webhook"
+	"estudocoin/pkg/config"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// loanDueReminder é a antecedência do aviso de vencimento enviado por webhook
+	loanDueReminder = 24 * time.Hour
+
+	// LoanOfferTimeout é o tempo que o devedor tem para aceitar uma oferta
+	LoanOfferTimeout = 1 * time.Minute
+
+	// loanCollectRetry é a espera antes de tentar de novo uma cobrança que falhou
+	loanCollectRetry = 5 * time.Minute
+)
+
+// OfferOutcome indica como uma oferta de empréstimo foi encerrada
+type OfferOutcome int
+
+const (
+	OfferAccepted OfferOutcome = iota
+	OfferDeclined
+	OfferExpired
+	OfferFailed
+)
+
+// pendingLoan representa uma solicitação de empréstimo pendente
+type pendingLoan struct {
+	Loan      *database.Loan
+	Timeout   *time.Timer
+	MessageID string
+}
+
+// LoanService mantém os empréstimos ativos e as ofertas pendentes em memória
+type LoanService struct {
+	// OnOfferClosed é chamado quando uma oferta com mensagem associada é encerrada
+	// (aceita, recusada, expirada ou com falha), para atualizar a mensagem no Discord
+	OnOfferClosed func(loan *database.Loan, messageID string, outcome OfferOutcome, err error)
+
+	// OnCollected é chamado após a cobrança automática no vencimento
+	OnCollected func(loan *database.Loan, collected int, defaulted bool)
+
+	// loans armazena todos os empréstimos ativos: loanID -> Loan
+	loans   map[string]*database.Loan
+	loansMu sync.RWMutex
+
+	// pending armazena solicitações pendentes: borrowerID -> pendingLoan
+	pending   map[string]*pendingLoan
+	pendingMu sync.Mutex
+
+	// idCounter para gerar IDs únicos
+	idCounter int64
+	idMu      sync.Mutex
+}
+
+// Loans é a instância usada pelos comandos e pela API
+var Loans = &LoanService{
+	loans:   make(map[string]*database.Loan),
+	pending: make(map[string]*pendingLoan),
+}
+
+// generateID gera um ID único para o empréstimo
+func (s *LoanService) generateID() string {
+	s.idMu.Lock()
+	defer s.idMu.Unlock()
+	s.idCounter++
+	return fmt.Sprintf("loan_%d_%d", time.Now().Unix(), s.idCounter)
+}
+
+// Offer valida e registra uma oferta de empréstimo pendente.
+// A oferta expira após LoanOfferTimeout se o devedor não responder.
+func (s *LoanService) Offer(ctx context.Context, lenderID, borrowerID string, amount int, interestRate float64, days int, channelID, guildID string) (*database.Loan, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Não pode emprestar para si mesmo
+	if borrowerID == lenderID {
+		return nil, newError(ErrInvalidInput, "You cannot lend money to yourself!")
+	}
+	if borrowerID == database.BotUserID {
+		return nil, newError(ErrInvalidInput, "You cannot lend money to bots!")
+	}
+	if amount <= 0 {
+		return nil, newError(ErrInvalidInput, "Invalid amount. Must be a positive number.")
+	}
+	if interestRate < 0 || interestRate > 100 {
+		return nil, newError(ErrInvalidInput, "Invalid interest rate. Must be between 0 and 100.")
+	}
+	if days <= 0 || days > 365 {
+		return nil, newError(ErrInvalidInput, "Invalid number of days. Must be between 1 and 365.")
+	}
+	if err := checkFrozen(lenderID, borrowerID); err != nil {
+		return nil, err
+	}
+
+	// Verificar saldo do credor
+	lenderBalance := database.GetBalance(lenderID)
+	if lenderBalance < amount {
+		return nil, newError(ErrInsufficientFunds, "Insufficient balance! You have %d %s", lenderBalance, config.Bot().CurrencySymbol)
+	}
+
+	// Calcular valor total
+	interest := int(float64(amount) * (interestRate / 100))
+
+	// Criar o empréstimo
+	loan := &database.Loan{
+		ID:           s.generateID(),
+		LenderID:     lenderID,
+		BorrowerID:   borrowerID,
+		Amount:       amount,
+		InterestRate: interestRate,
+		DueDate:      time.Now().Add(time.Duration(days) * 24 * time.Hour),
+		TotalOwed:    amount + interest,
+		Paid:         false,
+		CreatedAt:    time.Now(),
+		ChannelID:    channelID,
+		GuildID:      guildID,
+	}
+
+	// Verificar se o usuário já tem uma solicitação pendente
+	s.pendingMu.Lock()
+	if _, exists := s.pending[borrowerID]; exists {
+		s.pendingMu.Unlock()
+		return nil, newError(ErrConflict, "<@%s> already has a pending loan request!", borrowerID)
+	}
+	s.pending[borrowerID] = &pendingLoan{
+		Loan: loan,
+		Timeout: time.AfterFunc(LoanOfferTimeout, func() {
+			s.expire(loan.ID)
+		}),
+	}
+	s.pendingMu.Unlock()
+
+	webhook.NotifyLoan(borrowerID, webhook.EventLoanOffered, loan, 0, false)
+	return loan, nil
+}
+
+// CancelOffer descarta uma oferta pendente sem notificar (ex.: a mensagem não pôde ser enviada)
+func (s *LoanService) CancelOffer(loan *database.Loan) {
+	s.take(loan.BorrowerID, loan.ID)
+}
+
+// SetOfferMessage associa (ou, com messageID vazio, desassocia) a mensagem que exibe a oferta.
+// Sem mensagem associada, OnOfferClosed não é chamado.
+func (s *LoanService) SetOfferMessage(borrowerID, loanID, messageID string) {
+	s.pendingMu.Lock()
+	if req, ok := s.pending[borrowerID]; ok && req.Loan.ID == loanID {
+		req.MessageID = messageID
+	}
+	s.pendingMu.Unlock()
+}
+
+// take remove e retorna a oferta pendente do devedor.
+// loanID vazio aceita qualquer oferta pendente dele.
+func (s *LoanService) take(borrowerID, loanID string) (*pendingLoan, error) {
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+
+	request, exists := s.pending[borrowerID]
+	if !exists || (loanID != "" && request.Loan.ID != loanID) {
+		return nil, ErrLoanOfferNotFound
+	}
+
+	// Cancelar timeout
+	request.Timeout.Stop()
+	delete(s.pending, borrowerID)
+	return request, nil
+}
+
+// closeOffer avisa a camada do Discord que a oferta foi encerrada
+func (s *LoanService) closeOffer(req *pendingLoan, outcome OfferOutcome, err error) {
+	if req.MessageID != "" && s.OnOfferClosed != nil {
+		s.OnOfferClosed(req.Loan, req.MessageID, outcome, err)
+	}
+}
+
+// Accept aceita a oferta pendente do devedor: transfere o valor, salva e agenda a cobrança
+func (s *LoanService) Accept(ctx context.Context, borrowerID, loanID string) (*database.Loan, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	request, err := s.take(borrowerID, loanID)
+	if err != nil {
+		return nil, err
+	}
+	loan := request.Loan
+
+	fail := func(err error) (*database.Loan, error) {
+		s.closeOffer(request, OfferFailed, err)
+		return nil, err
+	}
+
+	// Uma das contas pode ter sido congelada depois da oferta
+	if err := checkFrozen(borrowerID, loan.LenderID); err != nil {
+		return fail(err)
+	}
+
+	// Verificar se o credor ainda tem saldo
+	if database.GetBalance(loan.LenderID) < loan.Amount {
+		return fail(newError(ErrInsufficientFunds, "<@%s> no longer has sufficient balance!", loan.LenderID))
+	}
+
+	// Transferir o dinheiro e salvar o empréstimo na mesma transação
+	if err := database.FundLoan(ctx, loan); err != nil {
+		log.Printf("Error funding loan %s: %v", loan.ID, err)
+		return fail(newError(ErrInternal, "Error processing loan transaction!"))
+	}
+
+	// Adicionar à lista de empréstimos ativos
+	s.loansMu.Lock()
+	s.loans[loan.ID] = loan
+	s.loansMu.Unlock()
+
+	// Agendar cobrança automática
+	s.scheduleAutoCollection(loan)
+
+	webhook.NotifyLoan(loan.LenderID, webhook.EventLoanAccepted, loan, 0, false)
+
+	s.closeOffer(request, OfferAccepted, nil)
+	return loan, nil
+}
+
+// Decline recusa a oferta pendente do devedor
+func (s *LoanService) Decline(ctx context.Context, borrowerID, loanID string) (*database.Loan, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	request, err := s.take(borrowerID, loanID)
+	if err != nil {
+		return nil, err
+	}
+	s.closeOffer(request, OfferDeclined, nil)
+	return request.Loan, nil
+}
+
+// expire expira uma oferta de empréstimo após timeout
+func (s *LoanService) expire(loanID string) {
+	s.pendingMu.Lock()
+	var expired *pendingLoan
+	for uid, req := range s.pending {
+		if req.Loan.ID == loanID {
+			expired = req
+			delete(s.pending, uid)
+			break
+		}
+	}
+	s.pendingMu.Unlock()
+
+	if expired != nil {
+		s.closeOffer(expired, OfferExpired, nil)
+	}
+}
+
+// Pay quita um empréstimo ativo do devedor. loanID vazio paga o mais antigo.
+func (s *LoanService) Pay(ctx context.Context, borrowerID, loanID string) (*database.Loan, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Buscar empréstimos ativos do usuário
+	var userLoans []*database.Loan
+	for _, loan := range s.Active(borrowerID) {
+		if loan.BorrowerID == borrowerID {
+			userLoans = append(userLoans, loan)
+		}
+	}
+
+	if len(userLoans) == 0 {
+		return nil, ErrNoActiveLoans
+	}
+	// Congelar bloqueia transferências, inclusive para pagar o credor
+	if err := checkFrozen(borrowerID); err != nil {
+		return nil, err
+	}
+
+	var loanToPay *database.Loan
+
+	// Se especificou um ID, procurar por ele
+	if loanID != "" {
+		for _, loan := range userLoans {
+			if loan.ID == loanID {
+				loanToPay = loan
+				break
+			}
+		}
+		if loanToPay == nil {
+			return nil, ErrLoanNotFound
+		}
+	} else {
+		// Pega o empréstimo mais antigo (primeiro da lista)
+		loanToPay = userLoans[0]
+	}
+
+	// Tira o empréstimo da lista antes de transferir, para que dois
+	// pagamentos ao mesmo tempo não cobrem duas vezes
+	if !s.claim(loanToPay) {
+		return nil, ErrLoanNotFound
+	}
+
+	// Verificar saldo
+	balance := database.GetBalance(borrowerID)
+	if balance < loanToPay.TotalOwed {
+		s.restore(loanToPay)
+		return nil, newError(ErrInsufficientFunds, "Insufficient balance! You need %d %s but have %d %s.",
+			loanToPay.TotalOwed, config.Bot().CurrencySymbol, balance, config.Bot().CurrencySymbol)
+	}
+
+	// Transferir do devedor para o credor e marcar como pago na mesma
+	// transação, para que um reinício não cobre o empréstimo de novo
+	if err := database.PayLoan(ctx, loanToPay.ID, loanToPay.BorrowerID, loanToPay.LenderID, loanToPay.TotalOwed); err != nil {
+		s.restore(loanToPay)
+		if !errors.Is(err, sql.ErrNoRows) {
+			log.Printf("Error paying loan %s: %v", loanToPay.ID, err)
+		}
+		return nil, newError(ErrInsufficientFunds, "Error processing payment. You need %d %s.", loanToPay.TotalOwed, config.Bot().CurrencySymbol)
+	}
+
+	s.loansMu.Lock()
+	loanToPay.Paid = true
+	s.loansMu.Unlock()
+	return loanToPay, nil
+}
+
+// claim tira um empréstimo ativo da lista. Só quem conseguiu tirá-lo pode
+// cobrá-lo; restore o devolve se a cobrança falhar.
+func (s *LoanService) claim(loan *database.Loan) bool {
+	s.loansMu.Lock()
+	defer s.loansMu.Unlock()
+	if current, ok := s.loans[loan.ID]; !ok || current.Paid {
+		return false
+	}
+	delete(s.loans, loan.ID)
+	return true
+}
+
+// restore devolve à lista um empréstimo cuja cobrança falhou
+func (s *LoanService) restore(loan *database.Loan) {
+	s.loansMu.Lock()
+	s.loans[loan.ID] = loan
+	s.loansMu.Unlock()
+}
+
+// Active retorna os empréstimos ativos em que o usuário é credor ou devedor, do mais antigo ao mais novo
+func (s *LoanService) Active(userID string) []*database.Loan {
+	s.loansMu.RLock()
+	var userLoans []*database.Loan
+	for _, loan := range s.loans {
+		if (loan.BorrowerID == userID || loan.LenderID == userID) && !loan.Paid {
+			userLoans = append(userLoans, loan)
+		}
+	}
+	s.loansMu.RUnlock()
+
+	sort.Slice(userLoans, func(i, j int) bool { return userLoans[i].CreatedAt.Before(userLoans[j].CreatedAt) })
+	return userLoans
+}
+
+// Drop descarta os empréstimos ativos e as ofertas pendentes do usuário sem
+// cobrar nada (usado pelo reset de conta; o banco é atualizado pelo chamador)
+func (s *LoanService) Drop(userID string) {
+	s.loansMu.Lock()
+	for id, loan := range s.loans {
+		if loan.BorrowerID == userID || loan.LenderID == userID {
+			// Paid faz a cobrança agendada ignorar o empréstimo
+			loan.Paid = true
+			delete(s.loans, id)
+		}
+	}
+	s.loansMu.Unlock()
+
+	var dropped []*pendingLoan
+	s.pendingMu.Lock()
+	for borrowerID, req := range s.pending {
+		if req.Loan.BorrowerID == userID || req.Loan.LenderID == userID {
+			req.Timeout.Stop()
+			delete(s.pending, borrowerID)
+			dropped = append(dropped, req)
+		}
+	}
+	s.pendingMu.Unlock()
+
+	for _, req := range dropped {
+		s.closeOffer(req, OfferFailed, newError(ErrConflict, "This offer was cancelled by an admin."))
+	}
+}
+
+// PendingOffers retorna as ofertas pendentes feitas pelo usuário ou para ele
+func (s *LoanService) PendingOffers(userID string) []*database.Loan {
+	s.pendingMu.Lock()
+	var offers []*database.Loan
+	for _, req := range s.pending {
+		if req.Loan.BorrowerID == userID || req.Loan.LenderID == userID {
+			offers = append(offers, req.Loan)
+		}
+	}
+	s.pendingMu.Unlock()
+
+	sort.Slice(offers, func(i, j int) bool { return offers[i].CreatedAt.Before(offers[j].CreatedAt) })
+	return offers
+}
+
+// Load carrega empréstimos ativos do banco ao iniciar e reagenda as cobranças
+func (s *LoanService) Load() error {
+	activeLoans, err := database.GetActiveLoans()
+	if err != nil {
+		return err
+	}
+
+	s.loansMu.Lock()
+	for _, loan := range activeLoans {
+		s.loans[loan.ID] = loan
+		// Reagendar cobrança
+		go s.scheduleAutoCollection(loan)
+	}
+	s.loansMu.Unlock()
+	return nil
+}
+
+// scheduleAutoCollection agenda a cobrança automática no vencimento
+func (s *LoanService) scheduleAutoCollection(loan *database.Loan) {
+	timeUntilDue := loan.DueDate.Sub(time.Now())
+
+	// Avisar o devedor 24h antes do vencimento
+	if timeUntilDue > loanDueReminder {
+		time.AfterFunc(timeUntilDue-loanDueReminder, func() {
+			s.loansMu.RLock()
+			current, exists := s.loans[loan.ID]
+			s.loansMu.RUnlock()
+			if exists && !current.Paid {
+				webhook.NotifyLoan(loan.BorrowerID, webhook.EventLoanDue, loan, 0, false)
+			}
+		})
+	}
+
+	if timeUntilDue <= 0 {
+		// Já venceu, cobrar imediatamente
+		go s.autoCollect(loan)
+		return
+	}
+
+	// Agendar cobrança
+	time.AfterFunc(timeUntilDue, func() {
+		s.autoCollect(loan)
+	})
+}
+
+// autoCollect cobra automaticamente o empréstimo no vencimento
+func (s *LoanService) autoCollect(loan *database.Loan) {
+	// Verificar se ainda existe e não foi pago (nem está sendo pago agora)
+	if !s.claim(loan) {
+		return
+	}
+
+	// Sem saldo suficiente o devedor fica negativo; o credor recebe o que havia
+	collected, err := database.CollectLoan(loan.ID, loan.BorrowerID, loan.LenderID, loan.TotalOwed)
+	if err != nil {
+		log.Printf("Error collecting loan %s, retrying in %s: %v", loan.ID, loanCollectRetry, err)
+		s.restore(loan)
+		time.AfterFunc(loanCollectRetry, func() {
+			s.autoCollect(loan)
+		})
+		return
+	}
+	defaulted := collected < loan.TotalOwed
+
+	s.loansMu.Lock()
+	loan.Paid = true
+	s.loansMu.Unlock()
+
+	webhook.NotifyLoan(loan.BorrowerID, webhook.EventLoanCollected, loan, collected, defaulted)
+	webhook.NotifyLoan(loan.LenderID, webhook.EventLoanCollected, loan, collected, defaulted)
+	audit.LoanCollected(loan, collected, defaulted)
+
+	if s.OnCollected != nil {
+		s.OnCollected(loan, collected, defaulted)
+	}
+}
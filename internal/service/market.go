@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"estudocoin/internal/database"
+	"estudocoin/internal/stockmarket"
+	"estudocoin/internal/webhook"
+	"log"
+	"strings"
+)
+
+// Order é o resultado de uma compra ou venda executada
+type Order struct {
+	Market   string // "stock" ou "crypto"
+	Side     string // "buy" ou "sell"
+	Symbol   string
+	Quantity float64 // ações ou coins
+	Price    float64
+	Amount   int // moedas pagas ou recebidas
+	Balance  int // saldo após a ordem
+}
+
+// Holding é uma posição na carteira do usuário
+type Holding struct {
+	Symbol   string
+	Name     string
+	Type     string // tipo da crypto ("major" ou "meme"); vazio para ações
+	Quantity float64
+	Price    float64
+	Value    int
+}
+
+// Portfolio é a carteira de um mercado
+type Portfolio struct {
+	Holdings   []Holding
+	TotalValue int
+}
+
+// StockQuote é a cotação de uma ação
+type StockQuote struct {
+	Ticker           string
+	Name             string
+	Price            float64
+	ChangeAmount     float64
+	ChangePercentage float64
+}
+
+// MarketService implementa as regras do mercado de ações
+type MarketService struct{}
+
+// Market é a instância usada pelos comandos e pela API
+var Market = &MarketService{}
+
+// Company retorna a empresa do ticker ou nil se não for negociável
+func (MarketService) Company(ticker string) *stockmarket.Company {
+	ticker = strings.ToUpper(ticker)
+	for _, c := range stockmarket.Companies {
+		if c.Ticker == ticker {
+			return &c
+		}
+	}
+	return nil
+}
+
+// Quotes retorna as cotações de todas as empresas.
+// Com live, busca a variação do dia na API externa (mais lento).
+func (m MarketService) Quotes(ctx context.Context, live bool) []StockQuote {
+	quotes := make([]StockQuote, 0, len(stockmarket.Companies))
+	for _, company := range stockmarket.Companies {
+		quote := StockQuote{Ticker: company.Ticker, Name: company.Name}
+		quote.Price, _ = database.GetStockPriceDB(company.Ticker)
+
+		if live || quote.Price <= 0 {
+			data, err := stockmarket.GetStockPriceContext(ctx, company.Ticker)
+			if err == nil {
+				if quote.Price <= 0 {
+					quote.Price = data.Price
+					database.SetStockPriceDB(company.Ticker, data.Price)
+				}
+				quote.ChangeAmount = data.ChangeAmount
+				quote.ChangePercentage = data.ChangePercentage
+			}
+		}
+		quotes = append(quotes, quote)
+	}
+	return quotes
+}
+
+// Quote retorna o preço atual de uma ação, usado para confirmar compras
+func (m MarketService) Quote(ctx context.Context, ticker string) (float64, error) {
+	company := m.Company(ticker)
+	if company == nil {
+		return 0, newError(ErrInvalidInput, "Invalid ticker.")
+	}
+	return m.price(ctx, company.Ticker)
+}
+
+// price retorna o preço em cache ou busca ao vivo se ainda não houver
+func (MarketService) price(ctx context.Context, ticker string) (float64, error) {
+	price, err := database.GetStockPriceDB(ticker)
+	if err == nil && price > 0 {
+		return price, nil
+	}
+
+	data, err := stockmarket.GetStockPriceContext(ctx, ticker)
+	if err != nil {
+		return 0, newError(ErrUnavailable, "Could not fetch stock price. Try again later.")
+	}
+	database.SetStockPriceDB(ticker, data.Price)
+	return data.Price, nil
+}
+
+// Portfolio retorna as ações do usuário avaliadas pelo preço atual
+func (m MarketService) Portfolio(ctx context.Context, userID string) (*Portfolio, error) {
+	investments, err := database.GetAllInvestmentsByUser(userID)
+	if err != nil {
+		return nil, newError(ErrInternal, "Database error.")
+	}
+
+	portfolio := &Portfolio{}
+	totalValue := 0.0
+	for _, inv := range investments {
+		if inv.Shares <= 0 {
+			continue
+		}
+
+		price, _ := m.price(ctx, inv.Ticker)
+		name := ""
+		if c := m.Company(inv.Ticker); c != nil {
+			name = c.Name
+		}
+
+		value := inv.Shares * price
+		totalValue += value
+		portfolio.Holdings = append(portfolio.Holdings, Holding{
+			Symbol:   inv.Ticker,
+			Name:     name,
+			Quantity: inv.Shares,
+			Price:    price,
+			Value:    int(value),
+		})
+	}
+	portfolio.TotalValue = int(totalValue)
+	return portfolio, nil
+}
+
+// Buy compra ações gastando amount moedas
+func (m MarketService) Buy(ctx context.Context, userID, ticker string, amount int) (*Order, error) {
+	company := m.Company(ticker)
+	if company == nil {
+		return nil, newError(ErrInvalidInput, "Invalid ticker.")
+	}
+	if err := checkFrozen(userID); err != nil {
+		return nil, err
+	}
+	if amount <= 0 {
+		return nil, newError(ErrInvalidInput, "Amount must be positive.")
+	}
+	if database.GetBalance(userID) < amount {
+		return nil, newError(ErrInsufficientFunds, "Insufficient funds.")
+	}
+
+	price, err := m.price(ctx, company.Ticker)
+	if err != nil {
+		return nil, err
+	}
+
+	shares := float64(amount) / price
+	if err := database.BuyStock(ctx, userID, company.Ticker, amount, shares); err != nil {
+		return nil, tradeError(err)
+	}
+
+	return completeOrder(userID, "stock", "buy", company.Ticker, shares, price, amount), nil
+}
+
+// Sell vende a quantidade de ações informada
+func (m MarketService) Sell(ctx context.Context, userID, ticker string, shares float64) (*Order, error) {
+	return m.sell(ctx, userID, ticker, shares, false)
+}
+
+// SellAll vende todas as ações do ticker
+func (m MarketService) SellAll(ctx context.Context, userID, ticker string) (*Order, error) {
+	return m.sell(ctx, userID, ticker, 0, true)
+}
+
+func (m MarketService) sell(ctx context.Context, userID, ticker string, shares float64, all bool) (*Order, error) {
+	company := m.Company(ticker)
+	if company == nil {
+		return nil, newError(ErrInvalidInput, "Invalid ticker.")
+	}
+	if err := checkFrozen(userID); err != nil {
+		return nil, err
+	}
+
+	owned, err := database.GetInvestment(userID, company.Ticker)
+	if err != nil {
+		return nil, newError(ErrInternal, "Database error.")
+	}
+	if owned <= 0 {
+		return nil, newError(ErrInvalidInput, "You don't own any shares of this company.")
+	}
+
+	if all {
+		shares = owned
+	}
+	if shares <= 0 {
+		return nil, newError(ErrInvalidInput, "Invalid number of shares.")
+	}
+	if shares > owned {
+		return nil, newError(ErrInvalidInput, "You only own %.4f shares.", owned)
+	}
+
+	price, err := m.price(ctx, company.Ticker)
+	if err != nil {
+		return nil, err
+	}
+
+	payout := int(shares * price)
+	if err := database.SellStock(ctx, userID, company.Ticker, shares, payout); err != nil {
+		return nil, tradeError(err)
+	}
+
+	return completeOrder(userID, "stock", "sell", company.Ticker, shares, price, payout), nil
+}
+
+// completeOrder notifica o webhook e monta o resultado da ordem
+func completeOrder(userID, market, side, symbol string, quantity, price float64, amount int) *Order {
+	webhook.Dispatch(userID, webhook.EventOrderFilled, webhook.OrderFilledData{
+		Market:   market,
+		Side:     side,
+		Symbol:   symbol,
+		Quantity: quantity,
+		Price:    price,
+		Amount:   amount,
+	})
+
+	return &Order{
+		Market:   market,
+		Side:     side,
+		Symbol:   symbol,
+		Quantity: quantity,
+		Price:    price,
+		Amount:   amount,
+		Balance:  database.GetBalance(userID),
+	}
+}
+
+// tradeError converte os erros de transação do banco em erros de serviço
+func tradeError(err error) error {
+	switch {
+	case errors.Is(err, database.ErrInsufficientBalance):
+		return newError(ErrInsufficientFunds, "Insufficient funds.")
+	case errors.Is(err, database.ErrInsufficientHoldings):
+		return newError(ErrInvalidInput, "You don't have that many to sell.")
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return err
+	default:
+		log.Printf("Trade transaction failed: %v", err)
+		return newError(ErrInternal, "Transaction failed.")
+	}
+}
@@ -1,6 +1,7 @@
 package stockmarket
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,11 +11,21 @@ import (
 const BaseURL = "https://stockprices.dev/api/stocks/"
 
 func GetStockPrice(ticker string) (*StockResponse, error) {
+	return GetStockPriceContext(context.Background(), ticker)
+}
+
+// GetStockPriceContext fetches the live price, aborting when ctx is cancelled
+func GetStockPriceContext(ctx context.Context, ticker string) (*StockResponse, error) {
 	client := http.Client{
 		Timeout: 5 * time.Second,
 	}
 
-	resp, err := client.Get(BaseURL + ticker)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, BaseURL+ticker, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
 	}
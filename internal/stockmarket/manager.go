@@ -3,6 +3,7 @@ package stockmarket
 import (
 	"encoding/json"
 	"estudocoin/internal/database"
+	"estudocoin/internal/webhook"
 	"estudocoin/pkg/config"
 	"log"
 	"os"
@@ -74,7 +75,7 @@ func checkMarket(s *discordgo.Session) {
 			// Calculate real price difference
 			realDiff := data.Price - oldPrice
 			// Apply multiplier only to the profit/loss
-			adjustedDiff := config.Economy.GetAdjustedStockPrice(realDiff)
+			adjustedDiff := config.Economy().GetAdjustedStockPrice(realDiff)
 
 			// Distribute rewards
 			investments, err := database.GetAllInvestmentsByTicker(company.Ticker)
@@ -90,7 +91,13 @@ func checkMarket(s *discordgo.Session) {
 					err := database.AddCoins(inv.UserID, payout)
 					if err != nil {
 						log.Printf("Failed to pay dividends to %s: %v", inv.UserID, err)
+						continue
 					}
+					webhook.Dispatch(inv.UserID, webhook.EventStockDividend, webhook.StockDividendData{
+						Ticker: company.Ticker,
+						Shares: inv.Shares,
+						Amount: payout,
+					})
 				}
 			}
 			log.Printf("Distributed dividends for %s (Real Growth: $%.2f, Adjusted: %.2f)", company.Ticker, realDiff, adjustedDiff)
@@ -0,0 +1,91 @@
+package webhook
+
+import "time"
+
+// Event names sent in the "event" field and the X-Webhook-Event header
+const (
+	EventTest             = "test"
+	EventTransferReceived = "transfer_received"
+	EventOrderFilled      = "order_filled"
+	EventDailyClaimed     = "daily_claimed"
+	EventVoiceReward      = "voice_reward"
+	EventGameWon          = "game_won"
+	EventGameLost         = "game_lost"
+	EventLoanOffered      = "loan_offered"
+	EventLoanAccepted     = "loan_accepted"
+	EventLoanDue          = "loan_due"
+	EventLoanCollected    = "loan_collected"
+	EventStockDividend    = "stock_dividend"
+)
+
+// Payload is the envelope shared by every webhook delivery.
+// Data holds one of the typed *Data structs below, depending on Event.
+type Payload struct {
+	ID        string      `json:"id"`
+	Event     string      `json:"event"`
+	UserID    string      `json:"user_id"`
+	Timestamp time.Time   `json:"timestamp"`
+	Data      interface{} `json:"data"`
+}
+
+// TestData is sent by /webhook test
+type TestData struct {
+	Message string `json:"message"`
+}
+
+// TransferReceivedData is sent to the recipient of a transfer
+type TransferReceivedData struct {
+	FromID string `json:"from_id"`
+	ToID   string `json:"to_id"`
+	Amount int    `json:"amount"`
+}
+
+// OrderFilledData is sent when a stock or crypto order executes
+type OrderFilledData struct {
+	Market   string  `json:"market"` // "stock" or "crypto"
+	Side     string  `json:"side"`   // "buy" or "sell"
+	Symbol   string  `json:"symbol"`
+	Quantity float64 `json:"quantity"`
+	Price    float64 `json:"price"`
+	Amount   int     `json:"amount"`
+}
+
+// DailyClaimedData is sent when the user claims the daily reward
+type DailyClaimedData struct {
+	Reward int `json:"reward"`
+	Streak int `json:"streak"`
+}
+
+// VoiceRewardData is sent when voice activity coins are paid
+type VoiceRewardData struct {
+	Minutes int `json:"minutes"`
+	Reward  int `json:"reward"`
+}
+
+// GameResultData is sent for game_won and game_lost
+type GameResultData struct {
+	Game   string `json:"game"`
+	Stake  int    `json:"stake"`
+	Payout int    `json:"payout"`
+	Profit int    `json:"profit"`
+}
+
+// LoanData is sent for every loan_* event
+type LoanData struct {
+	LoanID       string    `json:"loan_id"`
+	LenderID     string    `json:"lender_id"`
+	BorrowerID   string    `json:"borrower_id"`
+	Amount       int       `json:"amount"`
+	InterestRate float64   `json:"interest_rate"`
+	TotalOwed    int       `json:"total_owed"`
+	DueDate      time.Time `json:"due_date"`
+	Collected    int       `json:"collected,omitempty"` // loan_collected only
+	Defaulted    bool      `json:"defaulted,omitempty"` // loan_collected only
+}
+
+// StockDividendData is sent when a dividend is paid on a holding
+type StockDividendData struct {
+	Ticker string  `json:"ticker"`
+	Shares float64 `json:"shares"`
+	Amount int     `json:"amount"`
+}
@@ -0,0 +1,170 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"estudocoin/pkg/config"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ErrBlockedDestination is returned when a webhook URL resolves to an address
+// the bot must not reach (loopback, private networks, cloud metadata...)
+var ErrBlockedDestination = errors.New("webhook destination is not allowed")
+
+// blockedNetworks are ranges not covered by the net.IP helpers below
+var blockedNetworks = mustParseCIDRs(
+	"0.0.0.0/8",       // "this" network
+	"100.64.0.0/10",   // carrier-grade NAT
+	"192.0.0.0/24",    // IETF protocol assignments
+	"192.0.2.0/24",    // TEST-NET-1
+	"198.18.0.0/15",   // benchmarking
+	"198.51.100.0/24", // TEST-NET-2
+	"203.0.113.0/24",  // TEST-NET-3
+	"240.0.0.0/4",     // reserved (includes broadcast)
+	"64:ff9b::/96",    // NAT64
+	"64:ff9b:1::/48",  // local-use NAT64
+	"100::/64",        // discard-only
+	"2001::/23",       // IETF protocol assignments
+	"2001:db8::/32",   // documentation
+	"2002::/16",       // 6to4 (can embed private IPv4)
+)
+
+var dialer = &net.Dialer{
+	Timeout:   5 * time.Second,
+	KeepAlive: 30 * time.Second,
+}
+
+// newClient builds the HTTP client used for deliveries: every connection goes
+// through safeDialContext and redirects are never followed.
+func newClient() *http.Client {
+	return &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			Proxy:                 nil,
+			DialContext:           safeDialContext,
+			TLSHandshakeTimeout:   5 * time.Second,
+			ResponseHeaderTimeout: 10 * time.Second,
+			MaxIdleConns:          10,
+			IdleConnTimeout:       30 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// ValidateURL checks the scheme and host of a webhook URL, refusing hosts
+// written as a blocked IP. Hostnames are not resolved here: safeDialContext
+// checks every address on each connection, which also covers DNS changes
+// after the URL was saved.
+func ValidateURL(raw string) error {
+	parsed, err := url.ParseRequestURI(raw)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return fmt.Errorf("invalid URL, must start with http:// or https://")
+	}
+	if parsed.User != nil {
+		return fmt.Errorf("URLs with credentials are not allowed")
+	}
+
+	host := parsed.Hostname()
+	if host == "" {
+		return fmt.Errorf("invalid URL, missing host")
+	}
+	if ip := net.ParseIP(host); ip != nil && !isIPAllowed(ip) {
+		return ErrBlockedDestination
+	}
+	return nil
+}
+
+// safeDialContext resolves the host itself and only connects to allowed
+// addresses, defeating DNS rebinding between validation and delivery.
+func safeDialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	allowHost := isHostAllowlisted(host)
+	ips, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastErr error = ErrBlockedDestination
+	for _, ip := range ips {
+		if !allowHost && !isIPAllowed(ip.IP) {
+			continue
+		}
+		conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(ip.IP.String(), port))
+		if err == nil {
+			return conn, nil
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// isIPAllowed reports whether the bot may connect to ip
+func isIPAllowed(ip net.IP) bool {
+	if ipAllowlisted(ip) {
+		return true
+	}
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, n := range blockedNetworks {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// isHostAllowlisted reports whether host matches a hostname entry in webhook_allowlist
+func isHostAllowlisted(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, entry := range config.Bot().WebhookAllowlist {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry != "" && entry == host {
+			return true
+		}
+	}
+	return false
+}
+
+// ipAllowlisted reports whether ip matches an IP or CIDR entry in webhook_allowlist
+func ipAllowlisted(ip net.IP) bool {
+	for _, entry := range config.Bot().WebhookAllowlist {
+		entry = strings.TrimSpace(entry)
+		if strings.Contains(entry, "/") {
+			if _, n, err := net.ParseCIDR(entry); err == nil && n.Contains(ip) {
+				return true
+			}
+		} else if allowed := net.ParseIP(entry); allowed != nil && allowed.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, c := range cidrs {
+		_, n, err := net.ParseCIDR(c)
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, n)
+	}
+	return nets
+}
@@ -2,60 +2,296 @@ package webhook
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"estudocoin/internal/database"
+	"fmt"
+	"io"
 	"log"
 	"net/http"
+	"strconv"
 	"time"
+
+	"github.com/google/uuid"
+)
+
+// Headers sent with every delivery
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	TimestampHeader = "X-Webhook-Timestamp"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+)
+
+const (
+	MaxAttempts    = 8
+	BaseRetryDelay = 30 * time.Second
+	MaxRetryDelay  = 1 * time.Hour
+
+	pollInterval = 10 * time.Second
+	batchSize    = 50
+)
+
+var (
+	client = newClient()
+	wake   = make(chan struct{}, 1)
 )
 
-type Payload struct {
-	Event     string    `json:"event"`
-	FromID    string    `json:"from_id"`
-	ToID      string    `json:"to_id"`
-	Amount    int       `json:"amount"`
-	Timestamp time.Time `json:"timestamp"`
+// Start launches the outbox worker that delivers and retries pending webhooks
+func Start() {
+	go deliveryLoop()
+	log.Println("Webhook delivery worker started")
 }
 
-func SendTransferNotification(fromID, toID string, amount int) {
-	// Look up webhook URL for the recipient
-	url, err := database.GetWebhook(toID)
+// Dispatch queues an event for the user's webhook, if one is configured and
+// the user is subscribed to it. The delivery is persisted first so it
+// survives restarts and failed attempts.
+func Dispatch(userID, event string, data interface{}) {
+	url, secret, err := database.GetWebhookConfig(userID)
 	if err != nil || url == "" {
 		return // No webhook configured
 	}
+	if !isSubscribed(userID, event) {
+		return
+	}
+	if secret == "" {
+		if _, err := EnsureSecret(userID); err != nil {
+			log.Printf("Failed to create webhook secret for user %s: %v", userID, err)
+			return
+		}
+	}
+
+	delivery, err := newDelivery(userID, url, event, data)
+	if err != nil {
+		log.Printf("Failed to encode webhook %s for user %s: %v", event, userID, err)
+		return
+	}
+
+	if err := database.EnqueueWebhookDelivery(delivery); err != nil {
+		log.Printf("Failed to queue webhook %s for user %s: %v", event, userID, err)
+		return
+	}
+
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
+
+// SendTransferNotification notifies the recipient of a transfer
+func SendTransferNotification(fromID, toID string, amount int) {
+	Dispatch(toID, EventTransferReceived, TransferReceivedData{
+		FromID: fromID,
+		ToID:   toID,
+		Amount: amount,
+	})
+}
+
+// SendTestWebhook delivers a test event immediately and records the attempt.
+// It returns the HTTP status code received (0 if the request never completed).
+func SendTestWebhook(userID string) (int, error) {
+	url, _, err := database.GetWebhookConfig(userID)
+	if err != nil || url == "" {
+		return 0, fmt.Errorf("no webhook configured")
+	}
+	secret, err := EnsureSecret(userID)
+	if err != nil {
+		return 0, err
+	}
+
+	delivery, err := newDelivery(userID, url, EventTest, TestData{Message: "Webhook test from Pousadinha-Chan"})
+	if err != nil {
+		return 0, err
+	}
+
+	statusCode, sendErr := send(delivery, secret)
+	delivery.Attempts = 1
+	delivery.LastStatusCode = statusCode
+	delivery.UpdatedAt = time.Now().UTC()
+	if sendErr != nil {
+		delivery.Status = database.WebhookStatusFailed
+		delivery.LastError = sendErr.Error()
+	} else {
+		delivery.Status = database.WebhookStatusDelivered
+	}
+
+	if err := database.EnqueueWebhookDelivery(delivery); err != nil {
+		log.Printf("Failed to record test webhook for user %s: %v", userID, err)
+	}
+	return statusCode, sendErr
+}
+
+// EnsureSecret returns the user's signing secret, creating one if missing
+func EnsureSecret(userID string) (string, error) {
+	_, secret, err := database.GetWebhookConfig(userID)
+	if err != nil {
+		return "", err
+	}
+	if secret != "" {
+		return secret, nil
+	}
+	return RotateSecret(userID)
+}
+
+// RotateSecret generates and stores a new signing secret for the user
+func RotateSecret(userID string) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	secret := "whsec_" + hex.EncodeToString(b)
+	if err := database.SetWebhookSecret(userID, secret); err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+// Sign returns the hex HMAC-SHA256 of "<timestamp>.<body>" using the secret
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
 
+func newDelivery(userID, url, event string, data interface{}) (*database.WebhookDelivery, error) {
+	now := time.Now().UTC()
 	payload := Payload{
-		Event:     "transfer_received",
-		FromID:    fromID,
-		ToID:      toID,
-		Amount:    amount,
-		Timestamp: time.Now(),
-	}
-
-	// Send asynchronously
-	go func(targetURL string, p Payload) {
-		jsonBytes, _ := json.Marshal(p)
-		
-		client := http.Client{
-			Timeout: 5 * time.Second,
+		ID:        uuid.New().String(),
+		Event:     event,
+		UserID:    userID,
+		Timestamp: now,
+		Data:      data,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &database.WebhookDelivery{
+		ID:            payload.ID,
+		UserID:        userID,
+		Event:         event,
+		URL:           url,
+		Payload:       string(body),
+		Status:        database.WebhookStatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+func deliveryLoop() {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		processDue()
+		select {
+		case <-ticker.C:
+		case <-wake:
 		}
+	}
+}
 
-		resp, err := client.Post(targetURL, "application/json", bytes.NewBuffer(jsonBytes))
-		if err != nil {
-			log.Printf("Failed to trigger webhook for user %s: %v", toID, err)
-			return
+func processDue() {
+	deliveries, err := database.GetDueWebhookDeliveries(time.Now().UTC(), batchSize)
+	if err != nil {
+		log.Printf("Error loading pending webhooks: %v", err)
+		return
+	}
+
+	for _, d := range deliveries {
+		attempt(d)
+	}
+}
+
+func attempt(d *database.WebhookDelivery) {
+	// Always deliver to the current URL and secret: the user may have
+	// changed or removed the webhook since the event was queued
+	url, secret, err := database.GetWebhookConfig(d.UserID)
+	if err != nil {
+		log.Printf("Error loading webhook config for user %s: %v", d.UserID, err)
+		return
+	}
+	if url == "" {
+		d.Status = database.WebhookStatusFailed
+		d.LastError = "webhook removed"
+		d.UpdatedAt = time.Now().UTC()
+		if err := database.UpdateWebhookDelivery(d); err != nil {
+			log.Printf("Error updating webhook delivery %s: %v", d.ID, err)
 		}
-		defer resp.Body.Close()
-	}(url, payload)
+		return
+	}
+	d.URL = url
+
+	statusCode, sendErr := send(d, secret)
+	d.Attempts++
+	d.LastStatusCode = statusCode
+	d.UpdatedAt = time.Now().UTC()
+
+	if sendErr == nil {
+		d.Status = database.WebhookStatusDelivered
+		d.LastError = ""
+	} else {
+		d.LastError = sendErr.Error()
+		if d.Attempts >= MaxAttempts {
+			d.Status = database.WebhookStatusFailed
+			log.Printf("Webhook %s for user %s failed permanently: %v", d.ID, d.UserID, sendErr)
+		} else {
+			d.NextAttemptAt = d.UpdatedAt.Add(retryDelay(d.Attempts))
+		}
+	}
+
+	if err := database.UpdateWebhookDelivery(d); err != nil {
+		log.Printf("Error updating webhook delivery %s: %v", d.ID, err)
+	}
 }
 
-func TestWebhook(url string) error {
-	payload := Payload{
-		Event:     "test",
-		Timestamp: time.Now(),
+// retryDelay doubles the wait after each failed attempt, capped at MaxRetryDelay
+func retryDelay(attempts int) time.Duration {
+	delay := BaseRetryDelay << (attempts - 1)
+	if delay <= 0 || delay > MaxRetryDelay {
+		return MaxRetryDelay
+	}
+	return delay
+}
+
+func send(d *database.WebhookDelivery, secret string) (int, error) {
+	if err := ValidateURL(d.URL); err != nil {
+		return 0, err
+	}
+
+	body := []byte(d.Payload)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequest(http.MethodPost, d.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Pousadinha-Chan-Webhook/1.0")
+	req.Header.Set(EventHeader, d.Event)
+	req.Header.Set(DeliveryHeader, d.ID)
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, "sha256="+Sign(secret, timestamp, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode >= 300 && resp.StatusCode < 400 {
+		return resp.StatusCode, fmt.Errorf("redirects are not followed (status %d)", resp.StatusCode)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
 	}
-	jsonBytes, _ := json.Marshal(payload)
-	client := http.Client{Timeout: 5 * time.Second}
-	_, err := client.Post(url, "application/json", bytes.NewBuffer(jsonBytes))
-	return err
+	return resp.StatusCode, nil
 }
@@ -0,0 +1,147 @@
+package webhook
+
+import (
+	"estudocoin/internal/database"
+	"log"
+)
+
+// EventInfo describes an event users can subscribe to
+type EventInfo struct {
+	Name        string
+	Description string
+}
+
+// Events lists every subscribable event, in display order
+var Events = []EventInfo{
+	{EventTransferReceived, "Coins received from another user"},
+	{EventOrderFilled, "Stock or crypto order executed"},
+	{EventDailyClaimed, "Daily reward claimed"},
+	{EventVoiceReward, "Voice activity reward paid"},
+	{EventGameWon, "Game won (above your threshold)"},
+	{EventGameLost, "Game lost (above your threshold)"},
+	{EventLoanOffered, "Someone offered you a loan"},
+	{EventLoanAccepted, "Your loan offer was accepted"},
+	{EventLoanDue, "A loan is due within 24 hours"},
+	{EventLoanCollected, "A loan was auto-collected at its due date"},
+	{EventStockDividend, "Stock dividend paid"},
+}
+
+// DefaultEvents are delivered to users who never changed their subscriptions
+var DefaultEvents = []string{EventTransferReceived, EventOrderFilled}
+
+// IsValidEvent reports whether name is a subscribable event
+func IsValidEvent(name string) bool {
+	for _, e := range Events {
+		if e.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Subscriptions returns the events the user currently receives and their game threshold
+func Subscriptions(userID string) ([]string, int, error) {
+	events, custom, threshold, err := database.GetWebhookSubscriptions(userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !custom {
+		events = append([]string(nil), DefaultEvents...)
+	}
+	return events, threshold, nil
+}
+
+// Subscribe adds an event to the user's subscriptions
+func Subscribe(userID, event string) error {
+	events, _, err := Subscriptions(userID)
+	if err != nil {
+		return err
+	}
+	for _, e := range events {
+		if e == event {
+			return nil
+		}
+	}
+	return database.SetWebhookSubscriptions(userID, append(events, event))
+}
+
+// Unsubscribe removes an event from the user's subscriptions
+func Unsubscribe(userID, event string) error {
+	events, _, err := Subscriptions(userID)
+	if err != nil {
+		return err
+	}
+	kept := make([]string, 0, len(events))
+	for _, e := range events {
+		if e != event {
+			kept = append(kept, e)
+		}
+	}
+	return database.SetWebhookSubscriptions(userID, kept)
+}
+
+// isSubscribed reports whether the user wants to receive the event
+func isSubscribed(userID, event string) bool {
+	if event == EventTest {
+		return true
+	}
+	events, _, err := Subscriptions(userID)
+	if err != nil {
+		log.Printf("Error loading webhook subscriptions for user %s: %v", userID, err)
+		return false
+	}
+	for _, e := range events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// NotifyGameResult sends game_won or game_lost when the profit or loss reaches
+// the user's threshold. Pushes (payout == stake) are never sent.
+func NotifyGameResult(userID, game string, stake, payout int) {
+	profit := payout - stake
+	if profit == 0 {
+		return
+	}
+
+	event := EventGameWon
+	if profit < 0 {
+		event = EventGameLost
+	}
+
+	_, threshold, err := Subscriptions(userID)
+	if err != nil {
+		return
+	}
+	abs := profit
+	if abs < 0 {
+		abs = -abs
+	}
+	if abs < threshold {
+		return
+	}
+
+	Dispatch(userID, event, GameResultData{
+		Game:   game,
+		Stake:  stake,
+		Payout: payout,
+		Profit: profit,
+	})
+}
+
+// NotifyLoan sends a loan_* event to the given user
+func NotifyLoan(userID, event string, loan *database.Loan, collected int, defaulted bool) {
+	Dispatch(userID, event, LoanData{
+		LoanID:       loan.ID,
+		LenderID:     loan.LenderID,
+		BorrowerID:   loan.BorrowerID,
+		Amount:       loan.Amount,
+		InterestRate: loan.InterestRate,
+		TotalOwed:    loan.TotalOwed,
+		DueDate:      loan.DueDate,
+		Collected:    collected,
+		Defaulted:    defaulted,
+	})
+}
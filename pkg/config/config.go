@@ -7,18 +7,143 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync/atomic"
 )
 
 type EconomyConfig struct {
-	DailyAmount             int     `json:"daily_amount"`
-	VoiceCoinsPerMinute     int     `json:"voice_coins_per_minute"`
-	CostNicknameSelf        int     `json:"cost_nickname_self"`
-	CostNicknameOther       int     `json:"cost_nickname_other"`
-	CostPerMinutePunishment int     `json:"cost_per_minute_punishment"`
-	CostPerMinuteMute       int     `json:"cost_per_minute_mute"`
-	StockPriceMultiplier    float64 `json:"stock_price_multiplier"`
-	RouletteEnabled         bool    `json:"roulette_enabled"`
-	RouletteIntervalMinutes int     `json:"roulette_interval_minutes"`
+	DailyAmount             int             `json:"daily_amount"`
+	VoiceCoinsPerMinute     int             `json:"voice_coins_per_minute"`
+	CostNicknameSelf        int             `json:"cost_nickname_self"`
+	CostNicknameOther       int             `json:"cost_nickname_other"`
+	CostPerMinutePunishment int             `json:"cost_per_minute_punishment"`
+	CostPerMinuteMute       int             `json:"cost_per_minute_mute"`
+	StockPriceMultiplier    float64         `json:"stock_price_multiplier"`
+	RouletteEnabled         bool            `json:"roulette_enabled"`
+	RouletteIntervalMinutes int             `json:"roulette_interval_minutes"`
+	Cooldowns               CooldownConfig  `json:"cooldowns"`
+	Sessions                SessionConfig   `json:"sessions"`
+	Blackjack               BlackjackConfig `json:"blackjack"`
+	Poker                   PokerConfig     `json:"poker"`
+	Lottery                 LotteryConfig   `json:"lottery"`
+	Duels                   DuelConfig      `json:"duels"`
+	Slots                   SlotsConfig     `json:"slots"`
+	Limits                  LimitsConfig    `json:"limits"`
+}
+
+// LimitsConfig controla os limites de jogo responsável (/limits)
+type LimitsConfig struct {
+	// CoolingOffHours é quanto tempo um limite afrouxado leva para valer (padrão 24)
+	CoolingOffHours int `json:"cooling_off_hours"`
+}
+
+// SlotsConfig controla o prêmio acumulado (progressivo) do caça-níquel
+type SlotsConfig struct {
+	// JackpotPercent é a parte de cada aposta que alimenta o prêmio acumulado
+	JackpotPercent float64 `json:"jackpot_percent"`
+	// JackpotSeed é o valor com que o pote recomeça depois de sair, pago pelo bot
+	JackpotSeed int `json:"jackpot_seed"`
+}
+
+// DuelConfig controla os duelos de cara ou coroa e de dados entre usuários
+type DuelConfig struct {
+	MinBet int `json:"min_bet"` // padrão 10
+	// HouseCutPercent é a parte do pote que vai para o bot (0 = o vencedor leva tudo)
+	HouseCutPercent float64 `json:"house_cut_percent"`
+}
+
+// LotteryConfig controla a loteria da comunidade. Cada bilhete recebe um número
+// de 1 a Numbers; no sorteio, quem tem o número sorteado divide o prêmio e, se
+// ninguém tiver, o prêmio acumula para o próximo. Zero usa o padrão indicado.
+type LotteryConfig struct {
+	Enabled     bool `json:"enabled"`
+	TicketPrice int  `json:"ticket_price"` // padrão 100
+	Numbers     int  `json:"numbers"`      // números possíveis em um bilhete (padrão 100)
+	// HouseCutPercent é a parte de cada bilhete que vai para o bot em vez do prêmio
+	HouseCutPercent float64 `json:"house_cut_percent"`
+	DrawHours       int     `json:"draw_hours"`  // intervalo entre os sorteios (padrão 24)
+	MaxTickets      int     `json:"max_tickets"` // bilhetes por usuário em cada sorteio (padrão 100)
+}
+
+// PokerConfig são as regras das mesas de Texas Hold'em. As fichas saem do saldo
+// na entrada e voltam quando o jogador levanta. Zero usa o padrão indicado.
+type PokerConfig struct {
+	SmallBlind int `json:"small_blind"` // padrão 10
+	BigBlind   int `json:"big_blind"`   // padrão 2x o small blind
+	MinBuyIn   int `json:"min_buy_in"`  // padrão 20 big blinds
+	MaxBuyIn   int `json:"max_buy_in"`  // padrão 100 big blinds
+	MaxSeats   int `json:"max_seats"`   // jogadores por mesa (padrão 6, máximo 9)
+	// RakePercent é a porcentagem de cada pote que vai para o bot, só nas mãos
+	// que chegam ao flop (0 = sem rake). RakeCap limita o rake de uma mão (0 = sem teto).
+	RakePercent float64 `json:"rake_percent"`
+	RakeCap     int     `json:"rake_cap"`
+	TurnSeconds int     `json:"turn_seconds"` // tempo de cada jogada antes de passar ou desistir sozinho (padrão 45)
+}
+
+// BlackjackConfig são as regras do blackjack, da mesa e do jogo solo. O sapato
+// (vários baralhos embaralhados juntos) é de cada canal e só é refeito quando a
+// carta de corte sai. Zero usa o padrão indicado.
+type BlackjackConfig struct {
+	Decks       int     `json:"decks"`       // baralhos no sapato (padrão 6)
+	Penetration float64 `json:"penetration"` // fração do sapato jogada antes da carta de corte (padrão 0.75)
+	// DealerHitsSoft17 faz o dealer pedir carta com 17 "soft" (ás valendo 11, como A+6)
+	DealerHitsSoft17 bool `json:"dealer_hits_soft_17"`
+	// BlackjackPayout é o prêmio de um blackjack por moeda apostada (padrão 1.5, ou seja, 3:2)
+	BlackjackPayout float64 `json:"blackjack_payout"`
+	MaxSeats        int     `json:"max_seats"`  // jogadores por mesa (padrão 5)
+	MaxSplits       int     `json:"max_splits"` // divisões por jogador (padrão 3, até 4 mãos)
+	ResplitAces     bool    `json:"resplit_aces"`
+	// HitSplitAces deixa pedir carta nos ases divididos; sem isso cada um recebe uma carta só
+	HitSplitAces   bool `json:"hit_split_aces"`
+	Surrender      bool `json:"surrender"`       // desistir nas duas primeiras cartas devolve metade da aposta
+	BettingSeconds int  `json:"betting_seconds"` // tempo para sentar antes de distribuir (padrão 20)
+	TurnSeconds    int  `json:"turn_seconds"`    // tempo de cada jogada antes de parar sozinho (padrão 60)
+}
+
+// SessionConfig limita os jogos simultâneos (aviator, copos, blackjack, slots e
+// roleta russa). Zero usa o padrão indicado.
+type SessionConfig struct {
+	MaxGames      int `json:"max_games"`       // jogos rodando no bot inteiro (padrão 50)
+	MaxPerUser    int `json:"max_per_user"`    // jogos de um mesmo usuário ao mesmo tempo (padrão 1)
+	MaxPerChannel int `json:"max_per_channel"` // jogos rodando num mesmo canal (padrão 5)
+	// MaxMinutes libera a vaga de um jogo abandonado depois desse tempo (padrão 15)
+	MaxMinutes int `json:"max_minutes"`
+}
+
+// CooldownConfig limita a frequência de comandos de cada usuário (anti-spam).
+// Admins não passam pelos cooldowns.
+type CooldownConfig struct {
+	// Commands mapeia o caminho do comando ("balance", "bet slots", "stock buy")
+	// para os segundos de espera entre dois usos. Um grupo ("bet") vale para
+	// todos os subcomandos que não têm entrada própria.
+	Commands map[string]float64 `json:"commands"`
+	// RateLimit é o máximo de comandos de um usuário a cada RateWindowSeconds (0 = sem limite)
+	RateLimit         int `json:"rate_limit"`
+	RateWindowSeconds int `json:"rate_window_seconds"`
+}
+
+// PermissionsConfig lista os cargos e usuários com acesso aos comandos administrativos.
+// Membros com Administrator ou Manage Guild no Discord já são admins.
+type PermissionsConfig struct {
+	AdminRoles     []string `json:"admin_roles"`
+	AdminUsers     []string `json:"admin_users"`
+	ModeratorRoles []string `json:"moderator_roles"`
+	ModeratorUsers []string `json:"moderator_users"`
+}
+
+// AuditConfig controla o canal onde o bot publica os eventos da economia.
+// Cada categoria é ligada separadamente; sem ChannelID nada é publicado.
+type AuditConfig struct {
+	ChannelID string `json:"channel_id"`
+	// TransferThreshold é o valor mínimo de uma transferência para ser publicada
+	TransferThreshold int `json:"transfer_threshold"`
+	// JackpotThreshold é o lucro mínimo (prêmio - aposta) de um jogo para ser publicado
+	JackpotThreshold int `json:"jackpot_threshold"`
+
+	Transfers bool `json:"transfers"`
+	Jackpots  bool `json:"jackpots"`
+	Loans     bool `json:"loans"` // cobranças automáticas e calotes
+	Shop      bool `json:"shop"`  // timeouts, mutes e renomeações compradas
+	Admin     bool `json:"admin"` // ações de /admin (além do mod-log)
 }
 
 type DatabaseConfig struct {
@@ -34,28 +159,93 @@ type GeneralConfig struct {
 	AllowedChannels   []string       `json:"allowed_channels"`
 	RouletteChannelID string         `json:"roulette_channel_id"`
 	Database          DatabaseConfig `json:"database"`
+	// LotteryChannelID recebe os sorteios da loteria (vazio = canal da roleta)
+	LotteryChannelID string `json:"lottery_channel_id"`
+	// SlotsJackpotChannelID recebe o anúncio do prêmio acumulado do caça-níquel (vazio = canal da roleta)
+	SlotsJackpotChannelID string `json:"slots_jackpot_channel_id"`
+	// WebhookAllowlist libera hosts, IPs ou CIDRs internos como destino de webhooks
+	WebhookAllowlist []string `json:"webhook_allowlist"`
+	// CommandGuildIDs registra os comandos de barra só nesses servidores (útil em
+	// desenvolvimento, a atualização é instantânea). Vazio = comandos globais.
+	CommandGuildIDs []string          `json:"command_guild_ids"`
+	Permissions     PermissionsConfig `json:"permissions"`
+	// ModLogChannelID recebe o registro das ações de /admin (vazio = só no banco)
+	ModLogChannelID string      `json:"mod_log_channel_id"`
+	Audit           AuditConfig `json:"audit"`
+	// DefaultLocale é o idioma usado quando nem o usuário nem o servidor escolheram um ("en" ou "pt-BR")
+	DefaultLocale string `json:"default_locale"`
+	// PrivateCommands lista comandos extras ("balance", "stock buy") cujas respostas
+	// só o autor vê: efêmeras no / e por DM no prefixo
+	PrivateCommands []string `json:"private_commands"`
 }
 
+// A configuração de economia e a geral ficam atrás de ponteiros atômicos:
+// Reload troca a struct inteira de uma vez enquanto comandos, jogos, a API e
+// o ticker de voz continuam lendo de outras goroutines. Fora dos testes, nunca
+// altere a struct devolvida por Economy ou Bot.
+var (
+	economyConfig atomic.Pointer[EconomyConfig]
+	botConfig     atomic.Pointer[GeneralConfig]
+)
+
+// DBType e ConnString são definidos só no Load (ver setupDatabaseConfig);
+// Reload não os altera
 var (
-	Economy    EconomyConfig
-	Bot        GeneralConfig
 	DBType     string
 	ConnString string
 )
 
+func init() {
+	economyConfig.Store(&EconomyConfig{})
+	botConfig.Store(&GeneralConfig{})
+}
+
+// Economy retorna a configuração de economia em vigor
+func Economy() *EconomyConfig {
+	return economyConfig.Load()
+}
+
+// Bot retorna a configuração geral em vigor
+func Bot() *GeneralConfig {
+	return botConfig.Load()
+}
+
 func Load() {
-	loadJSON("economy.json", &Economy)
-	loadJSON("config.json", &Bot)
+	var economy EconomyConfig
+	loadJSON("economy.json", &economy)
+	economyConfig.Store(&economy)
+	var bot GeneralConfig
+	loadJSON("config.json", &bot)
+	botConfig.Store(&bot)
 	
 	// Configurar database defaults
 	setupDatabaseConfig()
 }
 
+// Reload relê config.json e economy.json sem derrubar o bot.
+// Em caso de erro mantém a configuração atual. Banco de dados (DBType,
+// ConnString) e porta da API não são recarregados: só mudam reiniciando.
+func Reload() error {
+	var economy EconomyConfig
+	if err := readJSON("economy.json", &economy); err != nil {
+		return err
+	}
+	var bot GeneralConfig
+	if err := readJSON("config.json", &bot); err != nil {
+		return err
+	}
+
+	economyConfig.Store(&economy)
+	botConfig.Store(&bot)
+	log.Println("Configuration reloaded")
+	return nil
+}
+
 func setupDatabaseConfig() {
 	// DB_TYPE do .env sobrescreve o config.json
 	DBType = os.Getenv("DB_TYPE")
 	if DBType == "" {
-		DBType = Bot.Database.Type
+		DBType = Bot().Database.Type
 	}
 	if DBType == "" {
 		DBType = "sqlite"
@@ -138,17 +328,23 @@ func contains(s, substr string) bool {
 }
 
 func loadJSON(filename string, target interface{}) {
+	if err := readJSON(filename, target); err != nil {
+		log.Fatal(err)
+	}
+	
+	log.Printf("Loaded config from %s", filename)
+}
+
+func readJSON(filename string, target interface{}) error {
 	file, err := os.ReadFile(filename)
 	if err != nil {
-		log.Fatalf("Error reading %s: %v", filename, err)
+		return fmt.Errorf("Error reading %s: %v", filename, err)
 	}
 
-	err = json.Unmarshal(file, target)
-	if err != nil {
-		log.Fatalf("Error parsing %s: %v", filename, err)
+	if err := json.Unmarshal(file, target); err != nil {
+		return fmt.Errorf("Error parsing %s: %v", filename, err)
 	}
-	
-	log.Printf("Loaded config from %s", filename)
+	return nil
 }
 
 // IsChannelAllowed checks if a channel ID is in the allowed channels list
@@ -165,6 +361,16 @@ func (c *GeneralConfig) IsChannelAllowed(channelID string) bool {
 	return false
 }
 
+// IsCommandPrivate informa se o comando (caminho como "stock buy") está em PrivateCommands
+func (c *GeneralConfig) IsCommandPrivate(path string) bool {
+	for _, p := range c.PrivateCommands {
+		if strings.EqualFold(strings.TrimSpace(p), path) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetAdjustedStockPrice applies the multiplier to a real stock price
 func (e *EconomyConfig) GetAdjustedStockPrice(realPrice float64) float64 {
 	multiplier := e.StockPriceMultiplier
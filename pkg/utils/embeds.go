@@ -1,12 +1,6 @@
 package utils
 
 import (
-	"bytes"
-	"encoding/json"
-	"estudocoin/internal/database"
-	"net/http"
-	"time"
-
 	"github.com/bwmarrin/discordgo"
 )
 
@@ -52,21 +46,3 @@ func GoldEmbed(title, description string) *discordgo.MessageEmbed {
 		Color:       ColorGold,
 	}
 }
-
-// SendWebhookNotification sends a simple message notification to user's webhook
-func SendWebhookNotification(userID string, message string) {
-	url, err := database.GetWebhook(userID)
-	if err != nil || url == "" {
-		return // No webhook configured
-	}
-
-	payload := map[string]string{
-		"content": message,
-	}
-
-	go func(targetURL string, p map[string]string) {
-		jsonBytes, _ := json.Marshal(p)
-		client := http.Client{Timeout: 5 * time.Second}
-		client.Post(targetURL, "application/json", bytes.NewBuffer(jsonBytes))
-	}(url, payload)
-}
\ No newline at end of file